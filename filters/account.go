@@ -4,8 +4,10 @@ import "strings"
 
 type Account struct {
 	UserID int `json:"email"`
-	clause string
-	args   []interface{}
+	// IncludeDeleted also selects the deleted accounts, for figures of the time before they were deleted
+	IncludeDeleted bool `json:"includeDeleted"`
+	clause         string
+	args           []interface{}
 }
 
 func (f *Account) WhereClause() (clause string, values []interface{}) {
@@ -16,7 +18,10 @@ func (f *Account) WhereClause() (clause string, values []interface{}) {
 
 	if f.clause != "" {
 		f.clause = " WHERE " + strings.TrimRight(f.clause, " AND")
-		f.clause += " AND deleted_at IS NULL"
+
+		if !f.IncludeDeleted {
+			f.clause += " AND deleted_at IS NULL"
+		}
 	}
 
 	return f.clause, f.args
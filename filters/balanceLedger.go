@@ -0,0 +1,34 @@
+package filters
+
+import "strings"
+
+type BalanceLedger struct {
+	UserID    int    `json:"userID"`
+	AccountID int    `json:"accountID"`
+	After     string `json:"after"` // only entries effective after this MySQL timestamp
+	clause    string
+	args      []interface{}
+}
+
+func (f *BalanceLedger) WhereClause() (clause string, values []interface{}) {
+	if f.UserID != 0 {
+		f.clause += `user_id=? AND`
+		f.args = append(f.args, f.UserID)
+	}
+
+	if f.AccountID != 0 {
+		f.clause += ` account_id=? AND`
+		f.args = append(f.args, f.AccountID)
+	}
+
+	if f.After != "" {
+		f.clause += ` effective_date>? AND`
+		f.args = append(f.args, f.After)
+	}
+
+	if f.clause != "" {
+		f.clause = " WHERE " + strings.TrimRight(f.clause, " AND")
+	}
+
+	return f.clause, f.args
+}
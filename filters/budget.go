@@ -0,0 +1,51 @@
+package filters
+
+import "strings"
+
+type Budget struct {
+	UserID   int      `json:"userID"`
+	Month    string   `json:"month"`
+	Category []string `json:"category"`
+	// FromMonth and ToMonth select the budgets of a range of months, both included
+	FromMonth string `json:"fromMonth"`
+	ToMonth   string `json:"toMonth"`
+	clause    string
+	args      []interface{}
+}
+
+func (b *Budget) WhereClause() (clause string, values []interface{}) {
+	if b.UserID != 0 {
+		b.clause += `b.user_id=? AND`
+		b.args = append(b.args, b.UserID)
+	}
+
+	if b.Month != "" {
+		b.clause += ` b.month=? AND`
+		b.args = append(b.args, b.Month)
+	}
+
+	if b.FromMonth != "" {
+		b.clause += ` b.month>=? AND`
+		b.args = append(b.args, b.FromMonth)
+	}
+
+	if b.ToMonth != "" {
+		b.clause += ` b.month<=? AND`
+		b.args = append(b.args, b.ToMonth)
+	}
+
+	if len(b.Category) != 0 {
+		b.clause += ` b.category IN (` + placeHolders(len(b.Category)) + `) AND`
+
+		for i := range b.Category {
+			b.args = append(b.args, b.Category[i])
+		}
+	}
+
+	if b.clause != "" {
+		b.clause = " WHERE " + strings.TrimRight(b.clause, " AND")
+		b.clause += " AND b.deleted_at IS NULL"
+	}
+
+	return b.clause, b.args
+}
@@ -0,0 +1,43 @@
+package filters
+
+import "strings"
+
+type Category struct {
+	UserID          int      `json:"userID"`
+	Type            []string `json:"type"`
+	Name            string   `json:"name"`
+	IncludeArchived bool     `json:"includeArchived"`
+	clause          string
+	args            []interface{}
+}
+
+func (f *Category) WhereClause() (clause string, values []interface{}) {
+	if f.UserID != 0 {
+		f.clause += `user_id=? AND`
+		f.args = append(f.args, f.UserID)
+	}
+
+	if len(f.Type) != 0 {
+		f.clause += ` type IN (` + placeHolders(len(f.Type)) + `) AND`
+
+		for i := range f.Type {
+			f.args = append(f.args, f.Type[i])
+		}
+	}
+
+	if f.Name != "" {
+		f.clause += ` name=? AND`
+		f.args = append(f.args, f.Name)
+	}
+
+	if !f.IncludeArchived {
+		f.clause += ` archived=false AND`
+	}
+
+	if f.clause != "" {
+		f.clause = " WHERE " + strings.TrimRight(f.clause, " AND")
+		f.clause += " AND deleted_at IS NULL"
+	}
+
+	return f.clause, f.args
+}
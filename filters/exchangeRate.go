@@ -0,0 +1,28 @@
+package filters
+
+import "strings"
+
+type ExchangeRate struct {
+	From   string `json:"from"`
+	To     string `json:"to"`
+	clause string
+	args   []interface{}
+}
+
+func (f *ExchangeRate) WhereClause() (clause string, values []interface{}) {
+	if f.From != "" {
+		f.clause += `from_currency=? AND`
+		f.args = append(f.args, f.From)
+	}
+
+	if f.To != "" {
+		f.clause += ` to_currency=? AND`
+		f.args = append(f.args, f.To)
+	}
+
+	if f.clause != "" {
+		f.clause = " WHERE " + strings.TrimRight(f.clause, " AND")
+	}
+
+	return f.clause, f.args
+}
@@ -0,0 +1,34 @@
+package filters
+
+import "strings"
+
+type Reconciliation struct {
+	UserID    int    `json:"userID"`
+	AccountID int    `json:"accountID"`
+	Status    string `json:"status"`
+	clause    string
+	args      []interface{}
+}
+
+func (r *Reconciliation) WhereClause() (clause string, values []interface{}) {
+	if r.UserID != 0 {
+		r.clause += `r.user_id=? AND`
+		r.args = append(r.args, r.UserID)
+	}
+
+	if r.AccountID != 0 {
+		r.clause += ` r.account_id=? AND`
+		r.args = append(r.args, r.AccountID)
+	}
+
+	if r.Status != "" {
+		r.clause += ` r.status=? AND`
+		r.args = append(r.args, r.Status)
+	}
+
+	if r.clause != "" {
+		r.clause = " WHERE " + strings.TrimRight(r.clause, " AND")
+	}
+
+	return r.clause, r.args
+}
@@ -3,14 +3,17 @@ package filters
 import "strings"
 
 type Transactions struct {
-	Type      []string `json:"type"`
-	UserID    int      `json:"userID"`
-	AccountID int      `json:"accountID"`
-	StartDate string   `json:"startDate"`
-	EndDate   string   `json:"endDate"`
-	Category  []string `json:"category"`
-	clause    string
-	args      []interface{}
+	Type       []string `json:"type"`
+	UserID     int      `json:"userID"`
+	AccountID  int      `json:"accountID"`
+	AccountIDs []int    `json:"accountIDs"` // any of several accounts, for dashboards over more than one account
+	StartDate  string   `json:"startDate"`
+	EndDate    string   `json:"endDate"`
+	Category   []string `json:"category"`
+	Depth      int      `json:"depth"`     // category level dashboard breakdowns roll up to, 0 keeps them flat
+	CompareTo  string   `json:"compareTo"` // period the dashboard is compared with, previousPeriod or previousYear
+	clause     string
+	args       []interface{}
 }
 
 func (t *Transactions) WhereClause() (clause string, values []interface{}) {
@@ -22,8 +25,14 @@ func (t *Transactions) WhereClause() (clause string, values []interface{}) {
 		}
 	}
 
+	// A transaction matches a category through its own category or through any of its splits
 	if len(t.Category) != 0 {
-		t.clause += ` t.category IN (` + placeHolders(len(t.Category)) + `) AND`
+		t.clause += ` (t.category IN (` + placeHolders(len(t.Category)) + `) OR EXISTS (SELECT 1 FROM transaction_splits as s ` +
+			`WHERE s.transaction_id=t.id AND s.deleted_at IS NULL AND s.category IN (` + placeHolders(len(t.Category)) + `))) AND`
+
+		for i := range t.Category {
+			t.args = append(t.args, t.Category[i])
+		}
 
 		for i := range t.Category {
 			t.args = append(t.args, t.Category[i])
@@ -36,13 +45,31 @@ func (t *Transactions) WhereClause() (clause string, values []interface{}) {
 	}
 
 	if t.AccountID != 0 {
-		t.clause += ` t.account_id=? AND`
-		t.args = append(t.args, t.AccountID)
+		t.clause += ` (t.account_id=? OR t.to_account_id=?) AND`
+		t.args = append(t.args, t.AccountID, t.AccountID)
+	}
+
+	if len(t.AccountIDs) != 0 {
+		t.clause += ` (t.account_id IN (` + placeHolders(len(t.AccountIDs)) + `) OR t.to_account_id IN (` +
+			placeHolders(len(t.AccountIDs)) + `)) AND`
+
+		for i := range t.AccountIDs {
+			t.args = append(t.args, t.AccountIDs[i])
+		}
+
+		for i := range t.AccountIDs {
+			t.args = append(t.args, t.AccountIDs[i])
+		}
+	}
+
+	if t.StartDate != "" {
+		t.clause += ` t.transaction_date>=? AND`
+		t.args = append(t.args, t.StartDate)
 	}
 
-	if t.StartDate != "" && t.EndDate != "" {
-		t.clause += ` t.transaction_date>=? AND t.transaction_date<=? AND`
-		t.args = append(t.args, t.StartDate, t.EndDate)
+	if t.EndDate != "" {
+		t.clause += ` t.transaction_date<=? AND`
+		t.args = append(t.args, t.EndDate)
 	}
 
 	if t.clause != "" {
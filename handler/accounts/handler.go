@@ -101,3 +101,42 @@ func (h *accounts) Delete(ctx *gofr.Context) (interface{}, error) {
 
 	return "account deleted successfully", nil
 }
+
+func (h *accounts) BalanceHistory(ctx *gofr.Context) (interface{}, error) {
+	idString := strings.TrimSpace(ctx.PathParam("id"))
+
+	id, err := strconv.Atoi(idString)
+	if err != nil {
+		return nil, errors.New("invalid id")
+	}
+
+	history, err := h.accountSvc.BalanceHistory(ctx, id, strings.TrimSpace(ctx.Param("from")),
+		strings.TrimSpace(ctx.Param("to")), strings.TrimSpace(ctx.Param("interval")))
+	if err != nil {
+		return nil, err
+	}
+
+	return history, nil
+}
+
+// CheckBalances reports the accounts whose balance does not match the one recomputed from their transactions. It
+// serves both the admin endpoint and the check-balances command.
+func (h *accounts) CheckBalances(ctx *gofr.Context) (interface{}, error) {
+	report, err := h.accountSvc.CheckBalances(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// RepairBalances replaces every balance that does not match by the recomputed one. It serves both the admin endpoint
+// and the repair-balances command.
+func (h *accounts) RepairBalances(ctx *gofr.Context) (interface{}, error) {
+	report, err := h.accountSvc.CheckBalances(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
@@ -15,6 +15,7 @@ import (
 	"moneyManagement/services"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"testing"
 )
 
@@ -22,7 +23,7 @@ func Test_Create(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	accountSvc := services.NewMockAccount(ctrl)
 
-	account := &models.Account{ID: 1, Name: "Cash", Type: "CASH", Balance: 2000}
+	account := &models.Account{ID: 1, Name: "Cash", Type: "CASH", Balance: models.NewMoney(2000)}
 
 	tests := []struct {
 		description    string
@@ -33,11 +34,11 @@ func Test_Create(t *testing.T) {
 	}{
 		{"Success Case", []byte(`{"name":"Cash","type":"CASH","balance":2000}`), account, nil,
 			func(ctx *gofr.Context) {
-				accountSvc.EXPECT().Create(ctx, &models.Account{Name: "Cash", Type: "CASH", Balance: 2000}).Return(account, nil)
+				accountSvc.EXPECT().Create(ctx, &models.Account{Name: "Cash", Type: "CASH", Balance: models.NewMoney(2000)}).Return(account, nil)
 			}},
 		{"Failure Case: Error from service layer", []byte(`{"name":"Cash","type":"CASH","balance":2000}`), nil, errors.New("error"),
 			func(ctx *gofr.Context) {
-				accountSvc.EXPECT().Create(ctx, &models.Account{Name: "Cash", Type: "CASH", Balance: 2000}).Return(nil, errors.New("error"))
+				accountSvc.EXPECT().Create(ctx, &models.Account{Name: "Cash", Type: "CASH", Balance: models.NewMoney(2000)}).Return(nil, errors.New("error"))
 			}},
 		{"Failure Case: bind error", []byte(`{`), nil, errors.New("bind error"), func(ctx *gofr.Context) {
 		}},
@@ -70,7 +71,7 @@ func Test_Update(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	accountSvc := services.NewMockAccount(ctrl)
 
-	account := &models.Account{ID: 1, Name: "Cash", Type: "CASH", Balance: 2000}
+	account := &models.Account{ID: 1, Name: "Cash", Type: "CASH", Balance: models.NewMoney(2000)}
 
 	tests := []struct {
 		description    string
@@ -171,7 +172,7 @@ func Test_GetByID(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	accountSvc := services.NewMockAccount(ctrl)
 
-	account := &models.Account{ID: 1, Name: "Cash", Type: "CASH", Balance: 2000}
+	account := &models.Account{ID: 1, Name: "Cash", Type: "CASH", Balance: models.NewMoney(2000)}
 
 	tests := []struct {
 		description    string
@@ -221,7 +222,7 @@ func Test_GetAll(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	accountSvc := services.NewMockAccount(ctrl)
 
-	account := &models.Account{ID: 1, Name: "Cash", Type: "CASH", Balance: 2000}
+	account := &models.Account{ID: 1, Name: "Cash", Type: "CASH", Balance: models.NewMoney(2000)}
 
 	tests := []struct {
 		description    string
@@ -263,3 +264,153 @@ func Test_GetAll(t *testing.T) {
 		})
 	}
 }
+
+func Test_BalanceHistory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	accountSvc := services.NewMockAccount(ctrl)
+
+	history := &models.BalanceHistory{Account: models.AccountDetails{ID: 1, Name: "Cash"}, Currency: "INR",
+		From: "2025-03-01", To: "2025-03-02", Interval: "day", Points: []models.BalancePoint{
+			{Date: "2025-03-01", Balance: models.NewMoney(1500)}, {Date: "2025-03-02", Balance: models.NewMoney(2000)}}}
+
+	tests := []struct {
+		description    string
+		id             string
+		query          url.Values
+		expectedOutput interface{}
+		expectedErr    error
+		execMocks      func(ctx *gofr.Context)
+	}{
+		{"Success Case", "1", url.Values{"from": {"2025-03-01"}, "to": {"2025-03-02"}, "interval": {"day"}}, history, nil,
+			func(ctx *gofr.Context) {
+				accountSvc.EXPECT().BalanceHistory(ctx, 1, "2025-03-01", "2025-03-02", "day").Return(history, nil)
+			}},
+		{"Success Case: defaults", "1", url.Values{}, history, nil,
+			func(ctx *gofr.Context) {
+				accountSvc.EXPECT().BalanceHistory(ctx, 1, "", "", "").Return(history, nil)
+			}},
+		{"Failure Case: Error from service layer", "1", url.Values{"interval": {"year"}}, nil,
+			errors.New("invalid interval, use day, week or month"),
+			func(ctx *gofr.Context) {
+				accountSvc.EXPECT().BalanceHistory(ctx, 1, "", "", "year").
+					Return(nil, errors.New("invalid interval, use day, week or month"))
+			}},
+		{"Failure Case: invalid id", "abc", url.Values{}, nil, errors.New("invalid id"), func(ctx *gofr.Context) {
+		}},
+	}
+
+	for i, tc := range tests {
+		i := i
+		tc := tc
+
+		t.Run(tc.description, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/account/balance-history", nil)
+			req.Header.Set("Content-Type", "application/json")
+			req.URL.RawQuery = tc.query.Encode()
+			req = mux.SetURLVars(req, map[string]string{"id": tc.id})
+
+			gofrReq := gofrHTTP.NewRequest(req)
+			mockContainer, _ := container.NewMockContainer(t)
+			ctx := gofr.Context{Context: context.Background(), Request: gofrReq, Container: mockContainer, Out: nil}
+
+			tc.execMocks(&ctx)
+
+			h := New(accountSvc)
+
+			output, err := h.BalanceHistory(&ctx)
+
+			assert.Equalf(t, tc.expectedOutput, output, "TEST[%d], failed.\n%s", i, tc.description)
+			assert.Equalf(t, tc.expectedErr, err, "TEST[%d], failed.\n%s", i, tc.description)
+		})
+	}
+}
+
+func Test_CheckBalances(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	accountSvc := services.NewMockAccount(ctrl)
+
+	check := &models.BalanceCheck{Account: models.AccountDetails{ID: 1, Name: "Cash"}, UserID: 1, Currency: "INR",
+		OpeningBalance: models.NewMoney(1000), Transactions: models.NewMoney(500), Expected: models.NewMoney(1500),
+		Balance: models.NewMoney(1600), Discrepancy: models.NewMoney(100)}
+	report := &models.BalanceCheckReport{Checked: 2, Discrepancies: 1, Accounts: []*models.BalanceCheck{check}}
+
+	tests := []struct {
+		description    string
+		expectedOutput interface{}
+		expectedErr    error
+		execMocks      func(ctx *gofr.Context)
+	}{
+		{"Success Case", report, nil, func(ctx *gofr.Context) {
+			accountSvc.EXPECT().CheckBalances(ctx, false).Return(report, nil)
+		}},
+		{"Failure Case: Error from service layer", nil, errors.New("DB error"), func(ctx *gofr.Context) {
+			accountSvc.EXPECT().CheckBalances(ctx, false).Return(nil, errors.New("DB error"))
+		}},
+	}
+
+	for i, tc := range tests {
+		i := i
+		tc := tc
+
+		t.Run(tc.description, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/balance-check", nil)
+
+			gofrReq := gofrHTTP.NewRequest(req)
+			mockContainer, _ := container.NewMockContainer(t)
+			ctx := gofr.Context{Context: context.Background(), Request: gofrReq, Container: mockContainer, Out: nil}
+
+			tc.execMocks(&ctx)
+
+			h := New(accountSvc)
+			output, err := h.CheckBalances(&ctx)
+
+			assert.Equalf(t, tc.expectedOutput, output, "TEST[%d], failed.\n%s", i, tc.description)
+			assert.Equalf(t, tc.expectedErr, err, "TEST[%d], failed.\n%s", i, tc.description)
+		})
+	}
+}
+
+func Test_RepairBalances(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	accountSvc := services.NewMockAccount(ctrl)
+
+	check := &models.BalanceCheck{Account: models.AccountDetails{ID: 1, Name: "Cash"}, UserID: 1, Currency: "INR",
+		OpeningBalance: models.NewMoney(1000), Transactions: models.NewMoney(500), Expected: models.NewMoney(1500),
+		Balance: models.NewMoney(1600), Discrepancy: models.NewMoney(100)}
+	repaired := &models.BalanceCheckReport{Checked: 2, Discrepancies: 1, Repaired: 1, Accounts: []*models.BalanceCheck{check}}
+
+	tests := []struct {
+		description    string
+		expectedOutput interface{}
+		expectedErr    error
+		execMocks      func(ctx *gofr.Context)
+	}{
+		{"Success Case", repaired, nil, func(ctx *gofr.Context) {
+			accountSvc.EXPECT().CheckBalances(ctx, true).Return(repaired, nil)
+		}},
+		{"Failure Case: Error from service layer", nil, errors.New("DB error"), func(ctx *gofr.Context) {
+			accountSvc.EXPECT().CheckBalances(ctx, true).Return(nil, errors.New("DB error"))
+		}},
+	}
+
+	for i, tc := range tests {
+		i := i
+		tc := tc
+
+		t.Run(tc.description, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/balance-check/repair", nil)
+
+			gofrReq := gofrHTTP.NewRequest(req)
+			mockContainer, _ := container.NewMockContainer(t)
+			ctx := gofr.Context{Context: context.Background(), Request: gofrReq, Container: mockContainer, Out: nil}
+
+			tc.execMocks(&ctx)
+
+			h := New(accountSvc)
+			output, err := h.RepairBalances(&ctx)
+
+			assert.Equalf(t, tc.expectedOutput, output, "TEST[%d], failed.\n%s", i, tc.description)
+			assert.Equalf(t, tc.expectedErr, err, "TEST[%d], failed.\n%s", i, tc.description)
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package budgets
+
+import (
+	"errors"
+	"moneyManagement/filters"
+	"moneyManagement/handler"
+	"moneyManagement/models"
+	"moneyManagement/services"
+	"strconv"
+	"strings"
+
+	"gofr.dev/pkg/gofr"
+)
+
+type budgets struct {
+	budgetSvc services.Budgets
+}
+
+func New(budgetSvc services.Budgets) handler.Budgets {
+	return &budgets{budgetSvc: budgetSvc}
+}
+
+func (h *budgets) Create(ctx *gofr.Context) (interface{}, error) {
+	var budget *models.Budget
+
+	err := ctx.Bind(&budget)
+	if err != nil {
+		return nil, errors.New("bind error")
+	}
+
+	newBudget, err := h.budgetSvc.Create(ctx, budget)
+	if err != nil {
+		return nil, err
+	}
+
+	return newBudget, nil
+}
+
+func (h *budgets) GetByID(ctx *gofr.Context) (interface{}, error) {
+	idString := strings.TrimSpace(ctx.PathParam("id"))
+
+	id, err := strconv.Atoi(idString)
+	if err != nil {
+		return nil, errors.New("invalid id")
+	}
+
+	budget, err := h.budgetSvc.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return budget, nil
+}
+
+func (h *budgets) GetAll(ctx *gofr.Context) (interface{}, error) {
+	var f filters.Budget
+
+	f.Month = ctx.Param("month")
+	f.Category = ctx.Params("category")
+
+	allBudgets, err := h.budgetSvc.GetAll(ctx, &f)
+	if err != nil {
+		return nil, err
+	}
+
+	return allBudgets, nil
+}
+
+func (h *budgets) Update(ctx *gofr.Context) (interface{}, error) {
+	idString := strings.TrimSpace(ctx.PathParam("id"))
+
+	id, err := strconv.Atoi(idString)
+	if err != nil {
+		return nil, errors.New("invalid id")
+	}
+
+	var budget *models.Budget
+
+	err = ctx.Bind(&budget)
+	if err != nil {
+		return nil, errors.New("bind error")
+	}
+
+	budget.ID = id
+
+	updatedBudget, err := h.budgetSvc.Update(ctx, budget)
+	if err != nil {
+		return nil, err
+	}
+
+	return updatedBudget, nil
+}
+
+func (h *budgets) Delete(ctx *gofr.Context) (interface{}, error) {
+	idString := strings.TrimSpace(ctx.PathParam("id"))
+
+	id, err := strconv.Atoi(idString)
+	if err != nil {
+		return nil, errors.New("invalid id")
+	}
+
+	err = h.budgetSvc.Delete(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return "budget deleted successfully", nil
+}
+
+func (h *budgets) Status(ctx *gofr.Context) (interface{}, error) {
+	month := strings.TrimSpace(ctx.PathParam("month"))
+
+	status, err := h.budgetSvc.Status(ctx, month)
+	if err != nil {
+		return nil, err
+	}
+
+	return status, nil
+}
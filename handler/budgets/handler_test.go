@@ -0,0 +1,334 @@
+package budgets
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	gofrHTTP "gofr.dev/pkg/gofr/http"
+	"moneyManagement/filters"
+	"moneyManagement/models"
+	"moneyManagement/services"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func Test_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	budgetSvc := services.NewMockBudgets(ctrl)
+
+	budget := &models.Budget{ID: 1, UserID: 1, Category: "Groceries", Month: "2025-05", Amount: models.NewMoney(500)}
+
+	tests := []struct {
+		description    string
+		body           []byte
+		expectedOutput interface{}
+		expectedErr    error
+		execMocks      func(ctx *gofr.Context)
+	}{
+		{"Success Case", []byte(`{"category":"Groceries","month":"2025-05","amount":500}`), budget, nil,
+			func(ctx *gofr.Context) {
+				budgetSvc.EXPECT().Create(ctx, &models.Budget{Category: "Groceries", Month: "2025-05", Amount: models.NewMoney(500)}).
+					Return(budget, nil)
+			}},
+		{"Success Case: account budget", []byte(`{"category":"Groceries","month":"2025-05","amount":500,"account":{"id":2}}`),
+			budget, nil,
+			func(ctx *gofr.Context) {
+				budgetSvc.EXPECT().Create(ctx, &models.Budget{Category: "Groceries", Month: "2025-05", Amount: models.NewMoney(500),
+					Account: &models.AccountDetails{ID: 2}}).Return(budget, nil)
+			}},
+		{"Success Case: rollover budget", []byte(`{"category":"Groceries","month":"2025-05","amount":500,"rollover":true}`),
+			budget, nil,
+			func(ctx *gofr.Context) {
+				budgetSvc.EXPECT().Create(ctx, &models.Budget{Category: "Groceries", Month: "2025-05", Amount: models.NewMoney(500),
+					Rollover: true}).Return(budget, nil)
+			}},
+		{"Failure Case: Error from service layer", []byte(`{"category":"Groceries","month":"2025-05","amount":500}`), nil,
+			errors.New("budget already exists for this category and month"),
+			func(ctx *gofr.Context) {
+				budgetSvc.EXPECT().Create(ctx, &models.Budget{Category: "Groceries", Month: "2025-05", Amount: models.NewMoney(500)}).
+					Return(nil, errors.New("budget already exists for this category and month"))
+			}},
+		{"Failure Case: bind error", []byte(`{`), nil, errors.New("bind error"), func(ctx *gofr.Context) {
+		}},
+	}
+
+	for i, tc := range tests {
+		i := i
+		tc := tc
+
+		t.Run(tc.description, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/budget", bytes.NewBuffer(tc.body))
+			req.Header.Set("Content-Type", "application/json")
+			gofrReq := gofrHTTP.NewRequest(req)
+			mockContainer, _ := container.NewMockContainer(t)
+			ctx := gofr.Context{Context: context.Background(), Request: gofrReq, Container: mockContainer, Out: nil}
+
+			tc.execMocks(&ctx)
+
+			h := New(budgetSvc)
+
+			output, err := h.Create(&ctx)
+
+			assert.Equalf(t, tc.expectedOutput, output, "TEST[%d], failed.\n%s", i, tc.description)
+			assert.Equalf(t, tc.expectedErr, err, "TEST[%d], failed.\n%s", i, tc.description)
+		})
+	}
+}
+
+func Test_GetAll(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	budgetSvc := services.NewMockBudgets(ctrl)
+
+	budget := &models.Budget{ID: 1, UserID: 1, Category: "Groceries", Month: "2025-05", Amount: models.NewMoney(500)}
+
+	tests := []struct {
+		description    string
+		expectedOutput interface{}
+		expectedErr    error
+		execMocks      func(ctx *gofr.Context)
+	}{
+		{"Success Case", []*models.Budget{budget}, nil,
+			func(ctx *gofr.Context) {
+				budgetSvc.EXPECT().GetAll(ctx, &filters.Budget{Month: "2025-05"}).Return([]*models.Budget{budget}, nil)
+			}},
+		{"Failure Case: Error from service layer", nil, errors.New("error"),
+			func(ctx *gofr.Context) {
+				budgetSvc.EXPECT().GetAll(ctx, &filters.Budget{Month: "2025-05"}).Return(nil, errors.New("error"))
+			}},
+	}
+
+	for i, tc := range tests {
+		i := i
+		tc := tc
+
+		t.Run(tc.description, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/budget", nil)
+			req.Header.Set("Content-Type", "application/json")
+			req.URL.RawQuery = url.Values{"month": {"2025-05"}}.Encode()
+
+			gofrReq := gofrHTTP.NewRequest(req)
+			mockContainer, _ := container.NewMockContainer(t)
+			ctx := gofr.Context{Context: context.Background(), Request: gofrReq, Container: mockContainer, Out: nil}
+
+			tc.execMocks(&ctx)
+
+			h := New(budgetSvc)
+
+			output, err := h.GetAll(&ctx)
+
+			assert.Equalf(t, tc.expectedOutput, output, "TEST[%d], failed.\n%s", i, tc.description)
+			assert.Equalf(t, tc.expectedErr, err, "TEST[%d], failed.\n%s", i, tc.description)
+		})
+	}
+}
+
+func Test_GetByID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	budgetSvc := services.NewMockBudgets(ctrl)
+
+	budget := &models.Budget{ID: 1, UserID: 1, Category: "Groceries", Month: "2025-05", Amount: models.NewMoney(500)}
+
+	tests := []struct {
+		description    string
+		id             string
+		expectedOutput interface{}
+		expectedErr    error
+		execMocks      func(ctx *gofr.Context)
+	}{
+		{"Success Case", "1", budget, nil,
+			func(ctx *gofr.Context) {
+				budgetSvc.EXPECT().GetByID(ctx, 1).Return(budget, nil)
+			}},
+		{"Failure Case: Error from service layer", "1", nil, errors.New("error"),
+			func(ctx *gofr.Context) {
+				budgetSvc.EXPECT().GetByID(ctx, 1).Return(nil, errors.New("error"))
+			}},
+		{"Failure Case: invalid id", "!", nil, errors.New("invalid id"), func(ctx *gofr.Context) {
+		}},
+	}
+
+	for i, tc := range tests {
+		i := i
+		tc := tc
+
+		t.Run(tc.description, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/budget", nil)
+			req.Header.Set("Content-Type", "application/json")
+			req = mux.SetURLVars(req, map[string]string{"id": tc.id})
+
+			gofrReq := gofrHTTP.NewRequest(req)
+			mockContainer, _ := container.NewMockContainer(t)
+			ctx := gofr.Context{Context: context.Background(), Request: gofrReq, Container: mockContainer, Out: nil}
+
+			tc.execMocks(&ctx)
+
+			h := New(budgetSvc)
+
+			output, err := h.GetByID(&ctx)
+
+			assert.Equalf(t, tc.expectedOutput, output, "TEST[%d], failed.\n%s", i, tc.description)
+			assert.Equalf(t, tc.expectedErr, err, "TEST[%d], failed.\n%s", i, tc.description)
+		})
+	}
+}
+
+func Test_Update(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	budgetSvc := services.NewMockBudgets(ctrl)
+
+	budget := &models.Budget{ID: 1, Category: "Groceries", Month: "2025-05", Amount: models.NewMoney(650)}
+
+	tests := []struct {
+		description    string
+		id             string
+		body           []byte
+		expectedOutput interface{}
+		expectedErr    error
+		execMocks      func(ctx *gofr.Context)
+	}{
+		{"Success Case", "1", []byte(`{"category":"Groceries","month":"2025-05","amount":650}`), budget, nil,
+			func(ctx *gofr.Context) {
+				budgetSvc.EXPECT().Update(ctx, budget).Return(budget, nil)
+			}},
+		{"Failure Case: Error from service layer", "1", []byte(`{"category":"Groceries","month":"2025-05","amount":650}`),
+			nil, errors.New("budget not found"),
+			func(ctx *gofr.Context) {
+				budgetSvc.EXPECT().Update(ctx, budget).Return(nil, errors.New("budget not found"))
+			}},
+		{"Failure Case: bind error", "1", []byte(`{`), nil, errors.New("bind error"), func(ctx *gofr.Context) {
+		}},
+		{"Failure Case: invalid id", "!", []byte(`{`), nil, errors.New("invalid id"), func(ctx *gofr.Context) {
+		}},
+	}
+
+	for i, tc := range tests {
+		i := i
+		tc := tc
+
+		t.Run(tc.description, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPut, "/budget", bytes.NewBuffer(tc.body))
+			req.Header.Set("Content-Type", "application/json")
+			req = mux.SetURLVars(req, map[string]string{"id": tc.id})
+
+			gofrReq := gofrHTTP.NewRequest(req)
+			mockContainer, _ := container.NewMockContainer(t)
+			ctx := gofr.Context{Context: context.Background(), Request: gofrReq, Container: mockContainer, Out: nil}
+
+			tc.execMocks(&ctx)
+
+			h := New(budgetSvc)
+
+			output, err := h.Update(&ctx)
+
+			assert.Equalf(t, tc.expectedOutput, output, "TEST[%d], failed.\n%s", i, tc.description)
+			assert.Equalf(t, tc.expectedErr, err, "TEST[%d], failed.\n%s", i, tc.description)
+		})
+	}
+}
+
+func Test_Delete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	budgetSvc := services.NewMockBudgets(ctrl)
+
+	tests := []struct {
+		description    string
+		id             string
+		expectedOutput interface{}
+		expectedErr    error
+		execMocks      func(ctx *gofr.Context)
+	}{
+		{"Success Case", "1", "budget deleted successfully", nil,
+			func(ctx *gofr.Context) {
+				budgetSvc.EXPECT().Delete(ctx, 1).Return(nil)
+			}},
+		{"Failure Case: Error from service layer", "1", nil, errors.New("error"),
+			func(ctx *gofr.Context) {
+				budgetSvc.EXPECT().Delete(ctx, 1).Return(errors.New("error"))
+			}},
+		{"Failure Case: invalid id", "!", nil, errors.New("invalid id"), func(ctx *gofr.Context) {
+		}},
+	}
+
+	for i, tc := range tests {
+		i := i
+		tc := tc
+
+		t.Run(tc.description, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodDelete, "/budget", nil)
+			req.Header.Set("Content-Type", "application/json")
+			req = mux.SetURLVars(req, map[string]string{"id": tc.id})
+
+			gofrReq := gofrHTTP.NewRequest(req)
+			mockContainer, _ := container.NewMockContainer(t)
+			ctx := gofr.Context{Context: context.Background(), Request: gofrReq, Container: mockContainer, Out: nil}
+
+			tc.execMocks(&ctx)
+
+			h := New(budgetSvc)
+
+			output, err := h.Delete(&ctx)
+
+			assert.Equalf(t, tc.expectedOutput, output, "TEST[%d], failed.\n%s", i, tc.description)
+			assert.Equalf(t, tc.expectedErr, err, "TEST[%d], failed.\n%s", i, tc.description)
+		})
+	}
+}
+
+func Test_Status(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	budgetSvc := services.NewMockBudgets(ctrl)
+
+	budget := &models.Budget{ID: 1, UserID: 1, Category: "Groceries", Month: "2025-05", Amount: models.NewMoney(500)}
+	status := &models.BudgetStatus{Month: "2025-05", DaysElapsed: 10, DaysInMonth: 31, TotalLimit: models.NewMoney(500), TotalCarryOver: models.NewMoney(50),
+		TotalSpent: models.NewMoney(200), TotalRemaining: models.NewMoney(350), Income: models.NewMoney(3000), AvailableToAssign: models.NewMoney(2500),
+		Budgets: []models.BudgetProgress{{Budget: budget, CarryOver: models.NewMoney(50), Available: models.NewMoney(550), Spent: models.NewMoney(200), Remaining: models.NewMoney(350),
+			PercentConsumed: 36.36, ProjectedSpend: models.NewMoney(620), OverBudget: false}}}
+
+	tests := []struct {
+		description    string
+		month          string
+		expectedOutput interface{}
+		expectedErr    error
+		execMocks      func(ctx *gofr.Context)
+	}{
+		{"Success Case", "2025-05", status, nil,
+			func(ctx *gofr.Context) {
+				budgetSvc.EXPECT().Status(ctx, "2025-05").Return(status, nil)
+			}},
+		{"Failure Case: Error from service layer", "May", nil, errors.New("invalid month, use YYYY-MM"),
+			func(ctx *gofr.Context) {
+				budgetSvc.EXPECT().Status(ctx, "May").Return(nil, errors.New("invalid month, use YYYY-MM"))
+			}},
+	}
+
+	for i, tc := range tests {
+		i := i
+		tc := tc
+
+		t.Run(tc.description, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/budget/status", nil)
+			req.Header.Set("Content-Type", "application/json")
+			req = mux.SetURLVars(req, map[string]string{"month": tc.month})
+
+			gofrReq := gofrHTTP.NewRequest(req)
+			mockContainer, _ := container.NewMockContainer(t)
+			ctx := gofr.Context{Context: context.Background(), Request: gofrReq, Container: mockContainer, Out: nil}
+
+			tc.execMocks(&ctx)
+
+			h := New(budgetSvc)
+
+			output, err := h.Status(&ctx)
+
+			assert.Equalf(t, tc.expectedOutput, output, "TEST[%d], failed.\n%s", i, tc.description)
+			assert.Equalf(t, tc.expectedErr, err, "TEST[%d], failed.\n%s", i, tc.description)
+		})
+	}
+}
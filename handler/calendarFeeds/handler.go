@@ -0,0 +1,76 @@
+package calendarFeeds
+
+import (
+	"errors"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/http/response"
+	"moneyManagement/handler"
+	"moneyManagement/models"
+	"moneyManagement/services"
+	"strconv"
+	"strings"
+)
+
+type calendarFeedsHandler struct {
+	calendarFeedSvc services.CalendarFeeds
+}
+
+func New(calendarFeedSvc services.CalendarFeeds) handler.CalendarFeeds {
+	return &calendarFeedsHandler{calendarFeedSvc: calendarFeedSvc}
+}
+
+func (h *calendarFeedsHandler) Create(ctx *gofr.Context) (interface{}, error) {
+	var feed *models.CalendarFeed
+
+	err := ctx.Bind(&feed)
+	if err != nil {
+		return nil, errors.New("bind error")
+	}
+
+	newFeed, err := h.calendarFeedSvc.Create(ctx, feed)
+	if err != nil {
+		return nil, err
+	}
+
+	return newFeed, nil
+}
+
+func (h *calendarFeedsHandler) GetAll(ctx *gofr.Context) (interface{}, error) {
+	feeds, err := h.calendarFeedSvc.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return feeds, nil
+}
+
+func (h *calendarFeedsHandler) Revoke(ctx *gofr.Context) (interface{}, error) {
+	idString := strings.TrimSpace(ctx.PathParam("id"))
+
+	id, err := strconv.Atoi(idString)
+	if err != nil {
+		return nil, errors.New("invalid id")
+	}
+
+	err = h.calendarFeedSvc.Revoke(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return "calendar feed revoked successfully", nil
+}
+
+// Feed serves the iCalendar document of a feed `token`, for calendar apps that cannot send a login.
+func (h *calendarFeedsHandler) Feed(ctx *gofr.Context) (interface{}, error) {
+	token := strings.TrimSpace(ctx.PathParam("token"))
+	if token == "" {
+		return nil, errors.New("calendar feed not found")
+	}
+
+	calendar, err := h.calendarFeedSvc.Render(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.File{Content: calendar, ContentType: "text/calendar; charset=utf-8"}, nil
+}
@@ -0,0 +1,163 @@
+package calendarFeeds
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	gofrHTTP "gofr.dev/pkg/gofr/http"
+	"gofr.dev/pkg/gofr/http/response"
+	"moneyManagement/models"
+	"moneyManagement/services"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func Test_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	calendarFeedSvc := services.NewMockCalendarFeeds(ctrl)
+
+	feed := &models.CalendarFeed{ID: 1, UserID: 1, Name: "Phone", Token: "abc", URL: "/calendar/abc.ics"}
+
+	tests := []struct {
+		description    string
+		body           []byte
+		expectedOutput interface{}
+		expectedErr    error
+		execMocks      func(ctx *gofr.Context)
+	}{
+		{"Success Case", []byte(`{"name":"Phone"}`), feed, nil,
+			func(ctx *gofr.Context) {
+				calendarFeedSvc.EXPECT().Create(ctx, &models.CalendarFeed{Name: "Phone"}).Return(feed, nil)
+			}},
+		{"Failure Case: Error from service layer", []byte(`{"name":"Phone"}`), nil, errors.New("error"),
+			func(ctx *gofr.Context) {
+				calendarFeedSvc.EXPECT().Create(ctx, &models.CalendarFeed{Name: "Phone"}).Return(nil, errors.New("error"))
+			}},
+		{"Failure Case: bind error", []byte(`{`), nil, errors.New("bind error"), func(ctx *gofr.Context) {
+		}},
+	}
+
+	for i, tc := range tests {
+		i := i
+		tc := tc
+
+		t.Run(tc.description, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/calendar-feed", bytes.NewBuffer(tc.body))
+			req.Header.Set("Content-Type", "application/json")
+			gofrReq := gofrHTTP.NewRequest(req)
+			mockContainer, _ := container.NewMockContainer(t)
+			ctx := gofr.Context{Context: context.Background(), Request: gofrReq, Container: mockContainer, Out: nil}
+
+			tc.execMocks(&ctx)
+
+			h := New(calendarFeedSvc)
+
+			output, err := h.Create(&ctx)
+
+			assert.Equalf(t, tc.expectedOutput, output, "TEST[%d], failed.\n%s", i, tc.description)
+			assert.Equalf(t, tc.expectedErr, err, "TEST[%d], failed.\n%s", i, tc.description)
+		})
+	}
+}
+
+func Test_Revoke(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	calendarFeedSvc := services.NewMockCalendarFeeds(ctrl)
+
+	tests := []struct {
+		description    string
+		id             string
+		expectedOutput interface{}
+		expectedErr    error
+		execMocks      func(ctx *gofr.Context)
+	}{
+		{"Success Case", "1", "calendar feed revoked successfully", nil,
+			func(ctx *gofr.Context) {
+				calendarFeedSvc.EXPECT().Revoke(ctx, 1).Return(nil)
+			}},
+		{"Failure Case: Error from service layer", "2", nil, errors.New("calendar feed not found"),
+			func(ctx *gofr.Context) {
+				calendarFeedSvc.EXPECT().Revoke(ctx, 2).Return(errors.New("calendar feed not found"))
+			}},
+		{"Failure Case: invalid id", "!", nil, errors.New("invalid id"), func(ctx *gofr.Context) {
+		}},
+	}
+
+	for i, tc := range tests {
+		i := i
+		tc := tc
+
+		t.Run(tc.description, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodDelete, "/calendar-feed", nil)
+			req = mux.SetURLVars(req, map[string]string{"id": tc.id})
+
+			gofrReq := gofrHTTP.NewRequest(req)
+			mockContainer, _ := container.NewMockContainer(t)
+			ctx := gofr.Context{Context: context.Background(), Request: gofrReq, Container: mockContainer, Out: nil}
+
+			tc.execMocks(&ctx)
+
+			h := New(calendarFeedSvc)
+
+			output, err := h.Revoke(&ctx)
+
+			assert.Equalf(t, tc.expectedOutput, output, "TEST[%d], failed.\n%s", i, tc.description)
+			assert.Equalf(t, tc.expectedErr, err, "TEST[%d], failed.\n%s", i, tc.description)
+		})
+	}
+}
+
+func Test_Feed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	calendarFeedSvc := services.NewMockCalendarFeeds(ctrl)
+
+	calendar := []byte("BEGIN:VCALENDAR\r\nEND:VCALENDAR\r\n")
+
+	tests := []struct {
+		description    string
+		token          string
+		expectedOutput interface{}
+		expectedErr    error
+		execMocks      func(ctx *gofr.Context)
+	}{
+		{"Success Case", "abc", response.File{Content: calendar, ContentType: "text/calendar; charset=utf-8"}, nil,
+			func(ctx *gofr.Context) {
+				calendarFeedSvc.EXPECT().Render(ctx, "abc").Return(calendar, nil)
+			}},
+		{"Failure Case: Error from service layer", "revoked", nil, errors.New("calendar feed not found"),
+			func(ctx *gofr.Context) {
+				calendarFeedSvc.EXPECT().Render(ctx, "revoked").Return(nil, errors.New("calendar feed not found"))
+			}},
+		{"Failure Case: missing token", "", nil, errors.New("calendar feed not found"), func(ctx *gofr.Context) {
+		}},
+	}
+
+	for i, tc := range tests {
+		i := i
+		tc := tc
+
+		t.Run(tc.description, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/calendar", nil)
+			req = mux.SetURLVars(req, map[string]string{"token": tc.token})
+
+			gofrReq := gofrHTTP.NewRequest(req)
+			mockContainer, _ := container.NewMockContainer(t)
+			ctx := gofr.Context{Context: context.Background(), Request: gofrReq, Container: mockContainer, Out: nil}
+
+			tc.execMocks(&ctx)
+
+			h := New(calendarFeedSvc)
+
+			output, err := h.Feed(&ctx)
+
+			assert.Equalf(t, tc.expectedOutput, output, "TEST[%d], failed.\n%s", i, tc.description)
+			assert.Equalf(t, tc.expectedErr, err, "TEST[%d], failed.\n%s", i, tc.description)
+		})
+	}
+}
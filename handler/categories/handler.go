@@ -0,0 +1,143 @@
+package categories
+
+import (
+	"errors"
+	"moneyManagement/filters"
+	"moneyManagement/handler"
+	"moneyManagement/models"
+	"moneyManagement/services"
+	"strconv"
+	"strings"
+
+	"gofr.dev/pkg/gofr"
+)
+
+type categories struct {
+	categorySvc services.Categories
+}
+
+func New(categorySvc services.Categories) handler.Categories {
+	return &categories{categorySvc: categorySvc}
+}
+
+func (h *categories) Create(ctx *gofr.Context) (interface{}, error) {
+	var category *models.Category
+
+	err := ctx.Bind(&category)
+	if err != nil {
+		return nil, errors.New("bind error")
+	}
+
+	newCategory, err := h.categorySvc.Create(ctx, category)
+	if err != nil {
+		return nil, err
+	}
+
+	return newCategory, nil
+}
+
+func (h *categories) GetByID(ctx *gofr.Context) (interface{}, error) {
+	idString := strings.TrimSpace(ctx.PathParam("id"))
+
+	id, err := strconv.Atoi(idString)
+	if err != nil {
+		return nil, errors.New("invalid id")
+	}
+
+	category, err := h.categorySvc.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return category, nil
+}
+
+func (h *categories) GetAll(ctx *gofr.Context) (interface{}, error) {
+	var f filters.Category
+
+	f.Type = ctx.Params("type")
+
+	if includeArchived := ctx.Param("includeArchived"); includeArchived != "" {
+		archived, err := strconv.ParseBool(includeArchived)
+		if err != nil {
+			return nil, errors.New("invalid includeArchived")
+		}
+
+		f.IncludeArchived = archived
+	}
+
+	allCategories, err := h.categorySvc.GetAll(ctx, &f)
+	if err != nil {
+		return nil, err
+	}
+
+	return allCategories, nil
+}
+
+func (h *categories) Update(ctx *gofr.Context) (interface{}, error) {
+	idString := strings.TrimSpace(ctx.PathParam("id"))
+
+	id, err := strconv.Atoi(idString)
+	if err != nil {
+		return nil, errors.New("invalid id")
+	}
+
+	var category *models.Category
+
+	err = ctx.Bind(&category)
+	if err != nil {
+		return nil, errors.New("bind error")
+	}
+
+	category.ID = id
+
+	updatedCategory, err := h.categorySvc.Update(ctx, category)
+	if err != nil {
+		return nil, err
+	}
+
+	return updatedCategory, nil
+}
+
+func (h *categories) Delete(ctx *gofr.Context) (interface{}, error) {
+	idString := strings.TrimSpace(ctx.PathParam("id"))
+
+	id, err := strconv.Atoi(idString)
+	if err != nil {
+		return nil, errors.New("invalid id")
+	}
+
+	err = h.categorySvc.Delete(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return "category deleted successfully", nil
+}
+
+func (h *categories) Merge(ctx *gofr.Context) (interface{}, error) {
+	idString := strings.TrimSpace(ctx.PathParam("id"))
+
+	id, err := strconv.Atoi(idString)
+	if err != nil {
+		return nil, errors.New("invalid id")
+	}
+
+	var merge models.CategoryMerge
+
+	err = ctx.Bind(&merge)
+	if err != nil {
+		return nil, errors.New("bind error")
+	}
+
+	if merge.TargetID == 0 {
+		return nil, errors.New("targetID is required")
+	}
+
+	target, err := h.categorySvc.Merge(ctx, id, merge.TargetID)
+	if err != nil {
+		return nil, err
+	}
+
+	return target, nil
+}
@@ -0,0 +1,344 @@
+package categories
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	gofrHTTP "gofr.dev/pkg/gofr/http"
+	"moneyManagement/filters"
+	"moneyManagement/models"
+	"moneyManagement/services"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func Test_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	categorySvc := services.NewMockCategories(ctrl)
+
+	category := &models.Category{ID: 1, UserID: 1, Name: "Coffee", Type: models.EXPENSE, Icon: "cup", Color: "#a1b2c3"}
+	parentID := 4
+	fuel := &models.Category{ID: 5, UserID: 1, ParentID: &parentID, Name: "Fuel", Type: models.EXPENSE}
+
+	tests := []struct {
+		description    string
+		body           []byte
+		expectedOutput interface{}
+		expectedErr    error
+		execMocks      func(ctx *gofr.Context)
+	}{
+		{"Success Case", []byte(`{"name":"Coffee","type":"EXPENSE","icon":"cup","color":"#a1b2c3"}`), category, nil,
+			func(ctx *gofr.Context) {
+				categorySvc.EXPECT().Create(ctx, &models.Category{Name: "Coffee", Type: models.EXPENSE, Icon: "cup",
+					Color: "#a1b2c3"}).Return(category, nil)
+			}},
+		{"Success Case: sub-category", []byte(`{"name":"Fuel","type":"EXPENSE","parentID":4}`), fuel, nil,
+			func(ctx *gofr.Context) {
+				categorySvc.EXPECT().Create(ctx, &models.Category{Name: "Fuel", Type: models.EXPENSE, ParentID: &parentID}).
+					Return(fuel, nil)
+			}},
+		{"Failure Case: Error from service layer", []byte(`{"name":"Coffee","type":"EXPENSE","icon":"cup","color":"#a1b2c3"}`),
+			nil, errors.New("category already exists"),
+			func(ctx *gofr.Context) {
+				categorySvc.EXPECT().Create(ctx, &models.Category{Name: "Coffee", Type: models.EXPENSE, Icon: "cup",
+					Color: "#a1b2c3"}).Return(nil, errors.New("category already exists"))
+			}},
+		{"Failure Case: bind error", []byte(`{`), nil, errors.New("bind error"), func(ctx *gofr.Context) {
+		}},
+	}
+
+	for i, tc := range tests {
+		i := i
+		tc := tc
+
+		t.Run(tc.description, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/category", bytes.NewBuffer(tc.body))
+			req.Header.Set("Content-Type", "application/json")
+			gofrReq := gofrHTTP.NewRequest(req)
+			mockContainer, _ := container.NewMockContainer(t)
+			ctx := gofr.Context{Context: context.Background(), Request: gofrReq, Container: mockContainer, Out: nil}
+
+			tc.execMocks(&ctx)
+
+			h := New(categorySvc)
+
+			output, err := h.Create(&ctx)
+
+			assert.Equalf(t, tc.expectedOutput, output, "TEST[%d], failed.\n%s", i, tc.description)
+			assert.Equalf(t, tc.expectedErr, err, "TEST[%d], failed.\n%s", i, tc.description)
+		})
+	}
+}
+
+func Test_GetAll(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	categorySvc := services.NewMockCategories(ctrl)
+
+	category := &models.Category{ID: 1, UserID: 1, Name: "Coffee", Type: models.EXPENSE}
+
+	tests := []struct {
+		description    string
+		query          url.Values
+		expectedOutput interface{}
+		expectedErr    error
+		execMocks      func(ctx *gofr.Context)
+	}{
+		{"Success Case", url.Values{}, []*models.Category{category}, nil,
+			func(ctx *gofr.Context) {
+				categorySvc.EXPECT().GetAll(ctx, &filters.Category{}).Return([]*models.Category{category}, nil)
+			}},
+		{"Success Case: type and archived filters", url.Values{"type": {"EXPENSE,SAVINGS"}, "includeArchived": {"true"}},
+			[]*models.Category{category}, nil,
+			func(ctx *gofr.Context) {
+				categorySvc.EXPECT().GetAll(ctx, &filters.Category{Type: []string{"EXPENSE", "SAVINGS"}, IncludeArchived: true}).
+					Return([]*models.Category{category}, nil)
+			}},
+		{"Failure Case: invalid includeArchived", url.Values{"includeArchived": {"maybe"}}, nil,
+			errors.New("invalid includeArchived"), func(ctx *gofr.Context) {
+			}},
+		{"Failure Case: Error from service layer", url.Values{}, nil, errors.New("error"),
+			func(ctx *gofr.Context) {
+				categorySvc.EXPECT().GetAll(ctx, &filters.Category{}).Return(nil, errors.New("error"))
+			}},
+	}
+
+	for i, tc := range tests {
+		i := i
+		tc := tc
+
+		t.Run(tc.description, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/category", nil)
+			req.Header.Set("Content-Type", "application/json")
+			req.URL.RawQuery = tc.query.Encode()
+
+			gofrReq := gofrHTTP.NewRequest(req)
+			mockContainer, _ := container.NewMockContainer(t)
+			ctx := gofr.Context{Context: context.Background(), Request: gofrReq, Container: mockContainer, Out: nil}
+
+			tc.execMocks(&ctx)
+
+			h := New(categorySvc)
+
+			output, err := h.GetAll(&ctx)
+
+			assert.Equalf(t, tc.expectedOutput, output, "TEST[%d], failed.\n%s", i, tc.description)
+			assert.Equalf(t, tc.expectedErr, err, "TEST[%d], failed.\n%s", i, tc.description)
+		})
+	}
+}
+
+func Test_GetByID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	categorySvc := services.NewMockCategories(ctrl)
+
+	category := &models.Category{ID: 1, UserID: 1, Name: "Coffee", Type: models.EXPENSE}
+
+	tests := []struct {
+		description    string
+		id             string
+		expectedOutput interface{}
+		expectedErr    error
+		execMocks      func(ctx *gofr.Context)
+	}{
+		{"Success Case", "1", category, nil,
+			func(ctx *gofr.Context) {
+				categorySvc.EXPECT().GetByID(ctx, 1).Return(category, nil)
+			}},
+		{"Failure Case: Error from service layer", "1", nil, errors.New("error"),
+			func(ctx *gofr.Context) {
+				categorySvc.EXPECT().GetByID(ctx, 1).Return(nil, errors.New("error"))
+			}},
+		{"Failure Case: invalid id", "!", nil, errors.New("invalid id"), func(ctx *gofr.Context) {
+		}},
+	}
+
+	for i, tc := range tests {
+		i := i
+		tc := tc
+
+		t.Run(tc.description, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/category", nil)
+			req.Header.Set("Content-Type", "application/json")
+			req = mux.SetURLVars(req, map[string]string{"id": tc.id})
+
+			gofrReq := gofrHTTP.NewRequest(req)
+			mockContainer, _ := container.NewMockContainer(t)
+			ctx := gofr.Context{Context: context.Background(), Request: gofrReq, Container: mockContainer, Out: nil}
+
+			tc.execMocks(&ctx)
+
+			h := New(categorySvc)
+
+			output, err := h.GetByID(&ctx)
+
+			assert.Equalf(t, tc.expectedOutput, output, "TEST[%d], failed.\n%s", i, tc.description)
+			assert.Equalf(t, tc.expectedErr, err, "TEST[%d], failed.\n%s", i, tc.description)
+		})
+	}
+}
+
+func Test_Update(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	categorySvc := services.NewMockCategories(ctrl)
+
+	category := &models.Category{ID: 1, Name: "Cafe", Archived: true}
+
+	tests := []struct {
+		description    string
+		id             string
+		body           []byte
+		expectedOutput interface{}
+		expectedErr    error
+		execMocks      func(ctx *gofr.Context)
+	}{
+		{"Success Case", "1", []byte(`{"name":"Cafe","archived":true}`), category, nil,
+			func(ctx *gofr.Context) {
+				categorySvc.EXPECT().Update(ctx, category).Return(category, nil)
+			}},
+		{"Failure Case: Error from service layer", "1", []byte(`{"name":"Cafe","archived":true}`), nil,
+			errors.New("category not found"),
+			func(ctx *gofr.Context) {
+				categorySvc.EXPECT().Update(ctx, category).Return(nil, errors.New("category not found"))
+			}},
+		{"Failure Case: bind error", "1", []byte(`{`), nil, errors.New("bind error"), func(ctx *gofr.Context) {
+		}},
+		{"Failure Case: invalid id", "!", []byte(`{`), nil, errors.New("invalid id"), func(ctx *gofr.Context) {
+		}},
+	}
+
+	for i, tc := range tests {
+		i := i
+		tc := tc
+
+		t.Run(tc.description, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPut, "/category", bytes.NewBuffer(tc.body))
+			req.Header.Set("Content-Type", "application/json")
+			req = mux.SetURLVars(req, map[string]string{"id": tc.id})
+
+			gofrReq := gofrHTTP.NewRequest(req)
+			mockContainer, _ := container.NewMockContainer(t)
+			ctx := gofr.Context{Context: context.Background(), Request: gofrReq, Container: mockContainer, Out: nil}
+
+			tc.execMocks(&ctx)
+
+			h := New(categorySvc)
+
+			output, err := h.Update(&ctx)
+
+			assert.Equalf(t, tc.expectedOutput, output, "TEST[%d], failed.\n%s", i, tc.description)
+			assert.Equalf(t, tc.expectedErr, err, "TEST[%d], failed.\n%s", i, tc.description)
+		})
+	}
+}
+
+func Test_Delete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	categorySvc := services.NewMockCategories(ctrl)
+
+	tests := []struct {
+		description    string
+		id             string
+		expectedOutput interface{}
+		expectedErr    error
+		execMocks      func(ctx *gofr.Context)
+	}{
+		{"Success Case", "1", "category deleted successfully", nil,
+			func(ctx *gofr.Context) {
+				categorySvc.EXPECT().Delete(ctx, 1).Return(nil)
+			}},
+		{"Failure Case: Error from service layer", "1", nil, errors.New("error"),
+			func(ctx *gofr.Context) {
+				categorySvc.EXPECT().Delete(ctx, 1).Return(errors.New("error"))
+			}},
+		{"Failure Case: invalid id", "!", nil, errors.New("invalid id"), func(ctx *gofr.Context) {
+		}},
+	}
+
+	for i, tc := range tests {
+		i := i
+		tc := tc
+
+		t.Run(tc.description, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodDelete, "/category", nil)
+			req.Header.Set("Content-Type", "application/json")
+			req = mux.SetURLVars(req, map[string]string{"id": tc.id})
+
+			gofrReq := gofrHTTP.NewRequest(req)
+			mockContainer, _ := container.NewMockContainer(t)
+			ctx := gofr.Context{Context: context.Background(), Request: gofrReq, Container: mockContainer, Out: nil}
+
+			tc.execMocks(&ctx)
+
+			h := New(categorySvc)
+
+			output, err := h.Delete(&ctx)
+
+			assert.Equalf(t, tc.expectedOutput, output, "TEST[%d], failed.\n%s", i, tc.description)
+			assert.Equalf(t, tc.expectedErr, err, "TEST[%d], failed.\n%s", i, tc.description)
+		})
+	}
+}
+
+func Test_Merge(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	categorySvc := services.NewMockCategories(ctrl)
+
+	target := &models.Category{ID: 2, UserID: 1, Name: "Dining Out", Type: models.EXPENSE}
+
+	tests := []struct {
+		description    string
+		id             string
+		body           []byte
+		expectedOutput interface{}
+		expectedErr    error
+		execMocks      func(ctx *gofr.Context)
+	}{
+		{"Success Case", "1", []byte(`{"targetID":2}`), target, nil,
+			func(ctx *gofr.Context) {
+				categorySvc.EXPECT().Merge(ctx, 1, 2).Return(target, nil)
+			}},
+		{"Failure Case: Error from service layer", "1", []byte(`{"targetID":2}`), nil,
+			errors.New("categories of different types cannot be merged"),
+			func(ctx *gofr.Context) {
+				categorySvc.EXPECT().Merge(ctx, 1, 2).Return(nil, errors.New("categories of different types cannot be merged"))
+			}},
+		{"Failure Case: missing target", "1", []byte(`{}`), nil, errors.New("targetID is required"),
+			func(ctx *gofr.Context) {
+			}},
+		{"Failure Case: bind error", "1", []byte(`{`), nil, errors.New("bind error"), func(ctx *gofr.Context) {
+		}},
+		{"Failure Case: invalid id", "!", []byte(`{"targetID":2}`), nil, errors.New("invalid id"), func(ctx *gofr.Context) {
+		}},
+	}
+
+	for i, tc := range tests {
+		i := i
+		tc := tc
+
+		t.Run(tc.description, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/category/merge", bytes.NewBuffer(tc.body))
+			req.Header.Set("Content-Type", "application/json")
+			req = mux.SetURLVars(req, map[string]string{"id": tc.id})
+
+			gofrReq := gofrHTTP.NewRequest(req)
+			mockContainer, _ := container.NewMockContainer(t)
+			ctx := gofr.Context{Context: context.Background(), Request: gofrReq, Container: mockContainer, Out: nil}
+
+			tc.execMocks(&ctx)
+
+			h := New(categorySvc)
+
+			output, err := h.Merge(&ctx)
+
+			assert.Equalf(t, tc.expectedOutput, output, "TEST[%d], failed.\n%s", i, tc.description)
+			assert.Equalf(t, tc.expectedErr, err, "TEST[%d], failed.\n%s", i, tc.description)
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package creditCards
+
+import (
+	"errors"
+	"moneyManagement/handler"
+	"moneyManagement/services"
+	"strconv"
+	"strings"
+
+	"gofr.dev/pkg/gofr"
+)
+
+type creditCards struct {
+	creditCardSvc services.CreditCards
+}
+
+func New(creditCardSvc services.CreditCards) handler.CreditCards {
+	return &creditCards{creditCardSvc: creditCardSvc}
+}
+
+// Statements lists the billing cycles of the credit card account in the path, the last `count` of them.
+func (h *creditCards) Statements(ctx *gofr.Context) (interface{}, error) {
+	id, err := strconv.Atoi(strings.TrimSpace(ctx.PathParam("id")))
+	if err != nil {
+		return nil, errors.New("invalid id")
+	}
+
+	var count int
+
+	if countString := strings.TrimSpace(ctx.Param("count")); countString != "" {
+		count, err = strconv.Atoi(countString)
+		if err != nil {
+			return nil, errors.New("invalid count")
+		}
+	}
+
+	statements, err := h.creditCardSvc.Statements(ctx, id, count)
+	if err != nil {
+		return nil, err
+	}
+
+	return statements, nil
+}
@@ -0,0 +1,79 @@
+package creditCards
+
+import (
+	"context"
+	"errors"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	gofrHTTP "gofr.dev/pkg/gofr/http"
+	"moneyManagement/models"
+	"moneyManagement/services"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func Test_Statements(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	creditCardSvc := services.NewMockCreditCards(ctrl)
+
+	statements := &models.CreditCardStatements{Account: models.AccountDetails{ID: 3, Name: "Visa"}, Currency: "INR",
+		Limit: models.NewMoney(50000), Outstanding: models.NewMoney(12000), AvailableCredit: models.NewMoney(38000),
+		Utilisation: 24, StatementBalance: models.NewMoney(9000), MinimumDue: models.NewMoney(450), DueDate: "2025-07-05",
+		Statements: []models.CreditCardStatement{{StartDate: "2025-05-16", CloseDate: "2025-06-15", DueDate: "2025-07-05",
+			Charges: models.NewMoney(9000), Balance: models.NewMoney(9000), MinimumDue: models.NewMoney(450)}}}
+
+	tests := []struct {
+		description    string
+		id             string
+		query          url.Values
+		expectedOutput interface{}
+		expectedErr    error
+		execMocks      func(ctx *gofr.Context)
+	}{
+		{"Success Case", "3", url.Values{"count": {"1"}}, statements, nil, func(ctx *gofr.Context) {
+			creditCardSvc.EXPECT().Statements(ctx, 3, 1).Return(statements, nil)
+		}},
+		{"Success Case: default count", "3", url.Values{}, statements, nil, func(ctx *gofr.Context) {
+			creditCardSvc.EXPECT().Statements(ctx, 3, 0).Return(statements, nil)
+		}},
+		{"Failure Case: Error from service layer", "3", url.Values{}, nil, errors.New("account is not a credit card"),
+			func(ctx *gofr.Context) {
+				creditCardSvc.EXPECT().Statements(ctx, 3, 0).Return(nil, errors.New("account is not a credit card"))
+			}},
+		{"Failure Case: invalid count", "3", url.Values{"count": {"all"}}, nil, errors.New("invalid count"),
+			func(ctx *gofr.Context) {
+			}},
+		{"Failure Case: invalid id", "abc", url.Values{}, nil, errors.New("invalid id"), func(ctx *gofr.Context) {
+		}},
+	}
+
+	for i, tc := range tests {
+		i := i
+		tc := tc
+
+		t.Run(tc.description, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/account/statements", nil)
+			req.Header.Set("Content-Type", "application/json")
+			req.URL.RawQuery = tc.query.Encode()
+			req = mux.SetURLVars(req, map[string]string{"id": tc.id})
+
+			gofrReq := gofrHTTP.NewRequest(req)
+			mockContainer, _ := container.NewMockContainer(t)
+			ctx := gofr.Context{Context: context.Background(), Request: gofrReq, Container: mockContainer, Out: nil}
+
+			tc.execMocks(&ctx)
+
+			h := New(creditCardSvc)
+
+			output, err := h.Statements(&ctx)
+
+			assert.Equalf(t, tc.expectedOutput, output, "TEST[%d], failed.\n%s", i, tc.description)
+			assert.Equalf(t, tc.expectedErr, err, "TEST[%d], failed.\n%s", i, tc.description)
+		})
+	}
+}
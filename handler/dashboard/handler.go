@@ -5,8 +5,10 @@ import (
 	"gofr.dev/pkg/gofr"
 	"moneyManagement/filters"
 	"moneyManagement/handler"
+	"moneyManagement/models"
 	"moneyManagement/services"
 	"strconv"
+	"strings"
 )
 
 type dashboardHandler struct {
@@ -17,17 +19,55 @@ func New(dashboardSvc services.Dashboard) handler.Dashboard {
 	return &dashboardHandler{dashboardSvc: dashboardSvc}
 }
 
+// Get returns the dashboard of one `accountId`, of several given as a comma separated list, or of all accounts when
+// it is left out, optionally compared with the period given by `compareTo`.
 func (h *dashboardHandler) Get(ctx *gofr.Context) (interface{}, error) {
 	startDate := ctx.Params("startDate")
 	endDate := ctx.Params("endDate")
-	accountID := ctx.Params("accountId")
 
-	id, err := strconv.Atoi(accountID[0])
-	if err != nil {
-		return nil, errors.New("invalid id")
+	if len(startDate) == 0 || len(endDate) == 0 {
+		return nil, errors.New("startDate and endDate are required")
+	}
+
+	var (
+		ids []int
+		err error
+	)
+
+	for _, accountID := range ctx.Params("accountId") {
+		if strings.TrimSpace(accountID) == "" {
+			continue
+		}
+
+		id, err := strconv.Atoi(strings.TrimSpace(accountID))
+		if err != nil {
+			return nil, errors.New("invalid id")
+		}
+
+		ids = append(ids, id)
 	}
 
-	f := &filters.Transactions{AccountID: id, StartDate: startDate[0] + " 00:00:00", EndDate: endDate[0] + " 23:59:59"}
+	f := &filters.Transactions{StartDate: startDate[0] + " 00:00:00", EndDate: endDate[0] + " 23:59:59"}
+
+	if len(ids) == 1 {
+		f.AccountID = ids[0]
+	} else {
+		f.AccountIDs = ids
+	}
+
+	switch compareTo := ctx.Param("compareTo"); compareTo {
+	case "", models.ComparePreviousPeriod, models.ComparePreviousYear:
+		f.CompareTo = compareTo
+	default:
+		return nil, errors.New("invalid compareTo, use previousPeriod or previousYear")
+	}
+
+	if depth := ctx.Param("depth"); depth != "" {
+		f.Depth, err = strconv.Atoi(depth)
+		if err != nil || f.Depth < 0 {
+			return nil, errors.New("invalid depth")
+		}
+	}
 
 	dashboard, err := h.dashboardSvc.Get(ctx, f)
 	if err != nil {
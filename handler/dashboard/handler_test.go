@@ -21,25 +21,44 @@ func Test_Get(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	dashboardSvc := services.NewMockDashboard(ctrl)
 
-	dashboard := models.Dashboard{TotalIncome: 200, TotalExpense: 100, TotalSavings: 100}
+	dashboard := models.Dashboard{TotalIncome: models.NewMoney(200), TotalExpense: models.NewMoney(100), TotalSavings: models.NewMoney(100)}
 
 	tests := []struct {
 		description    string
 		id             string
+		depth          string
+		compareTo      string
 		expectedOutput interface{}
 		expectedErr    error
 		execMocks      func(ctx *gofr.Context)
 	}{
-		{"Success Case", "1", dashboard, nil,
+		{"Success Case", "1", "", "", dashboard, nil,
 			func(ctx *gofr.Context) {
 				dashboardSvc.EXPECT().Get(ctx, &filters.Transactions{AccountID: 1, StartDate: "01-01-2025 00:00:00", EndDate: "30-01-2025 23:59:59"}).Return(dashboard, nil)
 			}},
-		{"Failure Case: Error from service layer", "1", nil, errors.New("error"),
+		{"Success Case: breakdowns at a depth", "1", "2", "", dashboard, nil,
+			func(ctx *gofr.Context) {
+				dashboardSvc.EXPECT().Get(ctx, &filters.Transactions{AccountID: 1, StartDate: "01-01-2025 00:00:00", EndDate: "30-01-2025 23:59:59", Depth: 2}).Return(dashboard, nil)
+			}},
+		{"Success Case: all accounts", "", "", "", dashboard, nil,
+			func(ctx *gofr.Context) {
+				dashboardSvc.EXPECT().Get(ctx, &filters.Transactions{StartDate: "01-01-2025 00:00:00", EndDate: "30-01-2025 23:59:59"}).Return(dashboard, nil)
+			}},
+		{"Success Case: several accounts compared with the previous year", "1,2", "", "previousYear", dashboard, nil,
+			func(ctx *gofr.Context) {
+				dashboardSvc.EXPECT().Get(ctx, &filters.Transactions{AccountIDs: []int{1, 2}, StartDate: "01-01-2025 00:00:00", EndDate: "30-01-2025 23:59:59", CompareTo: "previousYear"}).Return(dashboard, nil)
+			}},
+		{"Failure Case: Error from service layer", "1", "", "", nil, errors.New("error"),
 			func(ctx *gofr.Context) {
 				dashboardSvc.EXPECT().Get(ctx, &filters.Transactions{AccountID: 1, StartDate: "01-01-2025 00:00:00", EndDate: "30-01-2025 23:59:59"}).Return(models.Dashboard{}, errors.New("error"))
 			}},
-		{"Failure Case: invalid id", "!", nil, errors.New("invalid id"), func(ctx *gofr.Context) {
+		{"Failure Case: invalid id", "!", "", "", nil, errors.New("invalid id"), func(ctx *gofr.Context) {
+		}},
+		{"Failure Case: invalid depth", "1", "-1", "", nil, errors.New("invalid depth"), func(ctx *gofr.Context) {
 		}},
+		{"Failure Case: invalid compareTo", "1", "", "lastWeek", nil,
+			errors.New("invalid compareTo, use previousPeriod or previousYear"), func(ctx *gofr.Context) {
+			}},
 	}
 
 	for i, tc := range tests {
@@ -50,6 +69,13 @@ func Test_Get(t *testing.T) {
 			req := httptest.NewRequest(http.MethodGet, "/dashboard", nil)
 			req.Header.Set("Content-Type", "application/json")
 			qParam := url.Values{"accountId": {tc.id}, "startDate": {"01-01-2025"}, "endDate": {"30-01-2025"}}
+			if tc.depth != "" {
+				qParam.Set("depth", tc.depth)
+			}
+
+			if tc.compareTo != "" {
+				qParam.Set("compareTo", tc.compareTo)
+			}
 
 			req.URL.RawQuery = qParam.Encode()
 
@@ -0,0 +1,90 @@
+package exchangeRates
+
+import (
+	"errors"
+	"mime/multipart"
+	"moneyManagement/filters"
+	"moneyManagement/handler"
+	"moneyManagement/models"
+	"moneyManagement/services"
+	"path/filepath"
+	"strings"
+
+	"gofr.dev/pkg/gofr"
+)
+
+type importRequest struct {
+	File   *multipart.FileHeader `file:"file"`
+	Format string                `form:"format"`
+}
+
+type exchangeRates struct {
+	exchangeRateSvc services.ExchangeRates
+}
+
+func New(exchangeRateSvc services.ExchangeRates) handler.ExchangeRates {
+	return &exchangeRates{exchangeRateSvc: exchangeRateSvc}
+}
+
+func (h *exchangeRates) Create(ctx *gofr.Context) (interface{}, error) {
+	var rates []*models.ExchangeRate
+
+	err := ctx.Bind(&rates)
+	if err != nil {
+		return nil, errors.New("bind error")
+	}
+
+	newRates, err := h.exchangeRateSvc.Create(ctx, rates)
+	if err != nil {
+		return nil, err
+	}
+
+	return newRates, nil
+}
+
+// Import loads a CSV or JSON file of exchange rates. The format defaults to the extension of the file.
+func (h *exchangeRates) Import(ctx *gofr.Context) (interface{}, error) {
+	var req importRequest
+
+	err := ctx.Bind(&req)
+	if err != nil {
+		return nil, errors.New("bind error")
+	}
+
+	if req.File == nil {
+		return nil, errors.New("missing file")
+	}
+
+	format := req.Format
+	if format == "" {
+		format = strings.TrimPrefix(filepath.Ext(req.File.Filename), ".")
+	}
+
+	file, err := req.File.Open()
+	if err != nil {
+		return nil, errors.New("invalid file")
+	}
+
+	defer file.Close()
+
+	rates, err := h.exchangeRateSvc.Import(ctx, file, format)
+	if err != nil {
+		return nil, err
+	}
+
+	return rates, nil
+}
+
+func (h *exchangeRates) GetAll(ctx *gofr.Context) (interface{}, error) {
+	f := filters.ExchangeRate{
+		From: strings.ToUpper(strings.TrimSpace(ctx.Param("from"))),
+		To:   strings.ToUpper(strings.TrimSpace(ctx.Param("to"))),
+	}
+
+	rates, err := h.exchangeRateSvc.GetAll(ctx, &f)
+	if err != nil {
+		return nil, err
+	}
+
+	return rates, nil
+}
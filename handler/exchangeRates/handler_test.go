@@ -0,0 +1,189 @@
+package exchangeRates
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	gofrHTTP "gofr.dev/pkg/gofr/http"
+	"mime/multipart"
+	"moneyManagement/filters"
+	"moneyManagement/models"
+	"moneyManagement/services"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func Test_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	exchangeRateSvc := services.NewMockExchangeRates(ctrl)
+
+	rates := []*models.ExchangeRate{{From: "USD", To: "INR", Rate: 83.25, Date: "2025-05-01"}}
+
+	tests := []struct {
+		description    string
+		body           []byte
+		expectedOutput interface{}
+		expectedErr    error
+		execMocks      func(ctx *gofr.Context)
+	}{
+		{"Success Case", []byte(`[{"from":"USD","to":"INR","rate":83.25,"date":"2025-05-01"}]`), rates, nil,
+			func(ctx *gofr.Context) {
+				exchangeRateSvc.EXPECT().Create(ctx, rates).Return(rates, nil)
+			}},
+		{"Failure Case: Error from service layer", []byte(`[{"from":"USD","to":"INR","rate":83.25,"date":"2025-05-01"}]`),
+			nil, errors.New("error"),
+			func(ctx *gofr.Context) {
+				exchangeRateSvc.EXPECT().Create(ctx, rates).Return(nil, errors.New("error"))
+			}},
+		{"Failure Case: bind error", []byte(`{`), nil, errors.New("bind error"), func(ctx *gofr.Context) {
+		}},
+	}
+
+	for i, tc := range tests {
+		i := i
+		tc := tc
+
+		t.Run(tc.description, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/exchange-rate", bytes.NewBuffer(tc.body))
+			req.Header.Set("Content-Type", "application/json")
+			gofrReq := gofrHTTP.NewRequest(req)
+			mockContainer, _ := container.NewMockContainer(t)
+			ctx := gofr.Context{Context: context.Background(), Request: gofrReq, Container: mockContainer, Out: nil}
+
+			tc.execMocks(&ctx)
+
+			h := New(exchangeRateSvc)
+
+			output, err := h.Create(&ctx)
+
+			assert.Equalf(t, tc.expectedOutput, output, "TEST[%d], failed.\n%s", i, tc.description)
+			assert.Equalf(t, tc.expectedErr, err, "TEST[%d], failed.\n%s", i, tc.description)
+		})
+	}
+}
+
+func Test_Import(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	exchangeRateSvc := services.NewMockExchangeRates(ctrl)
+
+	csvFile := "from,to,rate,date\nUSD,INR,83.25,2025-05-01\n"
+	rates := []*models.ExchangeRate{{From: "USD", To: "INR", Rate: 83.25, Date: "2025-05-01"}}
+
+	tests := []struct {
+		description    string
+		file           string
+		fileName       string
+		format         string
+		expectedOutput interface{}
+		expectedErr    error
+		execMocks      func(ctx *gofr.Context)
+	}{
+		{"Success Case: format from the file extension", csvFile, "rates.csv", "", rates, nil,
+			func(ctx *gofr.Context) {
+				exchangeRateSvc.EXPECT().Import(ctx, gomock.Any(), "csv").Return(rates, nil)
+			}},
+		{"Success Case: explicit format", `[{"from":"USD","to":"INR","rate":83.25,"date":"2025-05-01"}]`, "rates.txt", "json",
+			rates, nil,
+			func(ctx *gofr.Context) {
+				exchangeRateSvc.EXPECT().Import(ctx, gomock.Any(), "json").Return(rates, nil)
+			}},
+		{"Failure Case: Error from service layer", csvFile, "rates.xml", "", nil, errors.New("unsupported file format, use csv or json"),
+			func(ctx *gofr.Context) {
+				exchangeRateSvc.EXPECT().Import(ctx, gomock.Any(), "xml").
+					Return(nil, errors.New("unsupported file format, use csv or json"))
+			}},
+		{"Failure Case: missing file", "", "", "", nil, errors.New("missing file"), func(ctx *gofr.Context) {
+		}},
+	}
+
+	for i, tc := range tests {
+		i := i
+		tc := tc
+
+		t.Run(tc.description, func(t *testing.T) {
+			body := &bytes.Buffer{}
+			writer := multipart.NewWriter(body)
+
+			if tc.file != "" {
+				part, _ := writer.CreateFormFile("file", tc.fileName)
+				_, _ = part.Write([]byte(tc.file))
+			}
+
+			_ = writer.WriteField("format", tc.format)
+			_ = writer.Close()
+
+			req := httptest.NewRequest(http.MethodPost, "/exchange-rate/import", body)
+			req.Header.Set("Content-Type", writer.FormDataContentType())
+			gofrReq := gofrHTTP.NewRequest(req)
+			mockContainer, _ := container.NewMockContainer(t)
+			ctx := gofr.Context{Context: context.Background(), Request: gofrReq, Container: mockContainer, Out: nil}
+
+			tc.execMocks(&ctx)
+
+			h := New(exchangeRateSvc)
+
+			output, err := h.Import(&ctx)
+
+			assert.Equalf(t, tc.expectedOutput, output, "TEST[%d], failed.\n%s", i, tc.description)
+			assert.Equalf(t, tc.expectedErr, err, "TEST[%d], failed.\n%s", i, tc.description)
+		})
+	}
+}
+
+func Test_GetAll(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	exchangeRateSvc := services.NewMockExchangeRates(ctrl)
+
+	rates := []*models.ExchangeRate{{ID: 1, From: "USD", To: "INR", Rate: 83.25, Date: "2025-05-01"}}
+
+	tests := []struct {
+		description    string
+		query          url.Values
+		expectedOutput interface{}
+		expectedErr    error
+		execMocks      func(ctx *gofr.Context)
+	}{
+		{"Success Case", url.Values{}, rates, nil,
+			func(ctx *gofr.Context) {
+				exchangeRateSvc.EXPECT().GetAll(ctx, &filters.ExchangeRate{}).Return(rates, nil)
+			}},
+		{"Success Case: currency filters", url.Values{"from": {"usd"}, "to": {"INR"}}, rates, nil,
+			func(ctx *gofr.Context) {
+				exchangeRateSvc.EXPECT().GetAll(ctx, &filters.ExchangeRate{From: "USD", To: "INR"}).Return(rates, nil)
+			}},
+		{"Failure Case: Error from service layer", url.Values{}, nil, errors.New("error"),
+			func(ctx *gofr.Context) {
+				exchangeRateSvc.EXPECT().GetAll(ctx, &filters.ExchangeRate{}).Return(nil, errors.New("error"))
+			}},
+	}
+
+	for i, tc := range tests {
+		i := i
+		tc := tc
+
+		t.Run(tc.description, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/exchange-rate", nil)
+			req.Header.Set("Content-Type", "application/json")
+			req.URL.RawQuery = tc.query.Encode()
+
+			gofrReq := gofrHTTP.NewRequest(req)
+			mockContainer, _ := container.NewMockContainer(t)
+			ctx := gofr.Context{Context: context.Background(), Request: gofrReq, Container: mockContainer, Out: nil}
+
+			tc.execMocks(&ctx)
+
+			h := New(exchangeRateSvc)
+
+			output, err := h.GetAll(&ctx)
+
+			assert.Equalf(t, tc.expectedOutput, output, "TEST[%d], failed.\n%s", i, tc.description)
+			assert.Equalf(t, tc.expectedErr, err, "TEST[%d], failed.\n%s", i, tc.description)
+		})
+	}
+}
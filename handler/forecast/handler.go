@@ -0,0 +1,54 @@
+package forecast
+
+import (
+	"errors"
+	"moneyManagement/handler"
+	"moneyManagement/models"
+	"moneyManagement/services"
+	"strconv"
+	"strings"
+
+	"gofr.dev/pkg/gofr"
+)
+
+type forecast struct {
+	forecastSvc services.Forecast
+}
+
+func New(forecastSvc services.Forecast) handler.Forecast {
+	return &forecast{forecastSvc: forecastSvc}
+}
+
+// Get projects the balance of the `account` over the next `days` days, alerting when it goes under `threshold`.
+func (h *forecast) Get(ctx *gofr.Context) (interface{}, error) {
+	accountID, err := strconv.Atoi(strings.TrimSpace(ctx.Param("account")))
+	if err != nil {
+		return nil, errors.New("invalid account")
+	}
+
+	var (
+		days      int
+		threshold models.Money
+	)
+
+	if daysString := strings.TrimSpace(ctx.Param("days")); daysString != "" {
+		days, err = strconv.Atoi(daysString)
+		if err != nil {
+			return nil, errors.New("invalid days")
+		}
+	}
+
+	if thresholdString := strings.TrimSpace(ctx.Param("threshold")); thresholdString != "" {
+		threshold, err = models.ParseMoney(thresholdString)
+		if err != nil {
+			return nil, errors.New("invalid threshold")
+		}
+	}
+
+	result, err := h.forecastSvc.Get(ctx, accountID, days, threshold)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,88 @@
+package forecast
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	gofrHTTP "gofr.dev/pkg/gofr/http"
+	"moneyManagement/models"
+	"moneyManagement/services"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func Test_Get(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	forecastSvc := services.NewMockForecast(ctrl)
+
+	forecast := &models.Forecast{Account: models.AccountDetails{ID: 1, Name: "HDFC"}, Currency: "INR", From: "2025-06-02",
+		To: "2025-06-03", StartingBalance: models.NewMoney(500), Threshold: models.NewMoney(100),
+		DailyDiscretionary: models.NewMoney(50), LowestBalance: models.NewMoney(-1550), LowestDate: "2025-06-03",
+		Points: []models.ForecastPoint{
+			{Date: "2025-06-02", Balance: models.NewMoney(450), Change: models.NewMoney(-50)},
+			{Date: "2025-06-03", Balance: models.NewMoney(-1550), Change: models.NewMoney(-2000), Negative: true,
+				BelowThreshold: true, Items: []models.ForecastItem{{RecurringTransactionID: 4, Type: models.EXPENSE,
+					Category: "Rent", Amount: models.NewMoney(-1950)}}},
+		},
+		Alerts: []models.ForecastAlert{{Date: "2025-06-03", Reason: models.ForecastNegative,
+			Balance: models.NewMoney(-1550), Items: []models.ForecastItem{{RecurringTransactionID: 4,
+				Type: models.EXPENSE, Category: "Rent", Amount: models.NewMoney(-1950)}}}}}
+
+	tests := []struct {
+		description    string
+		query          url.Values
+		expectedOutput interface{}
+		expectedErr    error
+		execMocks      func(ctx *gofr.Context)
+	}{
+		{"Success Case", url.Values{"account": {"1"}, "days": {"2"}, "threshold": {"100"}}, forecast, nil,
+			func(ctx *gofr.Context) {
+				forecastSvc.EXPECT().Get(ctx, 1, 2, models.NewMoney(100)).Return(forecast, nil)
+			}},
+		{"Success Case: default days and threshold", url.Values{"account": {"1"}}, forecast, nil,
+			func(ctx *gofr.Context) {
+				forecastSvc.EXPECT().Get(ctx, 1, 0, models.Money(0)).Return(forecast, nil)
+			}},
+		{"Failure Case: Error from service layer", url.Values{"account": {"9"}}, nil, errors.New("account not found"),
+			func(ctx *gofr.Context) {
+				forecastSvc.EXPECT().Get(ctx, 9, 0, models.Money(0)).Return(nil, errors.New("account not found"))
+			}},
+		{"Failure Case: missing account", url.Values{}, nil, errors.New("invalid account"), func(ctx *gofr.Context) {
+		}},
+		{"Failure Case: invalid days", url.Values{"account": {"1"}, "days": {"soon"}}, nil, errors.New("invalid days"),
+			func(ctx *gofr.Context) {
+			}},
+		{"Failure Case: invalid threshold", url.Values{"account": {"1"}, "threshold": {"lots"}}, nil,
+			errors.New("invalid threshold"), func(ctx *gofr.Context) {
+			}},
+	}
+
+	for i, tc := range tests {
+		i := i
+		tc := tc
+
+		t.Run(tc.description, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/forecast", nil)
+			req.Header.Set("Content-Type", "application/json")
+			req.URL.RawQuery = tc.query.Encode()
+
+			gofrReq := gofrHTTP.NewRequest(req)
+			mockContainer, _ := container.NewMockContainer(t)
+			ctx := gofr.Context{Context: context.Background(), Request: gofrReq, Container: mockContainer, Out: nil}
+
+			tc.execMocks(&ctx)
+
+			h := New(forecastSvc)
+
+			output, err := h.Get(&ctx)
+
+			assert.Equalf(t, tc.expectedOutput, output, "TEST[%d], failed.\n%s", i, tc.description)
+			assert.Equalf(t, tc.expectedErr, err, "TEST[%d], failed.\n%s", i, tc.description)
+		})
+	}
+}
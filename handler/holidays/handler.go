@@ -0,0 +1,60 @@
+package holidays
+
+import (
+	"errors"
+	"gofr.dev/pkg/gofr"
+	"moneyManagement/handler"
+	"moneyManagement/models"
+	"moneyManagement/services"
+	"strconv"
+	"strings"
+)
+
+type holidaysHandler struct {
+	holidaySvc services.Holidays
+}
+
+func New(holidaySvc services.Holidays) handler.Holidays {
+	return &holidaysHandler{holidaySvc: holidaySvc}
+}
+
+func (h *holidaysHandler) Create(ctx *gofr.Context) (interface{}, error) {
+	var holiday *models.Holiday
+
+	err := ctx.Bind(&holiday)
+	if err != nil {
+		return nil, errors.New("bind error")
+	}
+
+	newHoliday, err := h.holidaySvc.Create(ctx, holiday)
+	if err != nil {
+		return nil, err
+	}
+
+	return newHoliday, nil
+}
+
+func (h *holidaysHandler) GetAll(ctx *gofr.Context) (interface{}, error) {
+	holidays, err := h.holidaySvc.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return holidays, nil
+}
+
+func (h *holidaysHandler) Delete(ctx *gofr.Context) (interface{}, error) {
+	idString := strings.TrimSpace(ctx.PathParam("id"))
+
+	id, err := strconv.Atoi(idString)
+	if err != nil {
+		return nil, errors.New("invalid id")
+	}
+
+	err = h.holidaySvc.Delete(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return "holiday deleted successfully", nil
+}
@@ -0,0 +1,115 @@
+package holidays
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	gofrHTTP "gofr.dev/pkg/gofr/http"
+	"moneyManagement/models"
+	"moneyManagement/services"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func Test_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	holidaySvc := services.NewMockHolidays(ctrl)
+
+	holiday := &models.Holiday{ID: 1, UserID: 1, Date: "2025-12-25", Name: "Christmas"}
+
+	tests := []struct {
+		description    string
+		body           []byte
+		expectedOutput interface{}
+		expectedErr    error
+		execMocks      func(ctx *gofr.Context)
+	}{
+		{"Success Case", []byte(`{"date":"2025-12-25","name":"Christmas"}`), holiday, nil,
+			func(ctx *gofr.Context) {
+				holidaySvc.EXPECT().Create(ctx, &models.Holiday{Date: "2025-12-25", Name: "Christmas"}).Return(holiday, nil)
+			}},
+		{"Failure Case: Error from service layer", []byte(`{"date":"2025-12-25"}`), nil,
+			errors.New("holiday already exists"),
+			func(ctx *gofr.Context) {
+				holidaySvc.EXPECT().Create(ctx, &models.Holiday{Date: "2025-12-25"}).
+					Return(nil, errors.New("holiday already exists"))
+			}},
+		{"Failure Case: bind error", []byte(`{`), nil, errors.New("bind error"), func(ctx *gofr.Context) {
+		}},
+	}
+
+	for i, tc := range tests {
+		i := i
+		tc := tc
+
+		t.Run(tc.description, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/holiday", bytes.NewBuffer(tc.body))
+			req.Header.Set("Content-Type", "application/json")
+			gofrReq := gofrHTTP.NewRequest(req)
+			mockContainer, _ := container.NewMockContainer(t)
+			ctx := gofr.Context{Context: context.Background(), Request: gofrReq, Container: mockContainer, Out: nil}
+
+			tc.execMocks(&ctx)
+
+			h := New(holidaySvc)
+
+			output, err := h.Create(&ctx)
+
+			assert.Equalf(t, tc.expectedOutput, output, "TEST[%d], failed.\n%s", i, tc.description)
+			assert.Equalf(t, tc.expectedErr, err, "TEST[%d], failed.\n%s", i, tc.description)
+		})
+	}
+}
+
+func Test_Delete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	holidaySvc := services.NewMockHolidays(ctrl)
+
+	tests := []struct {
+		description    string
+		id             string
+		expectedOutput interface{}
+		expectedErr    error
+		execMocks      func(ctx *gofr.Context)
+	}{
+		{"Success Case", "1", "holiday deleted successfully", nil,
+			func(ctx *gofr.Context) {
+				holidaySvc.EXPECT().Delete(ctx, 1).Return(nil)
+			}},
+		{"Failure Case: Error from service layer", "2", nil, errors.New("holiday not found"),
+			func(ctx *gofr.Context) {
+				holidaySvc.EXPECT().Delete(ctx, 2).Return(errors.New("holiday not found"))
+			}},
+		{"Failure Case: invalid id", "!", nil, errors.New("invalid id"), func(ctx *gofr.Context) {
+		}},
+	}
+
+	for i, tc := range tests {
+		i := i
+		tc := tc
+
+		t.Run(tc.description, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodDelete, "/holiday", nil)
+			req = mux.SetURLVars(req, map[string]string{"id": tc.id})
+
+			gofrReq := gofrHTTP.NewRequest(req)
+			mockContainer, _ := container.NewMockContainer(t)
+			ctx := gofr.Context{Context: context.Background(), Request: gofrReq, Container: mockContainer, Out: nil}
+
+			tc.execMocks(&ctx)
+
+			h := New(holidaySvc)
+
+			output, err := h.Delete(&ctx)
+
+			assert.Equalf(t, tc.expectedOutput, output, "TEST[%d], failed.\n%s", i, tc.description)
+			assert.Equalf(t, tc.expectedErr, err, "TEST[%d], failed.\n%s", i, tc.description)
+		})
+	}
+}
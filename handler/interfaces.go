@@ -18,6 +18,9 @@ type Account interface {
 	GetAll(ctx *gofr.Context) (interface{}, error)
 	Update(ctx *gofr.Context) (interface{}, error)
 	Delete(ctx *gofr.Context) (interface{}, error)
+	BalanceHistory(ctx *gofr.Context) (interface{}, error)
+	CheckBalances(ctx *gofr.Context) (interface{}, error)
+	RepairBalances(ctx *gofr.Context) (interface{}, error)
 }
 
 type Transactions interface {
@@ -26,6 +29,7 @@ type Transactions interface {
 	GetByID(ctx *gofr.Context) (interface{}, error)
 	Update(ctx *gofr.Context) (interface{}, error)
 	Delete(ctx *gofr.Context) (interface{}, error)
+	Import(ctx *gofr.Context) (interface{}, error)
 }
 
 type Savings interface {
@@ -52,4 +56,79 @@ type RecurringTransactions interface {
 	GetByID(ctx *gofr.Context) (interface{}, error)
 	Update(ctx *gofr.Context) (interface{}, error)
 	Delete(ctx *gofr.Context) (interface{}, error)
+	Occurrences(ctx *gofr.Context) (interface{}, error)
+	Calendar(ctx *gofr.Context) (interface{}, error)
+	Pause(ctx *gofr.Context) (interface{}, error)
+	Resume(ctx *gofr.Context) (interface{}, error)
+	CreateException(ctx *gofr.Context) (interface{}, error)
+	DeleteException(ctx *gofr.Context) (interface{}, error)
+	Pending(ctx *gofr.Context) (interface{}, error)
+	ConfirmPending(ctx *gofr.Context) (interface{}, error)
+	RejectPending(ctx *gofr.Context) (interface{}, error)
+}
+
+type Statements interface {
+	Parse(ctx *gofr.Context) (interface{}, error)
+	Confirm(ctx *gofr.Context) (interface{}, error)
+}
+
+type Categories interface {
+	Create(ctx *gofr.Context) (interface{}, error)
+	GetByID(ctx *gofr.Context) (interface{}, error)
+	GetAll(ctx *gofr.Context) (interface{}, error)
+	Update(ctx *gofr.Context) (interface{}, error)
+	Delete(ctx *gofr.Context) (interface{}, error)
+	Merge(ctx *gofr.Context) (interface{}, error)
+}
+
+type Budgets interface {
+	Create(ctx *gofr.Context) (interface{}, error)
+	GetByID(ctx *gofr.Context) (interface{}, error)
+	GetAll(ctx *gofr.Context) (interface{}, error)
+	Update(ctx *gofr.Context) (interface{}, error)
+	Delete(ctx *gofr.Context) (interface{}, error)
+	Status(ctx *gofr.Context) (interface{}, error)
+}
+
+type ExchangeRates interface {
+	Create(ctx *gofr.Context) (interface{}, error)
+	Import(ctx *gofr.Context) (interface{}, error)
+	GetAll(ctx *gofr.Context) (interface{}, error)
+}
+
+type Reconciliations interface {
+	Create(ctx *gofr.Context) (interface{}, error)
+	GetByID(ctx *gofr.Context) (interface{}, error)
+	GetAll(ctx *gofr.Context) (interface{}, error)
+	Clear(ctx *gofr.Context) (interface{}, error)
+	Lock(ctx *gofr.Context) (interface{}, error)
+}
+
+type CreditCards interface {
+	Statements(ctx *gofr.Context) (interface{}, error)
+}
+
+type NetWorth interface {
+	Get(ctx *gofr.Context) (interface{}, error)
+}
+
+type Reports interface {
+	Trends(ctx *gofr.Context) (interface{}, error)
+}
+
+type Forecast interface {
+	Get(ctx *gofr.Context) (interface{}, error)
+}
+
+type CalendarFeeds interface {
+	Create(ctx *gofr.Context) (interface{}, error)
+	GetAll(ctx *gofr.Context) (interface{}, error)
+	Revoke(ctx *gofr.Context) (interface{}, error)
+	Feed(ctx *gofr.Context) (interface{}, error)
+}
+
+type Holidays interface {
+	Create(ctx *gofr.Context) (interface{}, error)
+	GetAll(ctx *gofr.Context) (interface{}, error)
+	Delete(ctx *gofr.Context) (interface{}, error)
 }
@@ -137,6 +137,36 @@ func (m *MockAccount) EXPECT() *MockAccountMockRecorder {
 	return m.recorder
 }
 
+// BalanceHistory mocks base method.
+func (m *MockAccount) BalanceHistory(ctx *gofr.Context) (any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BalanceHistory", ctx)
+	ret0, _ := ret[0].(any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BalanceHistory indicates an expected call of BalanceHistory.
+func (mr *MockAccountMockRecorder) BalanceHistory(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BalanceHistory", reflect.TypeOf((*MockAccount)(nil).BalanceHistory), ctx)
+}
+
+// CheckBalances mocks base method.
+func (m *MockAccount) CheckBalances(ctx *gofr.Context) (any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckBalances", ctx)
+	ret0, _ := ret[0].(any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckBalances indicates an expected call of CheckBalances.
+func (mr *MockAccountMockRecorder) CheckBalances(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckBalances", reflect.TypeOf((*MockAccount)(nil).CheckBalances), ctx)
+}
+
 // Create mocks base method.
 func (m *MockAccount) Create(ctx *gofr.Context) (any, error) {
 	m.ctrl.T.Helper()
@@ -197,6 +227,21 @@ func (mr *MockAccountMockRecorder) GetByID(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockAccount)(nil).GetByID), ctx)
 }
 
+// RepairBalances mocks base method.
+func (m *MockAccount) RepairBalances(ctx *gofr.Context) (any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RepairBalances", ctx)
+	ret0, _ := ret[0].(any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RepairBalances indicates an expected call of RepairBalances.
+func (mr *MockAccountMockRecorder) RepairBalances(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RepairBalances", reflect.TypeOf((*MockAccount)(nil).RepairBalances), ctx)
+}
+
 // Update mocks base method.
 func (m *MockAccount) Update(ctx *gofr.Context) (any, error) {
 	m.ctrl.T.Helper()
@@ -295,6 +340,21 @@ func (mr *MockTransactionsMockRecorder) GetByID(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockTransactions)(nil).GetByID), ctx)
 }
 
+// Import mocks base method.
+func (m *MockTransactions) Import(ctx *gofr.Context) (any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Import", ctx)
+	ret0, _ := ret[0].(any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Import indicates an expected call of Import.
+func (mr *MockTransactionsMockRecorder) Import(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockTransactions)(nil).Import), ctx)
+}
+
 // Update mocks base method.
 func (m *MockTransactions) Update(ctx *gofr.Context) (any, error) {
 	m.ctrl.T.Helper()
@@ -537,6 +597,36 @@ func (m *MockRecurringTransactions) EXPECT() *MockRecurringTransactionsMockRecor
 	return m.recorder
 }
 
+// Calendar mocks base method.
+func (m *MockRecurringTransactions) Calendar(ctx *gofr.Context) (any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Calendar", ctx)
+	ret0, _ := ret[0].(any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Calendar indicates an expected call of Calendar.
+func (mr *MockRecurringTransactionsMockRecorder) Calendar(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Calendar", reflect.TypeOf((*MockRecurringTransactions)(nil).Calendar), ctx)
+}
+
+// ConfirmPending mocks base method.
+func (m *MockRecurringTransactions) ConfirmPending(ctx *gofr.Context) (any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmPending", ctx)
+	ret0, _ := ret[0].(any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmPending indicates an expected call of ConfirmPending.
+func (mr *MockRecurringTransactionsMockRecorder) ConfirmPending(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmPending", reflect.TypeOf((*MockRecurringTransactions)(nil).ConfirmPending), ctx)
+}
+
 // Create mocks base method.
 func (m *MockRecurringTransactions) Create(ctx *gofr.Context) (any, error) {
 	m.ctrl.T.Helper()
@@ -552,6 +642,21 @@ func (mr *MockRecurringTransactionsMockRecorder) Create(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRecurringTransactions)(nil).Create), ctx)
 }
 
+// CreateException mocks base method.
+func (m *MockRecurringTransactions) CreateException(ctx *gofr.Context) (any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateException", ctx)
+	ret0, _ := ret[0].(any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateException indicates an expected call of CreateException.
+func (mr *MockRecurringTransactionsMockRecorder) CreateException(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateException", reflect.TypeOf((*MockRecurringTransactions)(nil).CreateException), ctx)
+}
+
 // Delete mocks base method.
 func (m *MockRecurringTransactions) Delete(ctx *gofr.Context) (any, error) {
 	m.ctrl.T.Helper()
@@ -567,6 +672,21 @@ func (mr *MockRecurringTransactionsMockRecorder) Delete(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRecurringTransactions)(nil).Delete), ctx)
 }
 
+// DeleteException mocks base method.
+func (m *MockRecurringTransactions) DeleteException(ctx *gofr.Context) (any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteException", ctx)
+	ret0, _ := ret[0].(any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteException indicates an expected call of DeleteException.
+func (mr *MockRecurringTransactionsMockRecorder) DeleteException(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteException", reflect.TypeOf((*MockRecurringTransactions)(nil).DeleteException), ctx)
+}
+
 // GetAll mocks base method.
 func (m *MockRecurringTransactions) GetAll(ctx *gofr.Context) (any, error) {
 	m.ctrl.T.Helper()
@@ -597,6 +717,81 @@ func (mr *MockRecurringTransactionsMockRecorder) GetByID(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockRecurringTransactions)(nil).GetByID), ctx)
 }
 
+// Occurrences mocks base method.
+func (m *MockRecurringTransactions) Occurrences(ctx *gofr.Context) (any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Occurrences", ctx)
+	ret0, _ := ret[0].(any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Occurrences indicates an expected call of Occurrences.
+func (mr *MockRecurringTransactionsMockRecorder) Occurrences(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Occurrences", reflect.TypeOf((*MockRecurringTransactions)(nil).Occurrences), ctx)
+}
+
+// Pause mocks base method.
+func (m *MockRecurringTransactions) Pause(ctx *gofr.Context) (any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pause", ctx)
+	ret0, _ := ret[0].(any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Pause indicates an expected call of Pause.
+func (mr *MockRecurringTransactionsMockRecorder) Pause(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pause", reflect.TypeOf((*MockRecurringTransactions)(nil).Pause), ctx)
+}
+
+// Pending mocks base method.
+func (m *MockRecurringTransactions) Pending(ctx *gofr.Context) (any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pending", ctx)
+	ret0, _ := ret[0].(any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Pending indicates an expected call of Pending.
+func (mr *MockRecurringTransactionsMockRecorder) Pending(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pending", reflect.TypeOf((*MockRecurringTransactions)(nil).Pending), ctx)
+}
+
+// RejectPending mocks base method.
+func (m *MockRecurringTransactions) RejectPending(ctx *gofr.Context) (any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RejectPending", ctx)
+	ret0, _ := ret[0].(any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RejectPending indicates an expected call of RejectPending.
+func (mr *MockRecurringTransactionsMockRecorder) RejectPending(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectPending", reflect.TypeOf((*MockRecurringTransactions)(nil).RejectPending), ctx)
+}
+
+// Resume mocks base method.
+func (m *MockRecurringTransactions) Resume(ctx *gofr.Context) (any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resume", ctx)
+	ret0, _ := ret[0].(any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Resume indicates an expected call of Resume.
+func (mr *MockRecurringTransactionsMockRecorder) Resume(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resume", reflect.TypeOf((*MockRecurringTransactions)(nil).Resume), ctx)
+}
+
 // Update mocks base method.
 func (m *MockRecurringTransactions) Update(ctx *gofr.Context) (any, error) {
 	m.ctrl.T.Helper()
@@ -611,3 +806,751 @@ func (mr *MockRecurringTransactionsMockRecorder) Update(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRecurringTransactions)(nil).Update), ctx)
 }
+
+// MockStatements is a mock of Statements interface.
+type MockStatements struct {
+	ctrl     *gomock.Controller
+	recorder *MockStatementsMockRecorder
+}
+
+// MockStatementsMockRecorder is the mock recorder for MockStatements.
+type MockStatementsMockRecorder struct {
+	mock *MockStatements
+}
+
+// NewMockStatements creates a new mock instance.
+func NewMockStatements(ctrl *gomock.Controller) *MockStatements {
+	mock := &MockStatements{ctrl: ctrl}
+	mock.recorder = &MockStatementsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStatements) EXPECT() *MockStatementsMockRecorder {
+	return m.recorder
+}
+
+// Confirm mocks base method.
+func (m *MockStatements) Confirm(ctx *gofr.Context) (any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Confirm", ctx)
+	ret0, _ := ret[0].(any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Confirm indicates an expected call of Confirm.
+func (mr *MockStatementsMockRecorder) Confirm(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Confirm", reflect.TypeOf((*MockStatements)(nil).Confirm), ctx)
+}
+
+// Parse mocks base method.
+func (m *MockStatements) Parse(ctx *gofr.Context) (any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Parse", ctx)
+	ret0, _ := ret[0].(any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Parse indicates an expected call of Parse.
+func (mr *MockStatementsMockRecorder) Parse(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Parse", reflect.TypeOf((*MockStatements)(nil).Parse), ctx)
+}
+
+// MockCategories is a mock of Categories interface.
+type MockCategories struct {
+	ctrl     *gomock.Controller
+	recorder *MockCategoriesMockRecorder
+}
+
+// MockCategoriesMockRecorder is the mock recorder for MockCategories.
+type MockCategoriesMockRecorder struct {
+	mock *MockCategories
+}
+
+// NewMockCategories creates a new mock instance.
+func NewMockCategories(ctrl *gomock.Controller) *MockCategories {
+	mock := &MockCategories{ctrl: ctrl}
+	mock.recorder = &MockCategoriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCategories) EXPECT() *MockCategoriesMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockCategories) Create(ctx *gofr.Context) (any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx)
+	ret0, _ := ret[0].(any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCategoriesMockRecorder) Create(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCategories)(nil).Create), ctx)
+}
+
+// Delete mocks base method.
+func (m *MockCategories) Delete(ctx *gofr.Context) (any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx)
+	ret0, _ := ret[0].(any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockCategoriesMockRecorder) Delete(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCategories)(nil).Delete), ctx)
+}
+
+// GetAll mocks base method.
+func (m *MockCategories) GetAll(ctx *gofr.Context) (any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx)
+	ret0, _ := ret[0].(any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockCategoriesMockRecorder) GetAll(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockCategories)(nil).GetAll), ctx)
+}
+
+// GetByID mocks base method.
+func (m *MockCategories) GetByID(ctx *gofr.Context) (any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx)
+	ret0, _ := ret[0].(any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockCategoriesMockRecorder) GetByID(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockCategories)(nil).GetByID), ctx)
+}
+
+// Merge mocks base method.
+func (m *MockCategories) Merge(ctx *gofr.Context) (any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Merge", ctx)
+	ret0, _ := ret[0].(any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Merge indicates an expected call of Merge.
+func (mr *MockCategoriesMockRecorder) Merge(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Merge", reflect.TypeOf((*MockCategories)(nil).Merge), ctx)
+}
+
+// Update mocks base method.
+func (m *MockCategories) Update(ctx *gofr.Context) (any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx)
+	ret0, _ := ret[0].(any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockCategoriesMockRecorder) Update(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockCategories)(nil).Update), ctx)
+}
+
+// MockBudgets is a mock of Budgets interface.
+type MockBudgets struct {
+	ctrl     *gomock.Controller
+	recorder *MockBudgetsMockRecorder
+}
+
+// MockBudgetsMockRecorder is the mock recorder for MockBudgets.
+type MockBudgetsMockRecorder struct {
+	mock *MockBudgets
+}
+
+// NewMockBudgets creates a new mock instance.
+func NewMockBudgets(ctrl *gomock.Controller) *MockBudgets {
+	mock := &MockBudgets{ctrl: ctrl}
+	mock.recorder = &MockBudgetsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBudgets) EXPECT() *MockBudgetsMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockBudgets) Create(ctx *gofr.Context) (any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx)
+	ret0, _ := ret[0].(any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockBudgetsMockRecorder) Create(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockBudgets)(nil).Create), ctx)
+}
+
+// Delete mocks base method.
+func (m *MockBudgets) Delete(ctx *gofr.Context) (any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx)
+	ret0, _ := ret[0].(any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockBudgetsMockRecorder) Delete(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockBudgets)(nil).Delete), ctx)
+}
+
+// GetAll mocks base method.
+func (m *MockBudgets) GetAll(ctx *gofr.Context) (any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx)
+	ret0, _ := ret[0].(any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockBudgetsMockRecorder) GetAll(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockBudgets)(nil).GetAll), ctx)
+}
+
+// GetByID mocks base method.
+func (m *MockBudgets) GetByID(ctx *gofr.Context) (any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx)
+	ret0, _ := ret[0].(any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockBudgetsMockRecorder) GetByID(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockBudgets)(nil).GetByID), ctx)
+}
+
+// Status mocks base method.
+func (m *MockBudgets) Status(ctx *gofr.Context) (any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Status", ctx)
+	ret0, _ := ret[0].(any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Status indicates an expected call of Status.
+func (mr *MockBudgetsMockRecorder) Status(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Status", reflect.TypeOf((*MockBudgets)(nil).Status), ctx)
+}
+
+// Update mocks base method.
+func (m *MockBudgets) Update(ctx *gofr.Context) (any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx)
+	ret0, _ := ret[0].(any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockBudgetsMockRecorder) Update(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockBudgets)(nil).Update), ctx)
+}
+
+// MockExchangeRates is a mock of ExchangeRates interface.
+type MockExchangeRates struct {
+	ctrl     *gomock.Controller
+	recorder *MockExchangeRatesMockRecorder
+}
+
+// MockExchangeRatesMockRecorder is the mock recorder for MockExchangeRates.
+type MockExchangeRatesMockRecorder struct {
+	mock *MockExchangeRates
+}
+
+// NewMockExchangeRates creates a new mock instance.
+func NewMockExchangeRates(ctrl *gomock.Controller) *MockExchangeRates {
+	mock := &MockExchangeRates{ctrl: ctrl}
+	mock.recorder = &MockExchangeRatesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExchangeRates) EXPECT() *MockExchangeRatesMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockExchangeRates) Create(ctx *gofr.Context) (any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx)
+	ret0, _ := ret[0].(any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockExchangeRatesMockRecorder) Create(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockExchangeRates)(nil).Create), ctx)
+}
+
+// GetAll mocks base method.
+func (m *MockExchangeRates) GetAll(ctx *gofr.Context) (any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx)
+	ret0, _ := ret[0].(any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockExchangeRatesMockRecorder) GetAll(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockExchangeRates)(nil).GetAll), ctx)
+}
+
+// Import mocks base method.
+func (m *MockExchangeRates) Import(ctx *gofr.Context) (any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Import", ctx)
+	ret0, _ := ret[0].(any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Import indicates an expected call of Import.
+func (mr *MockExchangeRatesMockRecorder) Import(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockExchangeRates)(nil).Import), ctx)
+}
+
+// MockReconciliations is a mock of Reconciliations interface.
+type MockReconciliations struct {
+	ctrl     *gomock.Controller
+	recorder *MockReconciliationsMockRecorder
+}
+
+// MockReconciliationsMockRecorder is the mock recorder for MockReconciliations.
+type MockReconciliationsMockRecorder struct {
+	mock *MockReconciliations
+}
+
+// NewMockReconciliations creates a new mock instance.
+func NewMockReconciliations(ctrl *gomock.Controller) *MockReconciliations {
+	mock := &MockReconciliations{ctrl: ctrl}
+	mock.recorder = &MockReconciliationsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReconciliations) EXPECT() *MockReconciliationsMockRecorder {
+	return m.recorder
+}
+
+// Clear mocks base method.
+func (m *MockReconciliations) Clear(ctx *gofr.Context) (any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Clear", ctx)
+	ret0, _ := ret[0].(any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Clear indicates an expected call of Clear.
+func (mr *MockReconciliationsMockRecorder) Clear(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Clear", reflect.TypeOf((*MockReconciliations)(nil).Clear), ctx)
+}
+
+// Create mocks base method.
+func (m *MockReconciliations) Create(ctx *gofr.Context) (any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx)
+	ret0, _ := ret[0].(any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockReconciliationsMockRecorder) Create(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockReconciliations)(nil).Create), ctx)
+}
+
+// GetAll mocks base method.
+func (m *MockReconciliations) GetAll(ctx *gofr.Context) (any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx)
+	ret0, _ := ret[0].(any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockReconciliationsMockRecorder) GetAll(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockReconciliations)(nil).GetAll), ctx)
+}
+
+// GetByID mocks base method.
+func (m *MockReconciliations) GetByID(ctx *gofr.Context) (any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx)
+	ret0, _ := ret[0].(any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockReconciliationsMockRecorder) GetByID(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockReconciliations)(nil).GetByID), ctx)
+}
+
+// Lock mocks base method.
+func (m *MockReconciliations) Lock(ctx *gofr.Context) (any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Lock", ctx)
+	ret0, _ := ret[0].(any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Lock indicates an expected call of Lock.
+func (mr *MockReconciliationsMockRecorder) Lock(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lock", reflect.TypeOf((*MockReconciliations)(nil).Lock), ctx)
+}
+
+// MockCreditCards is a mock of CreditCards interface.
+type MockCreditCards struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreditCardsMockRecorder
+}
+
+// MockCreditCardsMockRecorder is the mock recorder for MockCreditCards.
+type MockCreditCardsMockRecorder struct {
+	mock *MockCreditCards
+}
+
+// NewMockCreditCards creates a new mock instance.
+func NewMockCreditCards(ctrl *gomock.Controller) *MockCreditCards {
+	mock := &MockCreditCards{ctrl: ctrl}
+	mock.recorder = &MockCreditCardsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreditCards) EXPECT() *MockCreditCardsMockRecorder {
+	return m.recorder
+}
+
+// Statements mocks base method.
+func (m *MockCreditCards) Statements(ctx *gofr.Context) (any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Statements", ctx)
+	ret0, _ := ret[0].(any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Statements indicates an expected call of Statements.
+func (mr *MockCreditCardsMockRecorder) Statements(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Statements", reflect.TypeOf((*MockCreditCards)(nil).Statements), ctx)
+}
+
+// MockNetWorth is a mock of NetWorth interface.
+type MockNetWorth struct {
+	ctrl     *gomock.Controller
+	recorder *MockNetWorthMockRecorder
+}
+
+// MockNetWorthMockRecorder is the mock recorder for MockNetWorth.
+type MockNetWorthMockRecorder struct {
+	mock *MockNetWorth
+}
+
+// NewMockNetWorth creates a new mock instance.
+func NewMockNetWorth(ctrl *gomock.Controller) *MockNetWorth {
+	mock := &MockNetWorth{ctrl: ctrl}
+	mock.recorder = &MockNetWorthMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNetWorth) EXPECT() *MockNetWorthMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockNetWorth) Get(ctx *gofr.Context) (any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx)
+	ret0, _ := ret[0].(any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockNetWorthMockRecorder) Get(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockNetWorth)(nil).Get), ctx)
+}
+
+// MockReports is a mock of Reports interface.
+type MockReports struct {
+	ctrl     *gomock.Controller
+	recorder *MockReportsMockRecorder
+}
+
+// MockReportsMockRecorder is the mock recorder for MockReports.
+type MockReportsMockRecorder struct {
+	mock *MockReports
+}
+
+// NewMockReports creates a new mock instance.
+func NewMockReports(ctrl *gomock.Controller) *MockReports {
+	mock := &MockReports{ctrl: ctrl}
+	mock.recorder = &MockReportsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReports) EXPECT() *MockReportsMockRecorder {
+	return m.recorder
+}
+
+// Trends mocks base method.
+func (m *MockReports) Trends(ctx *gofr.Context) (any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trends", ctx)
+	ret0, _ := ret[0].(any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Trends indicates an expected call of Trends.
+func (mr *MockReportsMockRecorder) Trends(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trends", reflect.TypeOf((*MockReports)(nil).Trends), ctx)
+}
+
+// MockForecast is a mock of Forecast interface.
+type MockForecast struct {
+	ctrl     *gomock.Controller
+	recorder *MockForecastMockRecorder
+}
+
+// MockForecastMockRecorder is the mock recorder for MockForecast.
+type MockForecastMockRecorder struct {
+	mock *MockForecast
+}
+
+// NewMockForecast creates a new mock instance.
+func NewMockForecast(ctrl *gomock.Controller) *MockForecast {
+	mock := &MockForecast{ctrl: ctrl}
+	mock.recorder = &MockForecastMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockForecast) EXPECT() *MockForecastMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockForecast) Get(ctx *gofr.Context) (any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx)
+	ret0, _ := ret[0].(any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockForecastMockRecorder) Get(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockForecast)(nil).Get), ctx)
+}
+
+// MockCalendarFeeds is a mock of CalendarFeeds interface.
+type MockCalendarFeeds struct {
+	ctrl     *gomock.Controller
+	recorder *MockCalendarFeedsMockRecorder
+}
+
+// MockCalendarFeedsMockRecorder is the mock recorder for MockCalendarFeeds.
+type MockCalendarFeedsMockRecorder struct {
+	mock *MockCalendarFeeds
+}
+
+// NewMockCalendarFeeds creates a new mock instance.
+func NewMockCalendarFeeds(ctrl *gomock.Controller) *MockCalendarFeeds {
+	mock := &MockCalendarFeeds{ctrl: ctrl}
+	mock.recorder = &MockCalendarFeedsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCalendarFeeds) EXPECT() *MockCalendarFeedsMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockCalendarFeeds) Create(ctx *gofr.Context) (any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx)
+	ret0, _ := ret[0].(any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCalendarFeedsMockRecorder) Create(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCalendarFeeds)(nil).Create), ctx)
+}
+
+// Feed mocks base method.
+func (m *MockCalendarFeeds) Feed(ctx *gofr.Context) (any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Feed", ctx)
+	ret0, _ := ret[0].(any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Feed indicates an expected call of Feed.
+func (mr *MockCalendarFeedsMockRecorder) Feed(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Feed", reflect.TypeOf((*MockCalendarFeeds)(nil).Feed), ctx)
+}
+
+// GetAll mocks base method.
+func (m *MockCalendarFeeds) GetAll(ctx *gofr.Context) (any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx)
+	ret0, _ := ret[0].(any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockCalendarFeedsMockRecorder) GetAll(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockCalendarFeeds)(nil).GetAll), ctx)
+}
+
+// Revoke mocks base method.
+func (m *MockCalendarFeeds) Revoke(ctx *gofr.Context) (any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", ctx)
+	ret0, _ := ret[0].(any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockCalendarFeedsMockRecorder) Revoke(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockCalendarFeeds)(nil).Revoke), ctx)
+}
+
+// MockHolidays is a mock of Holidays interface.
+type MockHolidays struct {
+	ctrl     *gomock.Controller
+	recorder *MockHolidaysMockRecorder
+}
+
+// MockHolidaysMockRecorder is the mock recorder for MockHolidays.
+type MockHolidaysMockRecorder struct {
+	mock *MockHolidays
+}
+
+// NewMockHolidays creates a new mock instance.
+func NewMockHolidays(ctrl *gomock.Controller) *MockHolidays {
+	mock := &MockHolidays{ctrl: ctrl}
+	mock.recorder = &MockHolidaysMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHolidays) EXPECT() *MockHolidaysMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockHolidays) Create(ctx *gofr.Context) (any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx)
+	ret0, _ := ret[0].(any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockHolidaysMockRecorder) Create(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockHolidays)(nil).Create), ctx)
+}
+
+// Delete mocks base method.
+func (m *MockHolidays) Delete(ctx *gofr.Context) (any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx)
+	ret0, _ := ret[0].(any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockHolidaysMockRecorder) Delete(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockHolidays)(nil).Delete), ctx)
+}
+
+// GetAll mocks base method.
+func (m *MockHolidays) GetAll(ctx *gofr.Context) (any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx)
+	ret0, _ := ret[0].(any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockHolidaysMockRecorder) GetAll(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockHolidays)(nil).GetAll), ctx)
+}
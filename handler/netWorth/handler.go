@@ -0,0 +1,41 @@
+package netWorth
+
+import (
+	"errors"
+	"moneyManagement/handler"
+	"moneyManagement/services"
+	"strconv"
+	"strings"
+
+	"gofr.dev/pkg/gofr"
+)
+
+type netWorth struct {
+	netWorthSvc services.NetWorth
+}
+
+func New(netWorthSvc services.NetWorth) handler.NetWorth {
+	return &netWorth{netWorthSvc: netWorthSvc}
+}
+
+// Get returns the current net worth of the user and its history over the last `months` months.
+func (h *netWorth) Get(ctx *gofr.Context) (interface{}, error) {
+	var (
+		months int
+		err    error
+	)
+
+	if monthsString := strings.TrimSpace(ctx.Param("months")); monthsString != "" {
+		months, err = strconv.Atoi(monthsString)
+		if err != nil {
+			return nil, errors.New("invalid months")
+		}
+	}
+
+	netWorth, err := h.netWorthSvc.Get(ctx, months)
+	if err != nil {
+		return nil, err
+	}
+
+	return netWorth, nil
+}
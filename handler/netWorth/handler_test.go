@@ -0,0 +1,77 @@
+package netWorth
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	gofrHTTP "gofr.dev/pkg/gofr/http"
+	"moneyManagement/models"
+	"moneyManagement/services"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func Test_Get(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	netWorthSvc := services.NewMockNetWorth(ctrl)
+
+	netWorth := &models.NetWorth{Currency: "INR", Assets: models.NewMoney(150000), Liabilities: models.NewMoney(20000),
+		NetWorth: models.NewMoney(130000), Savings: models.NewMoney(50000),
+		Accounts: []models.NetWorthAccount{
+			{ID: 1, Name: "HDFC", Type: "BANK", Currency: "INR", Balance: models.NewMoney(100000)},
+			{ID: 2, Name: "Visa", Type: "CREDIT CARD", Currency: "INR", Balance: models.NewMoney(-20000), Liability: true},
+		},
+		History: []models.NetWorthPoint{{Month: "2025-06", Date: "2025-06-30", Assets: models.NewMoney(150000),
+			Liabilities: models.NewMoney(20000), NetWorth: models.NewMoney(130000)}}}
+
+	tests := []struct {
+		description    string
+		query          url.Values
+		expectedOutput interface{}
+		expectedErr    error
+		execMocks      func(ctx *gofr.Context)
+	}{
+		{"Success Case", url.Values{"months": {"1"}}, netWorth, nil, func(ctx *gofr.Context) {
+			netWorthSvc.EXPECT().Get(ctx, 1).Return(netWorth, nil)
+		}},
+		{"Success Case: default months", url.Values{}, netWorth, nil, func(ctx *gofr.Context) {
+			netWorthSvc.EXPECT().Get(ctx, 0).Return(netWorth, nil)
+		}},
+		{"Failure Case: Error from service layer", url.Values{"months": {"500"}}, nil,
+			errors.New("months must be between 1 and 120"), func(ctx *gofr.Context) {
+				netWorthSvc.EXPECT().Get(ctx, 500).Return(nil, errors.New("months must be between 1 and 120"))
+			}},
+		{"Failure Case: invalid months", url.Values{"months": {"all"}}, nil, errors.New("invalid months"),
+			func(ctx *gofr.Context) {
+			}},
+	}
+
+	for i, tc := range tests {
+		i := i
+		tc := tc
+
+		t.Run(tc.description, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/net-worth", nil)
+			req.Header.Set("Content-Type", "application/json")
+			req.URL.RawQuery = tc.query.Encode()
+
+			gofrReq := gofrHTTP.NewRequest(req)
+			mockContainer, _ := container.NewMockContainer(t)
+			ctx := gofr.Context{Context: context.Background(), Request: gofrReq, Container: mockContainer, Out: nil}
+
+			tc.execMocks(&ctx)
+
+			h := New(netWorthSvc)
+
+			output, err := h.Get(&ctx)
+
+			assert.Equalf(t, tc.expectedOutput, output, "TEST[%d], failed.\n%s", i, tc.description)
+			assert.Equalf(t, tc.expectedErr, err, "TEST[%d], failed.\n%s", i, tc.description)
+		})
+	}
+}
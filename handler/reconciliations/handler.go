@@ -0,0 +1,111 @@
+package reconciliations
+
+import (
+	"errors"
+	"moneyManagement/filters"
+	"moneyManagement/handler"
+	"moneyManagement/models"
+	"moneyManagement/services"
+	"strconv"
+	"strings"
+
+	"gofr.dev/pkg/gofr"
+)
+
+type reconciliations struct {
+	reconciliationSvc services.Reconciliations
+}
+
+func New(reconciliationSvc services.Reconciliations) handler.Reconciliations {
+	return &reconciliations{reconciliationSvc: reconciliationSvc}
+}
+
+// Create starts the reconciliation of the account in the path.
+func (h *reconciliations) Create(ctx *gofr.Context) (interface{}, error) {
+	accountID, err := strconv.Atoi(strings.TrimSpace(ctx.PathParam("id")))
+	if err != nil {
+		return nil, errors.New("invalid id")
+	}
+
+	var reconciliation *models.Reconciliation
+
+	err = ctx.Bind(&reconciliation)
+	if err != nil {
+		return nil, errors.New("bind error")
+	}
+
+	reconciliation.Account.ID = accountID
+
+	newReconciliation, err := h.reconciliationSvc.Create(ctx, reconciliation)
+	if err != nil {
+		return nil, err
+	}
+
+	return newReconciliation, nil
+}
+
+func (h *reconciliations) GetByID(ctx *gofr.Context) (interface{}, error) {
+	id, err := strconv.Atoi(strings.TrimSpace(ctx.PathParam("id")))
+	if err != nil {
+		return nil, errors.New("invalid id")
+	}
+
+	reconciliation, err := h.reconciliationSvc.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return reconciliation, nil
+}
+
+// GetAll lists the reconciliations of the account in the path.
+func (h *reconciliations) GetAll(ctx *gofr.Context) (interface{}, error) {
+	accountID, err := strconv.Atoi(strings.TrimSpace(ctx.PathParam("id")))
+	if err != nil {
+		return nil, errors.New("invalid id")
+	}
+
+	f := filters.Reconciliation{AccountID: accountID, Status: strings.ToUpper(strings.TrimSpace(ctx.Param("status")))}
+
+	allReconciliations, err := h.reconciliationSvc.GetAll(ctx, &f)
+	if err != nil {
+		return nil, err
+	}
+
+	return allReconciliations, nil
+}
+
+func (h *reconciliations) Clear(ctx *gofr.Context) (interface{}, error) {
+	id, err := strconv.Atoi(strings.TrimSpace(ctx.PathParam("id")))
+	if err != nil {
+		return nil, errors.New("invalid id")
+	}
+
+	var clearing *models.ReconciliationClearing
+
+	err = ctx.Bind(&clearing)
+	if err != nil {
+		return nil, errors.New("bind error")
+	}
+
+	reconciliation, err := h.reconciliationSvc.Clear(ctx, id, clearing)
+	if err != nil {
+		return nil, err
+	}
+
+	return reconciliation, nil
+}
+
+func (h *reconciliations) Lock(ctx *gofr.Context) (interface{}, error) {
+	id, err := strconv.Atoi(strings.TrimSpace(ctx.PathParam("id")))
+	if err != nil {
+		return nil, errors.New("invalid id")
+	}
+
+	reconciliation, err := h.reconciliationSvc.Lock(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return reconciliation, nil
+}
@@ -0,0 +1,295 @@
+package reconciliations
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	gofrHTTP "gofr.dev/pkg/gofr/http"
+	"moneyManagement/filters"
+	"moneyManagement/models"
+	"moneyManagement/services"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func Test_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	reconciliationSvc := services.NewMockReconciliations(ctrl)
+
+	reconciliation := &models.Reconciliation{ID: 1, UserID: 1, Account: models.AccountDetails{ID: 2, Name: "Bank"},
+		StatementDate: "2025-06-30", StatementBalance: models.NewMoney(1500), ClearedBalance: models.NewMoney(1000),
+		Difference: models.NewMoney(500), Status: models.OPEN}
+
+	tests := []struct {
+		description    string
+		id             string
+		body           []byte
+		expectedOutput interface{}
+		expectedErr    error
+		execMocks      func(ctx *gofr.Context)
+	}{
+		{"Success Case", "2", []byte(`{"statementDate":"2025-06-30","statementBalance":"1500"}`), reconciliation, nil,
+			func(ctx *gofr.Context) {
+				reconciliationSvc.EXPECT().Create(ctx, &models.Reconciliation{Account: models.AccountDetails{ID: 2},
+					StatementDate: "2025-06-30", StatementBalance: models.NewMoney(1500)}).Return(reconciliation, nil)
+			}},
+		{"Failure Case: Error from service layer", "2", []byte(`{"statementDate":"2025-06-30","statementBalance":"1500"}`), nil,
+			errors.New("account already has an open reconciliation"),
+			func(ctx *gofr.Context) {
+				reconciliationSvc.EXPECT().Create(ctx, &models.Reconciliation{Account: models.AccountDetails{ID: 2},
+					StatementDate: "2025-06-30", StatementBalance: models.NewMoney(1500)}).
+					Return(nil, errors.New("account already has an open reconciliation"))
+			}},
+		{"Failure Case: bind error", "2", []byte(`{`), nil, errors.New("bind error"), func(ctx *gofr.Context) {
+		}},
+		{"Failure Case: invalid id", "abc", []byte(`{}`), nil, errors.New("invalid id"), func(ctx *gofr.Context) {
+		}},
+	}
+
+	for i, tc := range tests {
+		i := i
+		tc := tc
+
+		t.Run(tc.description, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/reconciliation", bytes.NewBuffer(tc.body))
+			req.Header.Set("Content-Type", "application/json")
+			req = mux.SetURLVars(req, map[string]string{"id": tc.id})
+
+			gofrReq := gofrHTTP.NewRequest(req)
+			mockContainer, _ := container.NewMockContainer(t)
+			ctx := gofr.Context{Context: context.Background(), Request: gofrReq, Container: mockContainer, Out: nil}
+
+			tc.execMocks(&ctx)
+
+			h := New(reconciliationSvc)
+
+			output, err := h.Create(&ctx)
+
+			assert.Equalf(t, tc.expectedOutput, output, "TEST[%d], failed.\n%s", i, tc.description)
+			assert.Equalf(t, tc.expectedErr, err, "TEST[%d], failed.\n%s", i, tc.description)
+		})
+	}
+}
+
+func Test_GetAll(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	reconciliationSvc := services.NewMockReconciliations(ctrl)
+
+	reconciliations := []*models.Reconciliation{{ID: 1, UserID: 1, Account: models.AccountDetails{ID: 2, Name: "Bank"},
+		StatementDate: "2025-05-31", StatementBalance: models.NewMoney(900), ClearedBalance: models.NewMoney(900),
+		Status: models.LOCKED}}
+
+	tests := []struct {
+		description    string
+		id             string
+		query          url.Values
+		expectedOutput interface{}
+		expectedErr    error
+		execMocks      func(ctx *gofr.Context)
+	}{
+		{"Success Case", "2", url.Values{"status": {"locked"}}, reconciliations, nil,
+			func(ctx *gofr.Context) {
+				reconciliationSvc.EXPECT().GetAll(ctx, &filters.Reconciliation{AccountID: 2, Status: "LOCKED"}).
+					Return(reconciliations, nil)
+			}},
+		{"Failure Case: Error from service layer", "2", url.Values{}, nil, errors.New("error"),
+			func(ctx *gofr.Context) {
+				reconciliationSvc.EXPECT().GetAll(ctx, &filters.Reconciliation{AccountID: 2}).Return(nil, errors.New("error"))
+			}},
+		{"Failure Case: invalid id", "abc", url.Values{}, nil, errors.New("invalid id"), func(ctx *gofr.Context) {
+		}},
+	}
+
+	for i, tc := range tests {
+		i := i
+		tc := tc
+
+		t.Run(tc.description, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/reconciliation", nil)
+			req.Header.Set("Content-Type", "application/json")
+			req.URL.RawQuery = tc.query.Encode()
+			req = mux.SetURLVars(req, map[string]string{"id": tc.id})
+
+			gofrReq := gofrHTTP.NewRequest(req)
+			mockContainer, _ := container.NewMockContainer(t)
+			ctx := gofr.Context{Context: context.Background(), Request: gofrReq, Container: mockContainer, Out: nil}
+
+			tc.execMocks(&ctx)
+
+			h := New(reconciliationSvc)
+
+			output, err := h.GetAll(&ctx)
+
+			assert.Equalf(t, tc.expectedOutput, output, "TEST[%d], failed.\n%s", i, tc.description)
+			assert.Equalf(t, tc.expectedErr, err, "TEST[%d], failed.\n%s", i, tc.description)
+		})
+	}
+}
+
+func Test_GetByID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	reconciliationSvc := services.NewMockReconciliations(ctrl)
+
+	reconciliation := &models.Reconciliation{ID: 1, UserID: 1, Account: models.AccountDetails{ID: 2, Name: "Bank"},
+		StatementDate: "2025-06-30", StatementBalance: models.NewMoney(1500), Status: models.OPEN,
+		Transactions: []*models.Transaction{{ID: 7, Amount: models.NewMoney(500), Type: models.INCOME, Status: models.UNCLEARED}}}
+
+	tests := []struct {
+		description    string
+		id             string
+		expectedOutput interface{}
+		expectedErr    error
+		execMocks      func(ctx *gofr.Context)
+	}{
+		{"Success Case", "1", reconciliation, nil, func(ctx *gofr.Context) {
+			reconciliationSvc.EXPECT().GetByID(ctx, 1).Return(reconciliation, nil)
+		}},
+		{"Failure Case: Error from service layer", "1", nil, errors.New("error"), func(ctx *gofr.Context) {
+			reconciliationSvc.EXPECT().GetByID(ctx, 1).Return(nil, errors.New("error"))
+		}},
+		{"Failure Case: invalid id", "abc", nil, errors.New("invalid id"), func(ctx *gofr.Context) {
+		}},
+	}
+
+	for i, tc := range tests {
+		i := i
+		tc := tc
+
+		t.Run(tc.description, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/reconciliation", nil)
+			req.Header.Set("Content-Type", "application/json")
+			req = mux.SetURLVars(req, map[string]string{"id": tc.id})
+
+			gofrReq := gofrHTTP.NewRequest(req)
+			mockContainer, _ := container.NewMockContainer(t)
+			ctx := gofr.Context{Context: context.Background(), Request: gofrReq, Container: mockContainer, Out: nil}
+
+			tc.execMocks(&ctx)
+
+			h := New(reconciliationSvc)
+
+			output, err := h.GetByID(&ctx)
+
+			assert.Equalf(t, tc.expectedOutput, output, "TEST[%d], failed.\n%s", i, tc.description)
+			assert.Equalf(t, tc.expectedErr, err, "TEST[%d], failed.\n%s", i, tc.description)
+		})
+	}
+}
+
+func Test_Clear(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	reconciliationSvc := services.NewMockReconciliations(ctrl)
+
+	reconciliation := &models.Reconciliation{ID: 1, UserID: 1, Account: models.AccountDetails{ID: 2, Name: "Bank"},
+		StatementDate: "2025-06-30", StatementBalance: models.NewMoney(1500), ClearedBalance: models.NewMoney(1500),
+		Status: models.OPEN}
+
+	tests := []struct {
+		description    string
+		id             string
+		body           []byte
+		expectedOutput interface{}
+		expectedErr    error
+		execMocks      func(ctx *gofr.Context)
+	}{
+		{"Success Case", "1", []byte(`{"cleared":[7,8],"uncleared":[9]}`), reconciliation, nil,
+			func(ctx *gofr.Context) {
+				reconciliationSvc.EXPECT().Clear(ctx, 1, &models.ReconciliationClearing{Cleared: []int{7, 8}, Uncleared: []int{9}}).
+					Return(reconciliation, nil)
+			}},
+		{"Failure Case: Error from service layer", "1", []byte(`{"cleared":[5]}`), nil,
+			errors.New("transaction 5 is not part of the reconciliation"),
+			func(ctx *gofr.Context) {
+				reconciliationSvc.EXPECT().Clear(ctx, 1, &models.ReconciliationClearing{Cleared: []int{5}}).
+					Return(nil, errors.New("transaction 5 is not part of the reconciliation"))
+			}},
+		{"Failure Case: bind error", "1", []byte(`{`), nil, errors.New("bind error"), func(ctx *gofr.Context) {
+		}},
+		{"Failure Case: invalid id", "abc", []byte(`{}`), nil, errors.New("invalid id"), func(ctx *gofr.Context) {
+		}},
+	}
+
+	for i, tc := range tests {
+		i := i
+		tc := tc
+
+		t.Run(tc.description, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPut, "/reconciliation", bytes.NewBuffer(tc.body))
+			req.Header.Set("Content-Type", "application/json")
+			req = mux.SetURLVars(req, map[string]string{"id": tc.id})
+
+			gofrReq := gofrHTTP.NewRequest(req)
+			mockContainer, _ := container.NewMockContainer(t)
+			ctx := gofr.Context{Context: context.Background(), Request: gofrReq, Container: mockContainer, Out: nil}
+
+			tc.execMocks(&ctx)
+
+			h := New(reconciliationSvc)
+
+			output, err := h.Clear(&ctx)
+
+			assert.Equalf(t, tc.expectedOutput, output, "TEST[%d], failed.\n%s", i, tc.description)
+			assert.Equalf(t, tc.expectedErr, err, "TEST[%d], failed.\n%s", i, tc.description)
+		})
+	}
+}
+
+func Test_Lock(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	reconciliationSvc := services.NewMockReconciliations(ctrl)
+
+	reconciliation := &models.Reconciliation{ID: 1, UserID: 1, Account: models.AccountDetails{ID: 2, Name: "Bank"},
+		StatementDate: "2025-06-30", StatementBalance: models.NewMoney(1500), ClearedBalance: models.NewMoney(1500),
+		Status: models.LOCKED, LockedAt: "2025-07-02T10:00:00.000Z"}
+
+	tests := []struct {
+		description    string
+		id             string
+		expectedOutput interface{}
+		expectedErr    error
+		execMocks      func(ctx *gofr.Context)
+	}{
+		{"Success Case", "1", reconciliation, nil, func(ctx *gofr.Context) {
+			reconciliationSvc.EXPECT().Lock(ctx, 1).Return(reconciliation, nil)
+		}},
+		{"Failure Case: Error from service layer", "1", nil,
+			errors.New("reconciliation cannot be locked while the difference is 20.00"),
+			func(ctx *gofr.Context) {
+				reconciliationSvc.EXPECT().Lock(ctx, 1).
+					Return(nil, errors.New("reconciliation cannot be locked while the difference is 20.00"))
+			}},
+		{"Failure Case: invalid id", "abc", nil, errors.New("invalid id"), func(ctx *gofr.Context) {
+		}},
+	}
+
+	for i, tc := range tests {
+		i := i
+		tc := tc
+
+		t.Run(tc.description, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/reconciliation", nil)
+			req.Header.Set("Content-Type", "application/json")
+			req = mux.SetURLVars(req, map[string]string{"id": tc.id})
+
+			gofrReq := gofrHTTP.NewRequest(req)
+			mockContainer, _ := container.NewMockContainer(t)
+			ctx := gofr.Context{Context: context.Background(), Request: gofrReq, Container: mockContainer, Out: nil}
+
+			tc.execMocks(&ctx)
+
+			h := New(reconciliationSvc)
+
+			output, err := h.Lock(&ctx)
+
+			assert.Equalf(t, tc.expectedOutput, output, "TEST[%d], failed.\n%s", i, tc.description)
+			assert.Equalf(t, tc.expectedErr, err, "TEST[%d], failed.\n%s", i, tc.description)
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"moneyManagement/services"
 	"strconv"
 	"strings"
+	"time"
 )
 
 type recurringTransactionsHandler struct {
@@ -115,3 +116,177 @@ func (h *recurringTransactionsHandler) Delete(ctx *gofr.Context) (interface{}, e
 
 	return "transaction deleted successfully", nil
 }
+
+// Occurrences previews the next `count` occurrences of a recurring transaction.
+func (h *recurringTransactionsHandler) Occurrences(ctx *gofr.Context) (interface{}, error) {
+	idString := strings.TrimSpace(ctx.PathParam("id"))
+
+	id, err := strconv.Atoi(idString)
+	if err != nil {
+		return nil, errors.New("invalid id")
+	}
+
+	var count int
+
+	if countString := strings.TrimSpace(ctx.Param("count")); countString != "" {
+		count, err = strconv.Atoi(countString)
+		if err != nil {
+			return nil, errors.New("invalid count")
+		}
+	}
+
+	occurrences, err := h.recurringTransactionSvc.Occurrences(ctx, id, count)
+	if err != nil {
+		return nil, err
+	}
+
+	return occurrences, nil
+}
+
+// Calendar lays out the occurrences of all recurring transactions from `from` to `to` by day.
+func (h *recurringTransactionsHandler) Calendar(ctx *gofr.Context) (interface{}, error) {
+	from, err := time.Parse("2006-01-02", strings.TrimSpace(ctx.Param("from")))
+	if err != nil {
+		return nil, errors.New("invalid from date format, use YYYY-MM-DD")
+	}
+
+	to, err := time.Parse("2006-01-02", strings.TrimSpace(ctx.Param("to")))
+	if err != nil {
+		return nil, errors.New("invalid to date format, use YYYY-MM-DD")
+	}
+
+	calendar, err := h.recurringTransactionSvc.Calendar(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return calendar, nil
+}
+
+// Pause stops a recurring transaction from posting, until the optional `until` date.
+func (h *recurringTransactionsHandler) Pause(ctx *gofr.Context) (interface{}, error) {
+	idString := strings.TrimSpace(ctx.PathParam("id"))
+
+	id, err := strconv.Atoi(idString)
+	if err != nil {
+		return nil, errors.New("invalid id")
+	}
+
+	var pause models.RecurringPause
+
+	err = ctx.Bind(&pause)
+	if err != nil {
+		return nil, errors.New("bind error")
+	}
+
+	recurringTransaction, err := h.recurringTransactionSvc.Pause(ctx, id, &pause)
+	if err != nil {
+		return nil, err
+	}
+
+	return recurringTransaction, nil
+}
+
+func (h *recurringTransactionsHandler) Resume(ctx *gofr.Context) (interface{}, error) {
+	idString := strings.TrimSpace(ctx.PathParam("id"))
+
+	id, err := strconv.Atoi(idString)
+	if err != nil {
+		return nil, errors.New("invalid id")
+	}
+
+	recurringTransaction, err := h.recurringTransactionSvc.Resume(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return recurringTransaction, nil
+}
+
+// CreateException skips one occurrence of a recurring transaction or overrides its amount or date.
+func (h *recurringTransactionsHandler) CreateException(ctx *gofr.Context) (interface{}, error) {
+	idString := strings.TrimSpace(ctx.PathParam("id"))
+
+	id, err := strconv.Atoi(idString)
+	if err != nil {
+		return nil, errors.New("invalid id")
+	}
+
+	var exception *models.RecurringException
+
+	err = ctx.Bind(&exception)
+	if err != nil || exception == nil {
+		return nil, errors.New("bind error")
+	}
+
+	newException, err := h.recurringTransactionSvc.CreateException(ctx, id, exception)
+	if err != nil {
+		return nil, err
+	}
+
+	return newException, nil
+}
+
+func (h *recurringTransactionsHandler) DeleteException(ctx *gofr.Context) (interface{}, error) {
+	idString := strings.TrimSpace(ctx.PathParam("id"))
+
+	id, err := strconv.Atoi(idString)
+	if err != nil {
+		return nil, errors.New("invalid id")
+	}
+
+	exceptionID, err := strconv.Atoi(strings.TrimSpace(ctx.PathParam("exceptionId")))
+	if err != nil {
+		return nil, errors.New("invalid exception id")
+	}
+
+	err = h.recurringTransactionSvc.DeleteException(ctx, id, exceptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return "recurring exception deleted successfully", nil
+}
+
+// Pending lists the due occurrences waiting for approval.
+func (h *recurringTransactionsHandler) Pending(ctx *gofr.Context) (interface{}, error) {
+	pending, err := h.recurringTransactionSvc.Pending(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return pending, nil
+}
+
+// ConfirmPending posts a pending occurrence, with an edited amount or date when given.
+func (h *recurringTransactionsHandler) ConfirmPending(ctx *gofr.Context) (interface{}, error) {
+	var confirmation *models.RecurringConfirmation
+
+	err := ctx.Bind(&confirmation)
+	if err != nil || confirmation == nil {
+		return nil, errors.New("bind error")
+	}
+
+	transaction, err := h.recurringTransactionSvc.ConfirmPending(ctx, confirmation)
+	if err != nil {
+		return nil, err
+	}
+
+	return transaction, nil
+}
+
+func (h *recurringTransactionsHandler) RejectPending(ctx *gofr.Context) (interface{}, error) {
+	var confirmation *models.RecurringConfirmation
+
+	err := ctx.Bind(&confirmation)
+	if err != nil || confirmation == nil {
+		return nil, errors.New("bind error")
+	}
+
+	err = h.recurringTransactionSvc.RejectPending(ctx, confirmation)
+	if err != nil {
+		return nil, err
+	}
+
+	return "pending occurrence rejected successfully", nil
+}
@@ -17,13 +17,14 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"testing"
+	"time"
 )
 
 func Test_Create(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	transactionSvc := services.NewMockRecurringTransactions(ctrl)
 
-	transaction := &models.RecurringTransaction{ID: 1, UserID: 1, Account: models.AccountDetails{ID: 1}, Amount: 100, Type: "EXPENSE"}
+	transaction := &models.RecurringTransaction{ID: 1, UserID: 1, Account: models.AccountDetails{ID: 1}, Amount: models.NewMoney(100), Type: "EXPENSE"}
 
 	tests := []struct {
 		description    string
@@ -34,11 +35,11 @@ func Test_Create(t *testing.T) {
 	}{
 		{"Success Case", []byte(`{"userId":1,"account":{"id":1},"amount":100,"type":"EXPENSE"}`), transaction, nil,
 			func(ctx *gofr.Context) {
-				transactionSvc.EXPECT().Create(ctx, &models.RecurringTransaction{UserID: 1, Account: models.AccountDetails{ID: 1}, Amount: 100, Type: "EXPENSE"}).Return(transaction, nil)
+				transactionSvc.EXPECT().Create(ctx, &models.RecurringTransaction{UserID: 1, Account: models.AccountDetails{ID: 1}, Amount: models.NewMoney(100), Type: "EXPENSE"}).Return(transaction, nil)
 			}},
 		{"Failure Case: Error from service layer", []byte(`{"userId":1,"account":{"id":1},"amount":100,"type":"EXPENSE"}`), nil, errors.New("error"),
 			func(ctx *gofr.Context) {
-				transactionSvc.EXPECT().Create(ctx, &models.RecurringTransaction{UserID: 1, Account: models.AccountDetails{ID: 1}, Amount: 100, Type: "EXPENSE"}).Return(nil, errors.New("error"))
+				transactionSvc.EXPECT().Create(ctx, &models.RecurringTransaction{UserID: 1, Account: models.AccountDetails{ID: 1}, Amount: models.NewMoney(100), Type: "EXPENSE"}).Return(nil, errors.New("error"))
 			}},
 		{"Failure Case: bind error", []byte(`{`), nil, errors.New("bind error"), func(ctx *gofr.Context) {
 		}},
@@ -71,7 +72,7 @@ func Test_Update(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	transactionSvc := services.NewMockRecurringTransactions(ctrl)
 
-	transaction := &models.RecurringTransaction{ID: 1, UserID: 1, Account: models.AccountDetails{ID: 1}, Amount: 100, Type: "EXPENSE"}
+	transaction := &models.RecurringTransaction{ID: 1, UserID: 1, Account: models.AccountDetails{ID: 1}, Amount: models.NewMoney(100), Type: "EXPENSE"}
 
 	tests := []struct {
 		description    string
@@ -172,7 +173,7 @@ func Test_GetByID(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	transactionSvc := services.NewMockRecurringTransactions(ctrl)
 
-	transaction := &models.RecurringTransaction{ID: 1, UserID: 1, Account: models.AccountDetails{ID: 1}, Amount: 100, Type: "EXPENSE"}
+	transaction := &models.RecurringTransaction{ID: 1, UserID: 1, Account: models.AccountDetails{ID: 1}, Amount: models.NewMoney(100), Type: "EXPENSE"}
 
 	tests := []struct {
 		description    string
@@ -222,7 +223,7 @@ func Test_GetAll(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	transactionSvc := services.NewMockRecurringTransactions(ctrl)
 
-	transaction := &models.RecurringTransaction{ID: 1, UserID: 1, Account: models.AccountDetails{ID: 1}, Amount: 100, Type: "EXPENSE"}
+	transaction := &models.RecurringTransaction{ID: 1, UserID: 1, Account: models.AccountDetails{ID: 1}, Amount: models.NewMoney(100), Type: "EXPENSE"}
 
 	tests := []struct {
 		description    string
@@ -266,3 +267,498 @@ func Test_GetAll(t *testing.T) {
 		})
 	}
 }
+
+func Test_Occurrences(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	transactionSvc := services.NewMockRecurringTransactions(ctrl)
+
+	occurrences := []models.RecurringOccurrence{
+		{RecurringTransactionID: 1, Date: "2025-07-01T09:00:00.000Z", Account: models.AccountDetails{ID: 1},
+			Amount: models.NewMoney(100), Type: models.EXPENSE, Category: "Rent"},
+		{RecurringTransactionID: 1, Date: "2025-08-01T09:00:00.000Z", Account: models.AccountDetails{ID: 1},
+			Amount: models.NewMoney(100), Type: models.EXPENSE, Category: "Rent"},
+	}
+
+	tests := []struct {
+		description    string
+		id             string
+		query          url.Values
+		expectedOutput interface{}
+		expectedErr    error
+		execMocks      func(ctx *gofr.Context)
+	}{
+		{"Success Case", "1", url.Values{"count": {"2"}}, occurrences, nil,
+			func(ctx *gofr.Context) {
+				transactionSvc.EXPECT().Occurrences(ctx, 1, 2).Return(occurrences, nil)
+			}},
+		{"Success Case: default count", "1", url.Values{}, occurrences, nil,
+			func(ctx *gofr.Context) {
+				transactionSvc.EXPECT().Occurrences(ctx, 1, 0).Return(occurrences, nil)
+			}},
+		{"Failure Case: Error from service layer", "1", url.Values{"count": {"500"}}, nil,
+			errors.New("count must be between 1 and 100"), func(ctx *gofr.Context) {
+				transactionSvc.EXPECT().Occurrences(ctx, 1, 500).Return(nil, errors.New("count must be between 1 and 100"))
+			}},
+		{"Failure Case: invalid count", "1", url.Values{"count": {"few"}}, nil, errors.New("invalid count"),
+			func(ctx *gofr.Context) {
+			}},
+		{"Failure Case: invalid id", "!", url.Values{}, nil, errors.New("invalid id"), func(ctx *gofr.Context) {
+		}},
+	}
+
+	for i, tc := range tests {
+		i := i
+		tc := tc
+
+		t.Run(tc.description, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/recurring-transaction", nil)
+			req.Header.Set("Content-Type", "application/json")
+			req.URL.RawQuery = tc.query.Encode()
+			req = mux.SetURLVars(req, map[string]string{"id": tc.id})
+
+			gofrReq := gofrHTTP.NewRequest(req)
+			mockContainer, _ := container.NewMockContainer(t)
+			ctx := gofr.Context{Context: context.Background(), Request: gofrReq, Container: mockContainer, Out: nil}
+
+			tc.execMocks(&ctx)
+
+			h := New(transactionSvc)
+
+			output, err := h.Occurrences(&ctx)
+
+			assert.Equalf(t, tc.expectedOutput, output, "TEST[%d], failed.\n%s", i, tc.description)
+			assert.Equalf(t, tc.expectedErr, err, "TEST[%d], failed.\n%s", i, tc.description)
+		})
+	}
+}
+
+func Test_Calendar(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	transactionSvc := services.NewMockRecurringTransactions(ctrl)
+
+	from := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 7, 31, 0, 0, 0, 0, time.UTC)
+
+	calendar := &models.RecurringCalendar{From: "2025-07-01", To: "2025-07-31", Days: []models.RecurringCalendarDay{
+		{Date: "2025-07-01", Occurrences: []models.RecurringOccurrence{{RecurringTransactionID: 1,
+			Date: "2025-07-01T09:00:00.000Z", Account: models.AccountDetails{ID: 1}, Amount: models.NewMoney(100),
+			Type: models.EXPENSE, Category: "Rent"}}},
+	}}
+
+	tests := []struct {
+		description    string
+		query          url.Values
+		expectedOutput interface{}
+		expectedErr    error
+		execMocks      func(ctx *gofr.Context)
+	}{
+		{"Success Case", url.Values{"from": {"2025-07-01"}, "to": {"2025-07-31"}}, calendar, nil,
+			func(ctx *gofr.Context) {
+				transactionSvc.EXPECT().Calendar(ctx, from, to).Return(calendar, nil)
+			}},
+		{"Failure Case: Error from service layer", url.Values{"from": {"2025-07-31"}, "to": {"2025-07-01"}}, nil,
+			errors.New("to must not be before from"), func(ctx *gofr.Context) {
+				transactionSvc.EXPECT().Calendar(ctx, to, from).Return(nil, errors.New("to must not be before from"))
+			}},
+		{"Failure Case: missing from", url.Values{"to": {"2025-07-31"}}, nil,
+			errors.New("invalid from date format, use YYYY-MM-DD"), func(ctx *gofr.Context) {
+			}},
+		{"Failure Case: invalid to", url.Values{"from": {"2025-07-01"}, "to": {"31/07/2025"}}, nil,
+			errors.New("invalid to date format, use YYYY-MM-DD"), func(ctx *gofr.Context) {
+			}},
+	}
+
+	for i, tc := range tests {
+		i := i
+		tc := tc
+
+		t.Run(tc.description, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/recurring-transaction/calendar", nil)
+			req.Header.Set("Content-Type", "application/json")
+			req.URL.RawQuery = tc.query.Encode()
+
+			gofrReq := gofrHTTP.NewRequest(req)
+			mockContainer, _ := container.NewMockContainer(t)
+			ctx := gofr.Context{Context: context.Background(), Request: gofrReq, Container: mockContainer, Out: nil}
+
+			tc.execMocks(&ctx)
+
+			h := New(transactionSvc)
+
+			output, err := h.Calendar(&ctx)
+
+			assert.Equalf(t, tc.expectedOutput, output, "TEST[%d], failed.\n%s", i, tc.description)
+			assert.Equalf(t, tc.expectedErr, err, "TEST[%d], failed.\n%s", i, tc.description)
+		})
+	}
+}
+
+func Test_Pause(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	transactionSvc := services.NewMockRecurringTransactions(ctrl)
+
+	paused := &models.RecurringTransaction{ID: 1, PausedAt: "2025-07-01T09:00:00.000Z", PausedUntil: "2025-09-01"}
+
+	tests := []struct {
+		description    string
+		id             string
+		body           []byte
+		expectedOutput interface{}
+		expectedErr    error
+		execMocks      func(ctx *gofr.Context)
+	}{
+		{"Success Case", "1", []byte(`{"until":"2025-09-01"}`), paused, nil,
+			func(ctx *gofr.Context) {
+				transactionSvc.EXPECT().Pause(ctx, 1, &models.RecurringPause{Until: "2025-09-01"}).Return(paused, nil)
+			}},
+		{"Failure Case: Error from service layer", "1", []byte(`{"until":"2020-01-01"}`), nil,
+			errors.New("until must be in the future"), func(ctx *gofr.Context) {
+				transactionSvc.EXPECT().Pause(ctx, 1, &models.RecurringPause{Until: "2020-01-01"}).
+					Return(nil, errors.New("until must be in the future"))
+			}},
+		{"Failure Case: bind error", "1", []byte(`{"until":`), nil, errors.New("bind error"),
+			func(ctx *gofr.Context) {
+			}},
+		{"Failure Case: invalid id", "!", []byte(`{}`), nil, errors.New("invalid id"), func(ctx *gofr.Context) {
+		}},
+	}
+
+	for i, tc := range tests {
+		i := i
+		tc := tc
+
+		t.Run(tc.description, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/recurring-transaction", bytes.NewBuffer(tc.body))
+			req.Header.Set("Content-Type", "application/json")
+			req = mux.SetURLVars(req, map[string]string{"id": tc.id})
+
+			gofrReq := gofrHTTP.NewRequest(req)
+			mockContainer, _ := container.NewMockContainer(t)
+			ctx := gofr.Context{Context: context.Background(), Request: gofrReq, Container: mockContainer, Out: nil}
+
+			tc.execMocks(&ctx)
+
+			h := New(transactionSvc)
+
+			output, err := h.Pause(&ctx)
+
+			assert.Equalf(t, tc.expectedOutput, output, "TEST[%d], failed.\n%s", i, tc.description)
+			assert.Equalf(t, tc.expectedErr, err, "TEST[%d], failed.\n%s", i, tc.description)
+		})
+	}
+}
+
+func Test_Resume(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	transactionSvc := services.NewMockRecurringTransactions(ctrl)
+
+	resumed := &models.RecurringTransaction{ID: 1}
+
+	tests := []struct {
+		description    string
+		id             string
+		expectedOutput interface{}
+		expectedErr    error
+		execMocks      func(ctx *gofr.Context)
+	}{
+		{"Success Case", "1", resumed, nil,
+			func(ctx *gofr.Context) {
+				transactionSvc.EXPECT().Resume(ctx, 1).Return(resumed, nil)
+			}},
+		{"Failure Case: Error from service layer", "1", nil, errors.New("recurring transaction not found"),
+			func(ctx *gofr.Context) {
+				transactionSvc.EXPECT().Resume(ctx, 1).Return(nil, errors.New("recurring transaction not found"))
+			}},
+		{"Failure Case: invalid id", "!", nil, errors.New("invalid id"), func(ctx *gofr.Context) {
+		}},
+	}
+
+	for i, tc := range tests {
+		i := i
+		tc := tc
+
+		t.Run(tc.description, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/recurring-transaction", nil)
+			req.Header.Set("Content-Type", "application/json")
+			req = mux.SetURLVars(req, map[string]string{"id": tc.id})
+
+			gofrReq := gofrHTTP.NewRequest(req)
+			mockContainer, _ := container.NewMockContainer(t)
+			ctx := gofr.Context{Context: context.Background(), Request: gofrReq, Container: mockContainer, Out: nil}
+
+			tc.execMocks(&ctx)
+
+			h := New(transactionSvc)
+
+			output, err := h.Resume(&ctx)
+
+			assert.Equalf(t, tc.expectedOutput, output, "TEST[%d], failed.\n%s", i, tc.description)
+			assert.Equalf(t, tc.expectedErr, err, "TEST[%d], failed.\n%s", i, tc.description)
+		})
+	}
+}
+
+func Test_CreateException(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	transactionSvc := services.NewMockRecurringTransactions(ctrl)
+
+	skip := &models.RecurringException{Occurrence: "2025-08-01T09:00:00.000Z", Skip: true}
+	created := &models.RecurringException{ID: 3, RecurringTransactionID: 1, UserID: 1,
+		Occurrence: "2025-08-01T09:00:00.000Z", Skip: true}
+
+	tests := []struct {
+		description    string
+		id             string
+		body           []byte
+		expectedOutput interface{}
+		expectedErr    error
+		execMocks      func(ctx *gofr.Context)
+	}{
+		{"Success Case", "1", []byte(`{"occurrence":"2025-08-01T09:00:00.000Z","skip":true}`), created, nil,
+			func(ctx *gofr.Context) {
+				transactionSvc.EXPECT().CreateException(ctx, 1, skip).Return(created, nil)
+			}},
+		{"Failure Case: Error from service layer", "1", []byte(`{"occurrence":"2025-08-01T09:00:00.000Z","skip":true}`),
+			nil, errors.New("the occurrence has already been posted"), func(ctx *gofr.Context) {
+				transactionSvc.EXPECT().CreateException(ctx, 1, skip).
+					Return(nil, errors.New("the occurrence has already been posted"))
+			}},
+		{"Failure Case: bind error", "1", []byte(`{"skip":`), nil, errors.New("bind error"),
+			func(ctx *gofr.Context) {
+			}},
+		{"Failure Case: invalid id", "!", []byte(`{}`), nil, errors.New("invalid id"), func(ctx *gofr.Context) {
+		}},
+	}
+
+	for i, tc := range tests {
+		i := i
+		tc := tc
+
+		t.Run(tc.description, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/recurring-transaction", bytes.NewBuffer(tc.body))
+			req.Header.Set("Content-Type", "application/json")
+			req = mux.SetURLVars(req, map[string]string{"id": tc.id})
+
+			gofrReq := gofrHTTP.NewRequest(req)
+			mockContainer, _ := container.NewMockContainer(t)
+			ctx := gofr.Context{Context: context.Background(), Request: gofrReq, Container: mockContainer, Out: nil}
+
+			tc.execMocks(&ctx)
+
+			h := New(transactionSvc)
+
+			output, err := h.CreateException(&ctx)
+
+			assert.Equalf(t, tc.expectedOutput, output, "TEST[%d], failed.\n%s", i, tc.description)
+			assert.Equalf(t, tc.expectedErr, err, "TEST[%d], failed.\n%s", i, tc.description)
+		})
+	}
+}
+
+func Test_DeleteException(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	transactionSvc := services.NewMockRecurringTransactions(ctrl)
+
+	tests := []struct {
+		description    string
+		id             string
+		exceptionID    string
+		expectedOutput interface{}
+		expectedErr    error
+		execMocks      func(ctx *gofr.Context)
+	}{
+		{"Success Case", "1", "3", "recurring exception deleted successfully", nil,
+			func(ctx *gofr.Context) {
+				transactionSvc.EXPECT().DeleteException(ctx, 1, 3).Return(nil)
+			}},
+		{"Failure Case: Error from service layer", "1", "3", nil, errors.New("recurring exception not found"),
+			func(ctx *gofr.Context) {
+				transactionSvc.EXPECT().DeleteException(ctx, 1, 3).Return(errors.New("recurring exception not found"))
+			}},
+		{"Failure Case: invalid exception id", "1", "!", nil, errors.New("invalid exception id"),
+			func(ctx *gofr.Context) {
+			}},
+		{"Failure Case: invalid id", "!", "3", nil, errors.New("invalid id"), func(ctx *gofr.Context) {
+		}},
+	}
+
+	for i, tc := range tests {
+		i := i
+		tc := tc
+
+		t.Run(tc.description, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodDelete, "/recurring-transaction", nil)
+			req.Header.Set("Content-Type", "application/json")
+			req = mux.SetURLVars(req, map[string]string{"id": tc.id, "exceptionId": tc.exceptionID})
+
+			gofrReq := gofrHTTP.NewRequest(req)
+			mockContainer, _ := container.NewMockContainer(t)
+			ctx := gofr.Context{Context: context.Background(), Request: gofrReq, Container: mockContainer, Out: nil}
+
+			tc.execMocks(&ctx)
+
+			h := New(transactionSvc)
+
+			output, err := h.DeleteException(&ctx)
+
+			assert.Equalf(t, tc.expectedOutput, output, "TEST[%d], failed.\n%s", i, tc.description)
+			assert.Equalf(t, tc.expectedErr, err, "TEST[%d], failed.\n%s", i, tc.description)
+		})
+	}
+}
+
+func Test_Pending(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	transactionSvc := services.NewMockRecurringTransactions(ctrl)
+
+	pending := []models.RecurringOccurrence{
+		{RecurringTransactionID: 2, Date: "2025-07-05T00:00:00.000Z", Account: models.AccountDetails{ID: 1},
+			Amount: models.NewMoney(60), Type: models.EXPENSE, Category: "Electricity", Scheduled: "2025-07-05T00:00:00.000Z"},
+	}
+
+	tests := []struct {
+		description    string
+		expectedOutput interface{}
+		expectedErr    error
+		execMocks      func(ctx *gofr.Context)
+	}{
+		{"Success Case", pending, nil,
+			func(ctx *gofr.Context) {
+				transactionSvc.EXPECT().Pending(ctx).Return(pending, nil)
+			}},
+		{"Failure Case: Error from service layer", nil, errors.New("error"),
+			func(ctx *gofr.Context) {
+				transactionSvc.EXPECT().Pending(ctx).Return(nil, errors.New("error"))
+			}},
+	}
+
+	for i, tc := range tests {
+		i := i
+		tc := tc
+
+		t.Run(tc.description, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/recurring-transaction/pending", nil)
+			req.Header.Set("Content-Type", "application/json")
+
+			gofrReq := gofrHTTP.NewRequest(req)
+			mockContainer, _ := container.NewMockContainer(t)
+			ctx := gofr.Context{Context: context.Background(), Request: gofrReq, Container: mockContainer, Out: nil}
+
+			tc.execMocks(&ctx)
+
+			h := New(transactionSvc)
+
+			output, err := h.Pending(&ctx)
+
+			assert.Equalf(t, tc.expectedOutput, output, "TEST[%d], failed.\n%s", i, tc.description)
+			assert.Equalf(t, tc.expectedErr, err, "TEST[%d], failed.\n%s", i, tc.description)
+		})
+	}
+}
+
+func Test_ConfirmPending(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	transactionSvc := services.NewMockRecurringTransactions(ctrl)
+
+	amount := models.NewMoney(64.5)
+	confirmation := &models.RecurringConfirmation{RecurringTransactionID: 2, Occurrence: "2025-07-05T00:00:00.000Z",
+		Amount: &amount}
+	transaction := &models.Transaction{ID: 9, Amount: amount, Type: models.EXPENSE, Category: "Electricity"}
+
+	tests := []struct {
+		description    string
+		body           []byte
+		expectedOutput interface{}
+		expectedErr    error
+		execMocks      func(ctx *gofr.Context)
+	}{
+		{"Success Case", []byte(`{"recurringTransactionID":2,"occurrence":"2025-07-05T00:00:00.000Z","amount":64.5}`),
+			transaction, nil, func(ctx *gofr.Context) {
+				transactionSvc.EXPECT().ConfirmPending(ctx, confirmation).Return(transaction, nil)
+			}},
+		{"Failure Case: Error from service layer",
+			[]byte(`{"recurringTransactionID":2,"occurrence":"2025-07-05T00:00:00.000Z","amount":64.5}`), nil,
+			errors.New("pending occurrence not found"), func(ctx *gofr.Context) {
+				transactionSvc.EXPECT().ConfirmPending(ctx, confirmation).
+					Return(nil, errors.New("pending occurrence not found"))
+			}},
+		{"Failure Case: bind error", []byte(`{"occurrence":`), nil, errors.New("bind error"),
+			func(ctx *gofr.Context) {
+			}},
+	}
+
+	for i, tc := range tests {
+		i := i
+		tc := tc
+
+		t.Run(tc.description, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/recurring-transaction/pending/confirm", bytes.NewBuffer(tc.body))
+			req.Header.Set("Content-Type", "application/json")
+
+			gofrReq := gofrHTTP.NewRequest(req)
+			mockContainer, _ := container.NewMockContainer(t)
+			ctx := gofr.Context{Context: context.Background(), Request: gofrReq, Container: mockContainer, Out: nil}
+
+			tc.execMocks(&ctx)
+
+			h := New(transactionSvc)
+
+			output, err := h.ConfirmPending(&ctx)
+
+			assert.Equalf(t, tc.expectedOutput, output, "TEST[%d], failed.\n%s", i, tc.description)
+			assert.Equalf(t, tc.expectedErr, err, "TEST[%d], failed.\n%s", i, tc.description)
+		})
+	}
+}
+
+func Test_RejectPending(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	transactionSvc := services.NewMockRecurringTransactions(ctrl)
+
+	confirmation := &models.RecurringConfirmation{RecurringTransactionID: 2, Occurrence: "2025-07-05T00:00:00.000Z"}
+
+	tests := []struct {
+		description    string
+		body           []byte
+		expectedOutput interface{}
+		expectedErr    error
+		execMocks      func(ctx *gofr.Context)
+	}{
+		{"Success Case", []byte(`{"recurringTransactionID":2,"occurrence":"2025-07-05T00:00:00.000Z"}`),
+			"pending occurrence rejected successfully", nil, func(ctx *gofr.Context) {
+				transactionSvc.EXPECT().RejectPending(ctx, confirmation).Return(nil)
+			}},
+		{"Failure Case: Error from service layer",
+			[]byte(`{"recurringTransactionID":2,"occurrence":"2025-07-05T00:00:00.000Z"}`), nil,
+			errors.New("the recurring transaction does not require approval"), func(ctx *gofr.Context) {
+				transactionSvc.EXPECT().RejectPending(ctx, confirmation).
+					Return(errors.New("the recurring transaction does not require approval"))
+			}},
+		{"Failure Case: bind error", []byte(`{"occurrence":`), nil, errors.New("bind error"),
+			func(ctx *gofr.Context) {
+			}},
+	}
+
+	for i, tc := range tests {
+		i := i
+		tc := tc
+
+		t.Run(tc.description, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/recurring-transaction/pending/reject", bytes.NewBuffer(tc.body))
+			req.Header.Set("Content-Type", "application/json")
+
+			gofrReq := gofrHTTP.NewRequest(req)
+			mockContainer, _ := container.NewMockContainer(t)
+			ctx := gofr.Context{Context: context.Background(), Request: gofrReq, Container: mockContainer, Out: nil}
+
+			tc.execMocks(&ctx)
+
+			h := New(transactionSvc)
+
+			output, err := h.RejectPending(&ctx)
+
+			assert.Equalf(t, tc.expectedOutput, output, "TEST[%d], failed.\n%s", i, tc.description)
+			assert.Equalf(t, tc.expectedErr, err, "TEST[%d], failed.\n%s", i, tc.description)
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package reports
+
+import (
+	"errors"
+	"moneyManagement/filters"
+	"moneyManagement/handler"
+	"moneyManagement/services"
+	"strconv"
+	"strings"
+
+	"gofr.dev/pkg/gofr"
+)
+
+type reports struct {
+	reportSvc services.Reports
+}
+
+func New(reportSvc services.Reports) handler.Reports {
+	return &reports{reportSvc: reportSvc}
+}
+
+// Trends returns the income, expense and savings per `interval` between the optional `startDate` and `endDate`, of
+// the transactions matching `type`, `category` and `accountId` like the transactions list.
+func (h *reports) Trends(ctx *gofr.Context) (interface{}, error) {
+	var (
+		f      filters.Transactions
+		window int
+		err    error
+	)
+
+	f.Type = ctx.Params("type")
+	f.Category = ctx.Params("category")
+
+	if startDate := strings.TrimSpace(ctx.Param("startDate")); startDate != "" {
+		f.StartDate = startDate + " 00:00:00"
+	}
+
+	if endDate := strings.TrimSpace(ctx.Param("endDate")); endDate != "" {
+		f.EndDate = endDate + " 23:59:59"
+	}
+
+	for _, accountID := range ctx.Params("accountId") {
+		if strings.TrimSpace(accountID) == "" {
+			continue
+		}
+
+		id, err := strconv.Atoi(strings.TrimSpace(accountID))
+		if err != nil {
+			return nil, errors.New("invalid id")
+		}
+
+		f.AccountIDs = append(f.AccountIDs, id)
+	}
+
+	if windowString := strings.TrimSpace(ctx.Param("window")); windowString != "" {
+		window, err = strconv.Atoi(windowString)
+		if err != nil {
+			return nil, errors.New("invalid window")
+		}
+	}
+
+	report, err := h.reportSvc.Trends(ctx, &f, strings.TrimSpace(ctx.Param("interval")), window)
+	if err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
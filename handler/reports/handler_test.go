@@ -0,0 +1,85 @@
+package reports
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	gofrHTTP "gofr.dev/pkg/gofr/http"
+	"moneyManagement/filters"
+	"moneyManagement/models"
+	"moneyManagement/services"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func Test_Trends(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	reportSvc := services.NewMockReports(ctrl)
+
+	report := &models.TrendReport{Currency: "INR", Interval: "month", From: "2025-01-01", To: "2025-02-28", Window: 3,
+		Buckets: []models.TrendBucket{
+			{Start: "2025-01-01", End: "2025-01-31", Income: models.NewMoney(1000), Expense: models.NewMoney(600),
+				SavingsRate: 40, IncomeAverage: models.NewMoney(1000), ExpenseAverage: models.NewMoney(600)},
+			{Start: "2025-02-01", End: "2025-02-28", Income: models.NewMoney(1000), Expense: models.NewMoney(800),
+				SavingsRate: 30, IncomeAverage: models.NewMoney(1000), ExpenseAverage: models.NewMoney(700)},
+		},
+		Series: []models.TrendSeries{{Type: models.EXPENSE, Category: "Food", Total: models.NewMoney(1400),
+			Values: []models.Money{models.NewMoney(600), models.NewMoney(800)}}}}
+
+	tests := []struct {
+		description    string
+		query          url.Values
+		expectedOutput interface{}
+		expectedErr    error
+		execMocks      func(ctx *gofr.Context)
+	}{
+		{"Success Case", url.Values{"interval": {"month"}, "startDate": {"2025-01-01"}, "endDate": {"2025-02-28"},
+			"category": {"Food"}, "accountId": {"1,2"}}, report, nil, func(ctx *gofr.Context) {
+			reportSvc.EXPECT().Trends(ctx, &filters.Transactions{Category: []string{"Food"}, AccountIDs: []int{1, 2},
+				StartDate: "2025-01-01 00:00:00", EndDate: "2025-02-28 23:59:59"}, "month", 0).Return(report, nil)
+		}},
+		{"Success Case: defaults", url.Values{"window": {"6"}}, report, nil, func(ctx *gofr.Context) {
+			reportSvc.EXPECT().Trends(ctx, &filters.Transactions{}, "", 6).Return(report, nil)
+		}},
+		{"Failure Case: Error from service layer", url.Values{"interval": {"hour"}}, nil,
+			errors.New("invalid interval, use day, week, month or year"), func(ctx *gofr.Context) {
+				reportSvc.EXPECT().Trends(ctx, &filters.Transactions{}, "hour", 0).
+					Return(nil, errors.New("invalid interval, use day, week, month or year"))
+			}},
+		{"Failure Case: invalid window", url.Values{"window": {"three"}}, nil, errors.New("invalid window"),
+			func(ctx *gofr.Context) {
+			}},
+		{"Failure Case: invalid id", url.Values{"accountId": {"abc"}}, nil, errors.New("invalid id"),
+			func(ctx *gofr.Context) {
+			}},
+	}
+
+	for i, tc := range tests {
+		i := i
+		tc := tc
+
+		t.Run(tc.description, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/reports/trends", nil)
+			req.Header.Set("Content-Type", "application/json")
+			req.URL.RawQuery = tc.query.Encode()
+
+			gofrReq := gofrHTTP.NewRequest(req)
+			mockContainer, _ := container.NewMockContainer(t)
+			ctx := gofr.Context{Context: context.Background(), Request: gofrReq, Container: mockContainer, Out: nil}
+
+			tc.execMocks(&ctx)
+
+			h := New(reportSvc)
+
+			output, err := h.Trends(&ctx)
+
+			assert.Equalf(t, tc.expectedOutput, output, "TEST[%d], failed.\n%s", i, tc.description)
+			assert.Equalf(t, tc.expectedErr, err, "TEST[%d], failed.\n%s", i, tc.description)
+		})
+	}
+}
@@ -21,7 +21,7 @@ func Test_Create(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	savingsSvc := services.NewMockSavings(ctrl)
 
-	saving := &models.Savings{ID: 1, UserID: 1, TransactionID: 1, Amount: 100, Type: "FD"}
+	saving := &models.Savings{ID: 1, UserID: 1, TransactionID: 1, Amount: models.NewMoney(100), Type: "FD"}
 
 	tests := []struct {
 		description    string
@@ -32,11 +32,11 @@ func Test_Create(t *testing.T) {
 	}{
 		{"Success Case", []byte(`{"userID":1,"transactionID":1,"amount":100,"type":"FD"}`), saving, nil,
 			func(ctx *gofr.Context) {
-				savingsSvc.EXPECT().Create(ctx, &models.Savings{UserID: 1, TransactionID: 1, Amount: 100, Type: "FD"}).Return(saving, nil)
+				savingsSvc.EXPECT().Create(ctx, &models.Savings{UserID: 1, TransactionID: 1, Amount: models.NewMoney(100), Type: "FD"}).Return(saving, nil)
 			}},
 		{"Failure Case: Error from service layer", []byte(`{"userID":1,"transactionID":1,"amount":100,"type":"FD"}`), nil, errors.New("error"),
 			func(ctx *gofr.Context) {
-				savingsSvc.EXPECT().Create(ctx, &models.Savings{UserID: 1, TransactionID: 1, Amount: 100, Type: "FD"}).Return(nil, errors.New("error"))
+				savingsSvc.EXPECT().Create(ctx, &models.Savings{UserID: 1, TransactionID: 1, Amount: models.NewMoney(100), Type: "FD"}).Return(nil, errors.New("error"))
 			}},
 		{"Failure Case: bind error", []byte(`{`), nil, errors.New("bind error"), func(ctx *gofr.Context) {
 		}},
@@ -69,7 +69,7 @@ func Test_Update(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	savingsSvc := services.NewMockSavings(ctrl)
 
-	saving := &models.Savings{ID: 1, UserID: 1, TransactionID: 1, Amount: 100, Type: "FD"}
+	saving := &models.Savings{ID: 1, UserID: 1, TransactionID: 1, Amount: models.NewMoney(100), Type: "FD"}
 
 	tests := []struct {
 		description    string
@@ -170,7 +170,7 @@ func Test_GetByID(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	savingsSvc := services.NewMockSavings(ctrl)
 
-	saving := &models.Savings{ID: 1, UserID: 1, TransactionID: 1, Amount: 100, Type: "FD"}
+	saving := &models.Savings{ID: 1, UserID: 1, TransactionID: 1, Amount: models.NewMoney(100), Type: "FD"}
 
 	tests := []struct {
 		description    string
@@ -220,7 +220,7 @@ func Test_GetAll(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	savingsSvc := services.NewMockSavings(ctrl)
 
-	saving := &models.Savings{ID: 1, UserID: 1, TransactionID: 1, Amount: 100, Type: "FD"}
+	saving := &models.Savings{ID: 1, UserID: 1, TransactionID: 1, Amount: models.NewMoney(100), Type: "FD"}
 
 	tests := []struct {
 		description    string
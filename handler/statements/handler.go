@@ -0,0 +1,71 @@
+package statements
+
+import (
+	"errors"
+	"gofr.dev/pkg/gofr"
+	"mime/multipart"
+	"moneyManagement/handler"
+	"moneyManagement/models"
+	"moneyManagement/services"
+)
+
+type statementRequest struct {
+	File      *multipart.FileHeader `file:"file"`
+	Layout    string                `form:"layout"`
+	AccountID int                   `form:"accountId"`
+}
+
+type statementsHandler struct {
+	statementSvc services.Statements
+}
+
+func New(statementSvc services.Statements) handler.Statements {
+	return &statementsHandler{statementSvc: statementSvc}
+}
+
+func (h *statementsHandler) Parse(ctx *gofr.Context) (interface{}, error) {
+	var req statementRequest
+
+	err := ctx.Bind(&req)
+	if err != nil {
+		return nil, errors.New("bind error")
+	}
+
+	if req.File == nil {
+		return nil, errors.New("missing file")
+	}
+
+	file, err := req.File.Open()
+	if err != nil {
+		return nil, errors.New("invalid file")
+	}
+
+	defer file.Close()
+
+	draft, err := h.statementSvc.Parse(ctx, file, req.Layout, req.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return draft, nil
+}
+
+func (h *statementsHandler) Confirm(ctx *gofr.Context) (interface{}, error) {
+	var confirmation *models.StatementConfirmation
+
+	err := ctx.Bind(&confirmation)
+	if err != nil {
+		return nil, errors.New("bind error")
+	}
+
+	if confirmation.AccountID == 0 {
+		return nil, errors.New("invalid account id")
+	}
+
+	transactions, err := h.statementSvc.Confirm(ctx, confirmation)
+	if err != nil {
+		return nil, err
+	}
+
+	return transactions, nil
+}
@@ -0,0 +1,129 @@
+package statements
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	gofrHTTP "gofr.dev/pkg/gofr/http"
+	"mime/multipart"
+	"moneyManagement/models"
+	"moneyManagement/services"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func Test_Parse(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	statementSvc := services.NewMockStatements(ctrl)
+
+	draft := &models.StatementDraft{Layout: "debit-credit", Transactions: []*models.Transaction{{Amount: models.NewMoney(100), Type: "EXPENSE"}}}
+
+	tests := []struct {
+		description    string
+		file           string
+		expectedOutput interface{}
+		expectedErr    error
+		execMocks      func(ctx *gofr.Context)
+	}{
+		{"Success Case", "%PDF-1.4", draft, nil,
+			func(ctx *gofr.Context) {
+				statementSvc.EXPECT().Parse(ctx, gomock.Any(), "debit-credit", 1).Return(draft, nil)
+			}},
+		{"Failure Case: Error from service layer", "%PDF-1.4", nil, errors.New("error"),
+			func(ctx *gofr.Context) {
+				statementSvc.EXPECT().Parse(ctx, gomock.Any(), "debit-credit", 1).Return(nil, errors.New("error"))
+			}},
+		{"Failure Case: missing file", "", nil, errors.New("missing file"), func(ctx *gofr.Context) {
+		}},
+	}
+
+	for i, tc := range tests {
+		i := i
+		tc := tc
+
+		t.Run(tc.description, func(t *testing.T) {
+			body := &bytes.Buffer{}
+			writer := multipart.NewWriter(body)
+
+			if tc.file != "" {
+				part, _ := writer.CreateFormFile("file", "statement.pdf")
+				_, _ = part.Write([]byte(tc.file))
+			}
+
+			_ = writer.WriteField("layout", "debit-credit")
+			_ = writer.WriteField("accountId", "1")
+			_ = writer.Close()
+
+			req := httptest.NewRequest(http.MethodPost, "/transaction/statement", body)
+			req.Header.Set("Content-Type", writer.FormDataContentType())
+			gofrReq := gofrHTTP.NewRequest(req)
+			mockContainer, _ := container.NewMockContainer(t)
+			ctx := gofr.Context{Context: context.Background(), Request: gofrReq, Container: mockContainer, Out: nil}
+
+			tc.execMocks(&ctx)
+
+			h := New(statementSvc)
+
+			output, err := h.Parse(&ctx)
+
+			assert.Equalf(t, tc.expectedOutput, output, "TEST[%d], failed.\n%s", i, tc.description)
+			assert.Equalf(t, tc.expectedErr, err, "TEST[%d], failed.\n%s", i, tc.description)
+		})
+	}
+}
+
+func Test_Confirm(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	statementSvc := services.NewMockStatements(ctrl)
+
+	confirmation := &models.StatementConfirmation{AccountID: 1, Transactions: []*models.Transaction{{Amount: models.NewMoney(100), Type: "EXPENSE"}}}
+	transactions := []*models.Transaction{{ID: 1, Account: models.AccountDetails{ID: 1}, Amount: models.NewMoney(100), Type: "EXPENSE"}}
+
+	tests := []struct {
+		description    string
+		body           []byte
+		expectedOutput interface{}
+		expectedErr    error
+		execMocks      func(ctx *gofr.Context)
+	}{
+		{"Success Case", []byte(`{"accountID":1,"transactions":[{"amount":100,"type":"EXPENSE"}]}`), transactions, nil,
+			func(ctx *gofr.Context) {
+				statementSvc.EXPECT().Confirm(ctx, confirmation).Return(transactions, nil)
+			}},
+		{"Failure Case: Error from service layer", []byte(`{"accountID":1,"transactions":[{"amount":100,"type":"EXPENSE"}]}`), nil, errors.New("error"),
+			func(ctx *gofr.Context) {
+				statementSvc.EXPECT().Confirm(ctx, confirmation).Return(nil, errors.New("error"))
+			}},
+		{"Failure Case: invalid account id", []byte(`{"transactions":[]}`), nil, errors.New("invalid account id"), func(ctx *gofr.Context) {
+		}},
+		{"Failure Case: bind error", []byte(`{`), nil, errors.New("bind error"), func(ctx *gofr.Context) {
+		}},
+	}
+
+	for i, tc := range tests {
+		i := i
+		tc := tc
+
+		t.Run(tc.description, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/transaction/statement/confirm", bytes.NewBuffer(tc.body))
+			req.Header.Set("Content-Type", "application/json")
+			gofrReq := gofrHTTP.NewRequest(req)
+			mockContainer, _ := container.NewMockContainer(t)
+			ctx := gofr.Context{Context: context.Background(), Request: gofrReq, Container: mockContainer, Out: nil}
+
+			tc.execMocks(&ctx)
+
+			h := New(statementSvc)
+
+			output, err := h.Confirm(&ctx)
+
+			assert.Equalf(t, tc.expectedOutput, output, "TEST[%d], failed.\n%s", i, tc.description)
+			assert.Equalf(t, tc.expectedErr, err, "TEST[%d], failed.\n%s", i, tc.description)
+		})
+	}
+}
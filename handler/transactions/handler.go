@@ -1,8 +1,10 @@
 package transactions
 
 import (
+	"encoding/json"
 	"errors"
 	"gofr.dev/pkg/gofr"
+	"mime/multipart"
 	"moneyManagement/filters"
 	"moneyManagement/handler"
 	"moneyManagement/models"
@@ -11,6 +13,12 @@ import (
 	"strings"
 )
 
+type importRequest struct {
+	File    *multipart.FileHeader `file:"file"`
+	Mapping string                `form:"mapping"`
+	DryRun  bool                  `form:"dryRun"`
+}
+
 type transactionsHandler struct {
 	transactionSvc services.Transactions
 }
@@ -110,3 +118,39 @@ func (h *transactionsHandler) Delete(ctx *gofr.Context) (interface{}, error) {
 
 	return "transaction deleted successfully", nil
 }
+
+func (h *transactionsHandler) Import(ctx *gofr.Context) (interface{}, error) {
+	var req importRequest
+
+	err := ctx.Bind(&req)
+	if err != nil {
+		return nil, errors.New("bind error")
+	}
+
+	if req.File == nil {
+		return nil, errors.New("missing file")
+	}
+
+	var mapping models.ImportColumnMapping
+
+	if req.Mapping != "" {
+		err = json.Unmarshal([]byte(req.Mapping), &mapping)
+		if err != nil {
+			return nil, errors.New("invalid mapping")
+		}
+	}
+
+	file, err := req.File.Open()
+	if err != nil {
+		return nil, errors.New("invalid file")
+	}
+
+	defer file.Close()
+
+	result, err := h.transactionSvc.Import(ctx, file, &mapping, req.DryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -10,6 +10,7 @@ import (
 	"gofr.dev/pkg/gofr"
 	"gofr.dev/pkg/gofr/container"
 	gofrHTTP "gofr.dev/pkg/gofr/http"
+	"mime/multipart"
 	"moneyManagement/filters"
 	"moneyManagement/models"
 	"moneyManagement/services"
@@ -23,7 +24,7 @@ func Test_Create(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	transactionSvc := services.NewMockTransactions(ctrl)
 
-	transaction := &models.Transaction{ID: 1, UserID: 1, Account: models.AccountDetails{ID: 1}, Amount: 100, Type: "EXPENSE"}
+	transaction := &models.Transaction{ID: 1, UserID: 1, Account: models.AccountDetails{ID: 1}, Amount: models.NewMoney(100), Type: "EXPENSE"}
 
 	tests := []struct {
 		description    string
@@ -34,11 +35,11 @@ func Test_Create(t *testing.T) {
 	}{
 		{"Success Case", []byte(`{"userId":1,"account":{"id":1},"amount":100,"type":"EXPENSE"}`), transaction, nil,
 			func(ctx *gofr.Context) {
-				transactionSvc.EXPECT().Create(ctx, &models.Transaction{UserID: 1, Account: models.AccountDetails{ID: 1}, Amount: 100, Type: "EXPENSE"}).Return(transaction, nil)
+				transactionSvc.EXPECT().Create(ctx, &models.Transaction{UserID: 1, Account: models.AccountDetails{ID: 1}, Amount: models.NewMoney(100), Type: "EXPENSE"}).Return(transaction, nil)
 			}},
 		{"Failure Case: Error from service layer", []byte(`{"userId":1,"account":{"id":1},"amount":100,"type":"EXPENSE"}`), nil, errors.New("error"),
 			func(ctx *gofr.Context) {
-				transactionSvc.EXPECT().Create(ctx, &models.Transaction{UserID: 1, Account: models.AccountDetails{ID: 1}, Amount: 100, Type: "EXPENSE"}).Return(nil, errors.New("error"))
+				transactionSvc.EXPECT().Create(ctx, &models.Transaction{UserID: 1, Account: models.AccountDetails{ID: 1}, Amount: models.NewMoney(100), Type: "EXPENSE"}).Return(nil, errors.New("error"))
 			}},
 		{"Failure Case: bind error", []byte(`{`), nil, errors.New("bind error"), func(ctx *gofr.Context) {
 		}},
@@ -71,7 +72,7 @@ func Test_Update(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	transactionSvc := services.NewMockTransactions(ctrl)
 
-	transaction := &models.Transaction{ID: 1, UserID: 1, Account: models.AccountDetails{ID: 1}, Amount: 100, Type: "EXPENSE"}
+	transaction := &models.Transaction{ID: 1, UserID: 1, Account: models.AccountDetails{ID: 1}, Amount: models.NewMoney(100), Type: "EXPENSE"}
 
 	tests := []struct {
 		description    string
@@ -172,7 +173,7 @@ func Test_GetByID(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	transactionSvc := services.NewMockTransactions(ctrl)
 
-	transaction := &models.Transaction{ID: 1, UserID: 1, Account: models.AccountDetails{ID: 1}, Amount: 100, Type: "EXPENSE"}
+	transaction := &models.Transaction{ID: 1, UserID: 1, Account: models.AccountDetails{ID: 1}, Amount: models.NewMoney(100), Type: "EXPENSE"}
 
 	tests := []struct {
 		description    string
@@ -222,7 +223,7 @@ func Test_GetAll(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	transactionSvc := services.NewMockTransactions(ctrl)
 
-	transaction := &models.Transaction{ID: 1, UserID: 1, Account: models.AccountDetails{ID: 1}, Amount: 100, Type: "EXPENSE"}
+	transaction := &models.Transaction{ID: 1, UserID: 1, Account: models.AccountDetails{ID: 1}, Amount: models.NewMoney(100), Type: "EXPENSE"}
 
 	tests := []struct {
 		description    string
@@ -266,3 +267,67 @@ func Test_GetAll(t *testing.T) {
 		})
 	}
 }
+
+func Test_Import(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	transactionSvc := services.NewMockTransactions(ctrl)
+
+	csvFile := "date,amount,type,category,description,account\n2025-03-01,100,EXPENSE,Groceries,milk,Bank\n"
+	result := &models.ImportResult{DryRun: true, TotalRows: 1}
+
+	tests := []struct {
+		description    string
+		file           string
+		mapping        string
+		expectedOutput interface{}
+		expectedErr    error
+		execMocks      func(ctx *gofr.Context)
+	}{
+		{"Success Case", csvFile, `{"date":"Date"}`, result, nil,
+			func(ctx *gofr.Context) {
+				transactionSvc.EXPECT().Import(ctx, gomock.Any(), &models.ImportColumnMapping{Date: "Date"}, true).Return(result, nil)
+			}},
+		{"Failure Case: Error from service layer", csvFile, "", nil, errors.New("error"),
+			func(ctx *gofr.Context) {
+				transactionSvc.EXPECT().Import(ctx, gomock.Any(), &models.ImportColumnMapping{}, true).Return(nil, errors.New("error"))
+			}},
+		{"Failure Case: invalid mapping", csvFile, `{`, nil, errors.New("invalid mapping"), func(ctx *gofr.Context) {
+		}},
+		{"Failure Case: missing file", "", "", nil, errors.New("missing file"), func(ctx *gofr.Context) {
+		}},
+	}
+
+	for i, tc := range tests {
+		i := i
+		tc := tc
+
+		t.Run(tc.description, func(t *testing.T) {
+			body := &bytes.Buffer{}
+			writer := multipart.NewWriter(body)
+
+			if tc.file != "" {
+				part, _ := writer.CreateFormFile("file", "transactions.csv")
+				_, _ = part.Write([]byte(tc.file))
+			}
+
+			_ = writer.WriteField("mapping", tc.mapping)
+			_ = writer.WriteField("dryRun", "true")
+			_ = writer.Close()
+
+			req := httptest.NewRequest(http.MethodPost, "/transaction/import", body)
+			req.Header.Set("Content-Type", writer.FormDataContentType())
+			gofrReq := gofrHTTP.NewRequest(req)
+			mockContainer, _ := container.NewMockContainer(t)
+			ctx := gofr.Context{Context: context.Background(), Request: gofrReq, Container: mockContainer, Out: nil}
+
+			tc.execMocks(&ctx)
+
+			h := New(transactionSvc)
+
+			output, err := h.Import(&ctx)
+
+			assert.Equalf(t, tc.expectedOutput, output, "TEST[%d], failed.\n%s", i, tc.description)
+			assert.Equalf(t, tc.expectedErr, err, "TEST[%d], failed.\n%s", i, tc.description)
+		})
+	}
+}
@@ -1,50 +1,122 @@
 package main
 
 import (
+	"github.com/unidoc/unipdf/v3/common/license"
 	"gofr.dev/pkg/gofr"
 	"moneyManagement/middlewares"
 	"moneyManagement/migrations"
 	"moneyManagement/services/auth"
 	"moneyManagement/stores/accounts"
+	"moneyManagement/stores/balanceLedger"
+	"moneyManagement/stores/balanceRepairs"
+	"moneyManagement/stores/budgets"
+	"moneyManagement/stores/calendarFeeds"
+	"moneyManagement/stores/categories"
+	"moneyManagement/stores/exchangeRates"
+	"moneyManagement/stores/holidays"
+	"moneyManagement/stores/reconciliations"
+	"moneyManagement/stores/recurringExceptions"
 	"moneyManagement/stores/recurringTransactions"
 	"moneyManagement/stores/savings"
+	"moneyManagement/stores/savingsValuations"
+	"moneyManagement/stores/transactionSplits"
 	"moneyManagement/stores/transactions"
 	"moneyManagement/stores/users"
+	"os"
 
 	validatorSvc "moneyManagement/services/Validator"
 	accountService "moneyManagement/services/accounts"
+	budgetService "moneyManagement/services/budgets"
+	calendarFeedService "moneyManagement/services/calendarFeeds"
+	categoryService "moneyManagement/services/categories"
+	creditCardService "moneyManagement/services/creditCards"
 	dashboardService "moneyManagement/services/dashboard"
+	exchangeRateService "moneyManagement/services/exchangeRates"
+	forecastService "moneyManagement/services/forecast"
+	holidayService "moneyManagement/services/holidays"
+	netWorthService "moneyManagement/services/netWorth"
+	reconciliationService "moneyManagement/services/reconciliations"
 	recurringTransactionService "moneyManagement/services/recurringTransactions"
+	reportService "moneyManagement/services/reports"
 	savingsService "moneyManagement/services/savings"
+	statementService "moneyManagement/services/statements"
 	transactionService "moneyManagement/services/transactions"
 	usersService "moneyManagement/services/users"
 
 	accountsHandler "moneyManagement/handler/accounts"
 	authHandlers "moneyManagement/handler/auth"
+	budgetsHandler "moneyManagement/handler/budgets"
+	calendarFeedsHandler "moneyManagement/handler/calendarFeeds"
+	categoriesHandler "moneyManagement/handler/categories"
+	creditCardsHandler "moneyManagement/handler/creditCards"
 	dashboardHandlers "moneyManagement/handler/dashboard"
+	exchangeRatesHandler "moneyManagement/handler/exchangeRates"
+	forecastHandlers "moneyManagement/handler/forecast"
+	holidaysHandler "moneyManagement/handler/holidays"
+	netWorthHandlers "moneyManagement/handler/netWorth"
+	reconciliationsHandler "moneyManagement/handler/reconciliations"
 	recurringTransactionsHandler "moneyManagement/handler/recurringTransactions"
+	reportsHandler "moneyManagement/handler/reports"
 	savingsHandler "moneyManagement/handler/savings"
+	statementsHandler "moneyManagement/handler/statements"
 	transactionsHandler "moneyManagement/handler/transactions"
 	usersHandler "moneyManagement/handler/users"
 )
 
 func main() {
+	// Given a subcommand, e.g. `check-balances` or `repair-balances`, the binary runs it instead of the server
+	if len(os.Args) > 1 {
+		runCommand()
+		return
+	}
+
 	app := gofr.New()
 
 	app.Migrate(migrations.All())
 
+	if key := app.Config.Get("UNIDOC_LICENSE_API_KEY"); key != "" {
+		err := license.SetMeteredKey(key)
+		if err != nil {
+			app.Logger().Errorf("error setting unidoc license key: %v", err)
+		}
+	}
+
 	userStore := users.New()
 	accountStore := accounts.New()
+	ledgerStore := balanceLedger.New()
+	repairStore := balanceRepairs.New()
 	transactionStore := transactions.New()
+	transactionSplitStore := transactionSplits.New()
 	savingStore := savings.New()
+	valuationStore := savingsValuations.New()
+	calendarFeedStore := calendarFeeds.New()
 	recurringTransactionStore := recurringTransactions.New()
+	recurringExceptionStore := recurringExceptions.New()
+	holidayStore := holidays.New()
+	categoryStore := categories.New()
+	budgetStore := budgets.New()
+	exchangeRateStore := exchangeRates.New()
+	reconciliationStore := reconciliations.New()
 
-	userSvc := usersService.New(userStore)
-	accountSvc := accountService.New(accountStore, userSvc)
-	savingsSvc := savingsService.New(savingStore)
-	transactionSvc := transactionService.New(transactionStore, accountSvc, savingsSvc, userSvc)
-	dashboardSvc := dashboardService.New(accountSvc, transactionSvc, userSvc)
-	recurringTransactionSvc := recurringTransactionService.New(recurringTransactionStore, userSvc)
+	categorySvc := categoryService.New(categoryStore)
+	userSvc := usersService.New(userStore, categorySvc)
+	accountSvc := accountService.New(accountStore, ledgerStore, repairStore, userSvc)
+	savingsSvc := savingsService.New(savingStore, valuationStore)
+	exchangeRateSvc := exchangeRateService.New(exchangeRateStore, userSvc)
+	transactionSvc := transactionService.New(transactionStore, transactionSplitStore, accountSvc, savingsSvc, categorySvc,
+		exchangeRateSvc, userSvc)
+	creditCardSvc := creditCardService.New(accountSvc, transactionSvc)
+	reconciliationSvc := reconciliationService.New(reconciliationStore, accountSvc, transactionSvc)
+	budgetSvc := budgetService.New(budgetStore, transactionSvc, accountSvc, categorySvc, exchangeRateSvc)
+	dashboardSvc := dashboardService.New(accountSvc, transactionSvc, categorySvc, budgetSvc, exchangeRateSvc, userSvc)
+	netWorthSvc := netWorthService.New(accountSvc, savingsSvc, exchangeRateSvc)
+	reportSvc := reportService.New(transactionSvc, exchangeRateSvc)
+	recurringTransactionSvc := recurringTransactionService.New(recurringTransactionStore, recurringExceptionStore, holidayStore,
+		transactionSvc, userSvc)
+	forecastSvc := forecastService.New(accountSvc, transactionSvc, recurringTransactionSvc)
+	holidaySvc := holidayService.New(holidayStore)
+	calendarFeedSvc := calendarFeedService.New(calendarFeedStore, recurringTransactionSvc, savingsSvc)
+	statementSvc := statementService.New(transactionSvc)
 	authSvc := auth.New(app.Config.Get("REFRESH_SECRET"), app.Config.Get("ACCESS_SECRET"), app.Config.Get("GOOGLE_CLIENT_ID"),
 		app.Config.Get("GOOGLE_CLIENT_SECRET"), app.Config.Get("REDIRECT_URL"))
 	validator := validatorSvc.New(app.Config.Get("ACCESS_SECRET"))
@@ -54,16 +126,31 @@ func main() {
 	savingHandler := savingsHandler.New(savingsSvc)
 	transactionHandler := transactionsHandler.New(transactionSvc)
 	dashboardHandler := dashboardHandlers.New(dashboardSvc)
+	netWorthHandler := netWorthHandlers.New(netWorthSvc)
+	reportHandler := reportsHandler.New(reportSvc)
+	forecastHandler := forecastHandlers.New(forecastSvc)
 	authHandler := authHandlers.New(authSvc, userSvc)
 	recurringTransactionHandler := recurringTransactionsHandler.New(recurringTransactionSvc)
+	statementHandler := statementsHandler.New(statementSvc)
+	categoryHandler := categoriesHandler.New(categorySvc)
+	budgetHandler := budgetsHandler.New(budgetSvc)
+	exchangeRateHandler := exchangeRatesHandler.New(exchangeRateSvc)
+	reconciliationHandler := reconciliationsHandler.New(reconciliationSvc)
+	creditCardHandler := creditCardsHandler.New(creditCardSvc)
+	calendarFeedHandler := calendarFeedsHandler.New(calendarFeedSvc)
+	holidayHandler := holidaysHandler.New(holidaySvc)
 
 	app.UseMiddleware(middlewares.Authorization([]middlewares.ExemptPath{
 		{Path: "^/google-token$", Method: "POST"},
 		{Path: "^/login$", Method: "POST"},
 		{Path: "^/refresh$", Method: "POST"},
+		{Path: "^/calendar/[0-9a-f]{64}\\.ics$", Method: "GET"},
 	}, validator, userSvc))
 
 	app.GET("/dashboard", dashboardHandler.Get)
+	app.GET("/net-worth", netWorthHandler.Get)
+	app.GET("/reports/trends", reportHandler.Trends)
+	app.GET("/forecast", forecastHandler.Get)
 
 	app.POST("/user", userHandler.Create)
 	app.GET("/user", userHandler.GetAll)
@@ -76,6 +163,16 @@ func main() {
 	app.GET("/account/{id}", accountHandler.GetByID)
 	app.PUT("/account/{id}", accountHandler.Update)
 	app.DELETE("/account/{id}", accountHandler.Delete)
+	app.GET("/account/{id}/balance-history", accountHandler.BalanceHistory)
+	app.GET("/account/{id}/statements", creditCardHandler.Statements)
+	app.POST("/account/{id}/reconciliation", reconciliationHandler.Create)
+	app.GET("/account/{id}/reconciliation", reconciliationHandler.GetAll)
+	app.GET("/reconciliation/{id}", reconciliationHandler.GetByID)
+	app.PUT("/reconciliation/{id}/transactions", reconciliationHandler.Clear)
+	app.POST("/reconciliation/{id}/lock", reconciliationHandler.Lock)
+
+	app.GET("/balance-check", accountHandler.CheckBalances)
+	app.POST("/balance-check/repair", accountHandler.RepairBalances)
 
 	app.POST("/savings", savingHandler.Create)
 	app.GET("/savings", savingHandler.GetAll)
@@ -84,16 +181,62 @@ func main() {
 	app.DELETE("/savings/{id}", savingHandler.Delete)
 
 	app.POST("/transaction", transactionHandler.Create)
+	app.POST("/transaction/import", transactionHandler.Import)
+	app.POST("/transaction/statement", statementHandler.Parse)
+	app.POST("/transaction/statement/confirm", statementHandler.Confirm)
 	app.GET("/transaction", transactionHandler.GetAll)
 	app.GET("/transaction/{id}", transactionHandler.GetByID)
 	app.PUT("/transaction/{id}", transactionHandler.Update)
 	app.DELETE("/transaction/{id}", transactionHandler.Delete)
 
+	app.POST("/category", categoryHandler.Create)
+	app.GET("/category", categoryHandler.GetAll)
+	app.GET("/category/{id}", categoryHandler.GetByID)
+	app.PUT("/category/{id}", categoryHandler.Update)
+	app.DELETE("/category/{id}", categoryHandler.Delete)
+	app.POST("/category/{id}/merge", categoryHandler.Merge)
+
+	app.POST("/budget", budgetHandler.Create)
+	app.GET("/budget", budgetHandler.GetAll)
+	app.GET("/budget/{id}", budgetHandler.GetByID)
+	app.PUT("/budget/{id}", budgetHandler.Update)
+	app.DELETE("/budget/{id}", budgetHandler.Delete)
+	app.GET("/budget/{month}/status", budgetHandler.Status)
+
+	app.POST("/exchange-rate", exchangeRateHandler.Create)
+	app.POST("/exchange-rate/import", exchangeRateHandler.Import)
+	app.GET("/exchange-rate", exchangeRateHandler.GetAll)
+
 	app.POST("/recurring-transaction", recurringTransactionHandler.Create)
 	app.GET("/recurring-transaction", recurringTransactionHandler.GetAll)
+	app.GET("/recurring-transaction/calendar", recurringTransactionHandler.Calendar)
+	app.GET("/recurring-transaction/pending", recurringTransactionHandler.Pending)
+	app.POST("/recurring-transaction/pending/confirm", recurringTransactionHandler.ConfirmPending)
+	app.POST("/recurring-transaction/pending/reject", recurringTransactionHandler.RejectPending)
 	app.GET("/recurring-transaction/{id}", recurringTransactionHandler.GetByID)
 	app.PUT("/recurring-transaction/{id}", recurringTransactionHandler.Update)
 	app.DELETE("/recurring-transaction/{id}", recurringTransactionHandler.Delete)
+	app.GET("/recurring-transaction/{id}/occurrences", recurringTransactionHandler.Occurrences)
+	app.POST("/recurring-transaction/{id}/pause", recurringTransactionHandler.Pause)
+	app.POST("/recurring-transaction/{id}/resume", recurringTransactionHandler.Resume)
+	app.POST("/recurring-transaction/{id}/exceptions", recurringTransactionHandler.CreateException)
+	app.DELETE("/recurring-transaction/{id}/exceptions/{exceptionId}", recurringTransactionHandler.DeleteException)
+
+	app.POST("/calendar-feed", calendarFeedHandler.Create)
+	app.GET("/calendar-feed", calendarFeedHandler.GetAll)
+	app.DELETE("/calendar-feed/{id}", calendarFeedHandler.Revoke)
+	app.GET("/calendar/{token}.ics", calendarFeedHandler.Feed)
+
+	app.POST("/holiday", holidayHandler.Create)
+	app.GET("/holiday", holidayHandler.GetAll)
+	app.DELETE("/holiday/{id}", holidayHandler.Delete)
+
+	app.AddCronJob("*/15 * * * *", "post-recurring-transactions", func(ctx *gofr.Context) {
+		err := recurringTransactionSvc.PostDue(ctx)
+		if err != nil {
+			ctx.Logger.Errorf("error posting recurring transactions: %v", err)
+		}
+	})
 
 	app.POST("/google-token", authHandler.CreateToken)
 	app.POST("/login", authHandler.Login)
@@ -101,3 +244,19 @@ func main() {
 
 	app.Run()
 }
+
+// runCommand runs the maintenance subcommands, which need the accounts and nothing else of the server.
+func runCommand() {
+	app := gofr.NewCMD()
+
+	app.Migrate(migrations.All())
+
+	userSvc := usersService.New(users.New(), categoryService.New(categories.New()))
+	accountSvc := accountService.New(accounts.New(), balanceLedger.New(), balanceRepairs.New(), userSvc)
+	accountHandler := accountsHandler.New(accountSvc)
+
+	app.SubCommand("check-balances", accountHandler.CheckBalances)
+	app.SubCommand("repair-balances", accountHandler.RepairBalances)
+
+	app.Run()
+}
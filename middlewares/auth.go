@@ -4,9 +4,11 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"moneyManagement/models"
 	"moneyManagement/services"
 	"net/http"
 	"regexp"
+	"slices"
 	"strings"
 )
 
@@ -24,6 +26,10 @@ type ExemptPath struct {
 
 func getAccessMap() []access {
 	return []access{
+		{"^/net-worth$", http.MethodGet, "ADMIN,USER", true},
+		{"^/reports/trends$", http.MethodGet, "ADMIN,USER", true},
+		{"^/forecast$", http.MethodGet, "ADMIN,USER", true},
+
 		{"^/user$", http.MethodPost, "ADMIN,USER", true},
 		{"^/user$", http.MethodGet, "ADMIN", true},
 		{"^/user/[0-9]+", http.MethodGet, "ADMIN,USER", true},
@@ -35,6 +41,15 @@ func getAccessMap() []access {
 		{"^/account/[0-9]+", http.MethodGet, "ADMIN,USER", true},
 		{"^/account/[0-9]+", http.MethodPut, "ADMIN,USER", true},
 		{"^/account/[0-9]+", http.MethodDelete, "ADMIN,USER", true},
+		{"^/account/[0-9]+/balance-history$", http.MethodGet, "ADMIN,USER", true},
+		{"^/account/[0-9]+/statements$", http.MethodGet, "ADMIN,USER", true},
+		{"^/account/[0-9]+/reconciliation$", http.MethodPost, "ADMIN,USER", true},
+		{"^/account/[0-9]+/reconciliation$", http.MethodGet, "ADMIN,USER", true},
+		{"^/reconciliation/[0-9]+$", http.MethodGet, "ADMIN,USER", true},
+		{"^/reconciliation/[0-9]+/transactions$", http.MethodPut, "ADMIN,USER", true},
+		{"^/reconciliation/[0-9]+/lock$", http.MethodPost, "ADMIN,USER", true},
+		{"^/balance-check$", http.MethodGet, "ADMIN", true},
+		{"^/balance-check/repair$", http.MethodPost, "ADMIN", true},
 
 		{"^/savings$", http.MethodPost, "ADMIN,USER", true},
 		{"^/savings$", http.MethodGet, "ADMIN,USER", true},
@@ -47,6 +62,46 @@ func getAccessMap() []access {
 		{"^/transaction/[0-9]+", http.MethodGet, "ADMIN,USER", true},
 		{"^/transaction/[0-9]+", http.MethodPut, "ADMIN,USER", true},
 		{"^/transaction/[0-9]+", http.MethodDelete, "ADMIN,USER", true},
+		{"^/transaction/import$", http.MethodPost, "ADMIN,USER", true},
+		{"^/transaction/statement$", http.MethodPost, "ADMIN,USER", true},
+		{"^/transaction/statement/confirm$", http.MethodPost, "ADMIN,USER", true},
+
+		{"^/category$", http.MethodPost, "ADMIN,USER", true},
+		{"^/category$", http.MethodGet, "ADMIN,USER", true},
+		{"^/category/[0-9]+", http.MethodGet, "ADMIN,USER", true},
+		{"^/category/[0-9]+", http.MethodPut, "ADMIN,USER", true},
+		{"^/category/[0-9]+", http.MethodDelete, "ADMIN,USER", true},
+		{"^/category/[0-9]+/merge$", http.MethodPost, "ADMIN,USER", true},
+
+		{"^/budget$", http.MethodPost, "ADMIN,USER", true},
+		{"^/budget$", http.MethodGet, "ADMIN,USER", true},
+		{"^/budget/[0-9]+$", http.MethodGet, "ADMIN,USER", true},
+		{"^/budget/[0-9]+$", http.MethodPut, "ADMIN,USER", true},
+		{"^/budget/[0-9]+$", http.MethodDelete, "ADMIN,USER", true},
+		{"^/budget/[0-9]{4}-[0-9]{2}/status$", http.MethodGet, "ADMIN,USER", true},
+
+		{"^/recurring-transaction/calendar$", http.MethodGet, "ADMIN,USER", true},
+		{"^/recurring-transaction/pending$", http.MethodGet, "ADMIN,USER", true},
+		{"^/recurring-transaction/pending/confirm$", http.MethodPost, "ADMIN,USER", true},
+		{"^/recurring-transaction/pending/reject$", http.MethodPost, "ADMIN,USER", true},
+		{"^/recurring-transaction/[0-9]+/occurrences$", http.MethodGet, "ADMIN,USER", true},
+		{"^/recurring-transaction/[0-9]+/pause$", http.MethodPost, "ADMIN,USER", true},
+		{"^/recurring-transaction/[0-9]+/resume$", http.MethodPost, "ADMIN,USER", true},
+		{"^/recurring-transaction/[0-9]+/exceptions$", http.MethodPost, "ADMIN,USER", true},
+		{"^/recurring-transaction/[0-9]+/exceptions/[0-9]+$", http.MethodDelete, "ADMIN,USER", true},
+
+		{"^/calendar-feed$", http.MethodPost, "ADMIN,USER", true},
+		{"^/calendar-feed$", http.MethodGet, "ADMIN,USER", true},
+		{"^/calendar-feed/[0-9]+$", http.MethodDelete, "ADMIN,USER", true},
+		{"^/calendar/[0-9a-f]{64}\\.ics$", http.MethodGet, "ADMIN,USER", false},
+
+		{"^/holiday$", http.MethodPost, "ADMIN,USER", true},
+		{"^/holiday$", http.MethodGet, "ADMIN,USER", true},
+		{"^/holiday/[0-9]+$", http.MethodDelete, "ADMIN,USER", true},
+
+		{"^/exchange-rate$", http.MethodPost, "ADMIN", true},
+		{"^/exchange-rate/import$", http.MethodPost, "ADMIN", true},
+		{"^/exchange-rate$", http.MethodGet, "ADMIN,USER", true},
 	}
 }
 
@@ -79,6 +134,17 @@ func Authorization(exemptPaths []ExemptPath, validator services.Validator, userS
 				return
 			}
 
+			// Tokens issued before roles existed carry none and belong to regular users
+			role, _ := claims["role"].(string)
+			if role == "" {
+				role = models.RoleUser
+			}
+
+			if !allowed(getAccessMap(), r, role) {
+				ErrorResponse(w, http.StatusForbidden, "forbidden", "Not allowed for role "+role)
+				return
+			}
+
 			*r = *r.WithContext(context.WithValue(r.Context(), "userID", int(userID)))
 
 			inner.ServeHTTP(w, r)
@@ -94,6 +160,33 @@ func ErrorResponse(w http.ResponseWriter, errStatusCode int, errCode, errReason
 	_, _ = fmt.Fprintln(w, string(data))
 }
 
+// getRoleChecks lists the paths of the access map whose roles are enforced. Every other route stays open to any
+// signed-in user.
+func getRoleChecks() []string {
+	return []string{"^/exchange-rate$", "^/exchange-rate/import$", "^/balance-check$", "^/balance-check/repair$"}
+}
+
+// allowed reports whether role may call the route of the request. Only the entries of the access map whose path is
+// role checked have to list the role.
+func allowed(accessMap []access, r *http.Request, role string) bool {
+	for _, entry := range accessMap {
+		if !entry.ProtectedRoute || entry.Method != r.Method || !slices.Contains(getRoleChecks(), entry.Path) {
+			continue
+		}
+
+		match, _ := regexp.MatchString(entry.Path, r.URL.Path)
+		if !match {
+			continue
+		}
+
+		if !slices.Contains(strings.Split(entry.Role, ","), role) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // containsURL checks if the request is exempted from authorization
 func checkIfExempt(urls []ExemptPath, r *http.Request) bool {
 	for _, url := range urls {
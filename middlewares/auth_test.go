@@ -0,0 +1,39 @@
+package middlewares
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"moneyManagement/models"
+)
+
+func Test_Allowed(t *testing.T) {
+	tests := []struct {
+		description string
+		method      string
+		path        string
+		role        string
+		expected    bool
+	}{
+		{"user route", http.MethodGet, "/account/4", models.RoleUser, true},
+		{"admin route as an admin", http.MethodPost, "/exchange-rate", models.RoleAdmin, true},
+		{"admin route as a user", http.MethodPost, "/exchange-rate", models.RoleUser, false},
+		{"admin import as a user", http.MethodPost, "/exchange-rate/import", models.RoleUser, false},
+		{"reading rates as a user", http.MethodGet, "/exchange-rate", models.RoleUser, true},
+		{"listing users as a user", http.MethodGet, "/user", models.RoleUser, true},
+		{"unknown role", http.MethodGet, "/exchange-rate", "GUEST", false},
+		{"balance check as an admin", http.MethodGet, "/balance-check", models.RoleAdmin, true},
+		{"balance check as a user", http.MethodGet, "/balance-check", models.RoleUser, false},
+		{"balance repair as a user", http.MethodPost, "/balance-check/repair", models.RoleUser, false},
+		{"route without an entry", http.MethodGet, "/dashboard", models.RoleUser, true},
+	}
+
+	for i, tc := range tests {
+		req := httptest.NewRequest(tc.method, tc.path, nil)
+
+		assert.Equalf(t, tc.expected, allowed(getAccessMap(), req, tc.role), "TEST[%d], failed.\n%s", i, tc.description)
+	}
+}
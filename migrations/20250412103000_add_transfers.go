@@ -0,0 +1,31 @@
+package migrations
+
+import (
+	"gofr.dev/pkg/gofr/migration"
+)
+
+const (
+	addTransferType = `ALTER TABLE transactions MODIFY type ENUM('INCOME', 'EXPENSE', 'SAVINGS', 'TRANSFER') NOT NULL;`
+
+	addToAccount = `ALTER TABLE transactions
+  ADD COLUMN to_account_id INT DEFAULT NULL AFTER account_id,
+  ADD FOREIGN KEY (to_account_id) REFERENCES accounts(id);`
+)
+
+func add_transfers() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			_, err := d.SQL.Exec(addTransferType)
+			if err != nil {
+				return err
+			}
+
+			_, err = d.SQL.Exec(addToAccount)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,30 @@
+package migrations
+
+import (
+	"gofr.dev/pkg/gofr/migration"
+)
+
+const createTransactionSplits = `CREATE TABLE transaction_splits (
+  id INT PRIMARY KEY AUTO_INCREMENT,
+  transaction_id INT NOT NULL,
+  category VARCHAR(255) NOT NULL,
+  amount FLOAT NOT NULL,
+  note TEXT,
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  deleted_at TIMESTAMP DEFAULT null,
+  FOREIGN KEY (transaction_id) REFERENCES transactions(id),
+  INDEX idx_transaction_splits_transaction_id (transaction_id)
+);`
+
+func create_transaction_splits() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			_, err := d.SQL.Exec(createTransactionSplits)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,85 @@
+package migrations
+
+import (
+	"gofr.dev/pkg/gofr/migration"
+	"moneyManagement/models"
+)
+
+const (
+	// Only active categories take part in the name key, so a deleted category does not hold its name
+	createCategories = `CREATE TABLE categories (
+  id INT PRIMARY KEY AUTO_INCREMENT,
+  user_id INT NOT NULL,
+  name VARCHAR(255) NOT NULL,
+  type ENUM('INCOME', 'EXPENSE', 'SAVINGS') NOT NULL,
+  icon VARCHAR(255),
+  color VARCHAR(7),
+  archived BOOLEAN DEFAULT false,
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  deleted_at TIMESTAMP DEFAULT null,
+  active BOOLEAN AS (IF(deleted_at IS NULL, true, NULL)) VIRTUAL,
+  FOREIGN KEY (user_id) REFERENCES users(id),
+  INDEX idx_categories_user_type (user_id, type),
+  UNIQUE KEY uq_categories_user_type_name (user_id, type, name, active)
+);`
+
+	// Savings types follow the user's SAVINGS categories instead of a fixed list
+	alterSavingsType = `ALTER TABLE savings MODIFY type VARCHAR(255) NOT NULL;`
+
+	// The built-in categories come with their colors, like every category created after them
+	getUserIDs          = `SELECT id FROM users;`
+	seedDefaultCategory = `INSERT INTO categories (user_id, name, type, color) VALUES (?, ?, ?, ?);`
+)
+
+// create_categories gives the existing users the built-in categories, which new users get when they are created.
+func create_categories() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			_, err := d.SQL.Exec(createCategories)
+			if err != nil {
+				return err
+			}
+
+			_, err = d.SQL.Exec(alterSavingsType)
+			if err != nil {
+				return err
+			}
+
+			rows, err := d.SQL.Query(getUserIDs)
+			if err != nil {
+				return err
+			}
+
+			defer rows.Close()
+
+			var userIDs []int
+
+			for rows.Next() {
+				var userID int
+
+				err = rows.Scan(&userID)
+				if err != nil {
+					return err
+				}
+
+				userIDs = append(userIDs, userID)
+			}
+
+			err = rows.Err()
+			if err != nil {
+				return err
+			}
+
+			for _, userID := range userIDs {
+				for _, category := range models.DefaultCategories(userID) {
+					_, err = d.SQL.Exec(seedDefaultCategory, category.UserID, category.Name, category.Type, category.Color)
+					if err != nil {
+						return err
+					}
+				}
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,22 @@
+package migrations
+
+import (
+	"gofr.dev/pkg/gofr/migration"
+)
+
+const addCategoryParent = `ALTER TABLE categories
+  ADD COLUMN parent_id INT DEFAULT NULL AFTER user_id,
+  ADD FOREIGN KEY (parent_id) REFERENCES categories(id);`
+
+func add_category_parent() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			_, err := d.SQL.Exec(addCategoryParent)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,32 @@
+package migrations
+
+import (
+	"gofr.dev/pkg/gofr/migration"
+)
+
+const createBudgets = `CREATE TABLE budgets (
+  id INT PRIMARY KEY AUTO_INCREMENT,
+  user_id INT NOT NULL,
+  category VARCHAR(255) NOT NULL,
+  month CHAR(7) NOT NULL,
+  account_id INT DEFAULT NULL,
+  amount FLOAT NOT NULL,
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  deleted_at TIMESTAMP DEFAULT null,
+  FOREIGN KEY (user_id) REFERENCES users(id),
+  FOREIGN KEY (account_id) REFERENCES accounts(id),
+  INDEX idx_budgets_user_month (user_id, month)
+);`
+
+func create_budgets() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			_, err := d.SQL.Exec(createBudgets)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,20 @@
+package migrations
+
+import (
+	"gofr.dev/pkg/gofr/migration"
+)
+
+const addBudgetRollover = `ALTER TABLE budgets ADD COLUMN rollover BOOLEAN DEFAULT false AFTER amount;`
+
+func add_budget_rollover() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			_, err := d.SQL.Exec(addBudgetRollover)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
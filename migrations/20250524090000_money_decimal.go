@@ -0,0 +1,30 @@
+package migrations
+
+import (
+	"gofr.dev/pkg/gofr/migration"
+)
+
+// Money columns were FLOAT, which cannot hold amounts like 0.1 exactly and let balances drift.
+var moneyDecimalColumns = []string{
+	`ALTER TABLE accounts MODIFY balance DECIMAL(19,4) DEFAULT 0;`,
+	`ALTER TABLE transactions MODIFY amount DECIMAL(19,4) NOT NULL;`,
+	`ALTER TABLE savings MODIFY amount DECIMAL(19,4) NOT NULL, MODIFY current_value DECIMAL(19,4);`,
+	`ALTER TABLE recurring_transactions MODIFY amount DECIMAL(19,4) NOT NULL;`,
+	`ALTER TABLE transaction_splits MODIFY amount DECIMAL(19,4) NOT NULL;`,
+	`ALTER TABLE budgets MODIFY amount DECIMAL(19,4) NOT NULL;`,
+}
+
+func money_decimal() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			for _, query := range moneyDecimalColumns {
+				_, err := d.SQL.Exec(query)
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,45 @@
+package migrations
+
+import (
+	"gofr.dev/pkg/gofr/migration"
+)
+
+const createExchangeRates = `CREATE TABLE exchange_rates (
+  id INT AUTO_INCREMENT PRIMARY KEY,
+  from_currency CHAR(3) NOT NULL,
+  to_currency CHAR(3) NOT NULL,
+  rate DECIMAL(19,8) NOT NULL,
+  rate_date DATE NOT NULL,
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  UNIQUE KEY (from_currency, to_currency, rate_date)
+);`
+
+// Existing users, accounts and transactions keep the currency they were implicitly in
+var addCurrencies = []string{
+	`ALTER TABLE users ADD COLUMN home_currency CHAR(3) NOT NULL DEFAULT 'INR' AFTER email;`,
+	`ALTER TABLE accounts ADD COLUMN currency CHAR(3) NOT NULL DEFAULT 'INR' AFTER balance;`,
+	`ALTER TABLE transactions
+  ADD COLUMN currency CHAR(3) NOT NULL DEFAULT 'INR' AFTER amount,
+  ADD COLUMN to_amount DECIMAL(19,4) DEFAULT NULL AFTER currency,
+  ADD COLUMN to_currency CHAR(3) DEFAULT NULL AFTER to_amount;`,
+}
+
+func add_currencies() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			_, err := d.SQL.Exec(createExchangeRates)
+			if err != nil {
+				return err
+			}
+
+			for _, query := range addCurrencies {
+				_, err = d.SQL.Exec(query)
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,47 @@
+package migrations
+
+import (
+	"gofr.dev/pkg/gofr/migration"
+)
+
+const (
+	createBalanceLedger = `CREATE TABLE balance_ledger (
+  id INT AUTO_INCREMENT PRIMARY KEY,
+  user_id INT NOT NULL,
+  account_id INT NOT NULL,
+  transaction_id INT DEFAULT NULL,
+  ` + "`change`" + ` DECIMAL(19,4) NOT NULL,
+  effective_date DATETIME NOT NULL,
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (user_id) REFERENCES users(id),
+  FOREIGN KEY (account_id) REFERENCES accounts(id),
+  FOREIGN KEY (transaction_id) REFERENCES transactions(id),
+  INDEX (account_id, effective_date)
+);`
+
+	// Existing transactions are backfilled so the history of an account reaches back before the ledger existed
+	backfillBalanceLedger = `INSERT INTO balance_ledger (user_id, account_id, transaction_id, ` + "`change`" + `, effective_date)
+SELECT user_id, account_id, id, CASE WHEN type='INCOME' THEN amount ELSE -amount END, transaction_date
+FROM transactions WHERE deleted_at IS NULL
+UNION ALL
+SELECT user_id, to_account_id, id, COALESCE(to_amount, amount), transaction_date
+FROM transactions WHERE deleted_at IS NULL AND type='TRANSFER' AND to_account_id IS NOT NULL;`
+)
+
+func create_balance_ledger() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			_, err := d.SQL.Exec(createBalanceLedger)
+			if err != nil {
+				return err
+			}
+
+			_, err = d.SQL.Exec(backfillBalanceLedger)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
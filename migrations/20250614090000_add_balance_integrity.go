@@ -0,0 +1,40 @@
+package migrations
+
+import (
+	"gofr.dev/pkg/gofr/migration"
+)
+
+const (
+	addOpeningBalance = "ALTER TABLE accounts ADD COLUMN opening_balance DECIMAL(19,4) NOT NULL DEFAULT 0 AFTER balance;"
+
+	// The opening balance of an existing account is whatever its balance does not owe to the ledger, so every
+	// balance matches its recomputed value when the check starts
+	backfillOpeningBalance = "UPDATE accounts a SET opening_balance = a.balance - COALESCE((SELECT SUM(l.`change`) " +
+		"FROM balance_ledger l WHERE l.account_id=a.id),0);"
+
+	createBalanceRepairs = `CREATE TABLE balance_repairs (
+  id INT AUTO_INCREMENT PRIMARY KEY,
+  user_id INT NOT NULL,
+  account_id INT NOT NULL,
+  previous_balance DECIMAL(19,4) NOT NULL,
+  balance DECIMAL(19,4) NOT NULL,
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (user_id) REFERENCES users(id),
+  FOREIGN KEY (account_id) REFERENCES accounts(id)
+);`
+)
+
+func add_balance_integrity() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			for _, query := range []string{addOpeningBalance, backfillOpeningBalance, createBalanceRepairs} {
+				_, err := d.SQL.Exec(query)
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,44 @@
+package migrations
+
+import (
+	"gofr.dev/pkg/gofr/migration"
+)
+
+const createReconciliations = `CREATE TABLE reconciliations (
+  id INT AUTO_INCREMENT PRIMARY KEY,
+  user_id INT NOT NULL,
+  account_id INT NOT NULL,
+  statement_date DATE NOT NULL,
+  statement_balance DECIMAL(19,4) NOT NULL,
+  cleared_balance DECIMAL(19,4) NOT NULL DEFAULT 0,
+  status VARCHAR(16) NOT NULL DEFAULT 'OPEN',
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  locked_at TIMESTAMP NULL DEFAULT NULL,
+  FOREIGN KEY (user_id) REFERENCES users(id),
+  FOREIGN KEY (account_id) REFERENCES accounts(id),
+  INDEX (account_id, statement_date)
+);`
+
+// Existing accounts open on the day they were created and all existing transactions start uncleared.
+var reconciliationQueries = []string{
+	`ALTER TABLE accounts ADD COLUMN opening_date DATE DEFAULT NULL AFTER opening_balance;`,
+	`UPDATE accounts SET opening_date = DATE(created_at);`,
+	`ALTER TABLE accounts MODIFY opening_date DATE NOT NULL;`,
+	`ALTER TABLE transactions ADD COLUMN status VARCHAR(16) NOT NULL DEFAULT 'UNCLEARED' AFTER description;`,
+	createReconciliations,
+}
+
+func add_reconciliations() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			for _, query := range reconciliationQueries {
+				_, err := d.SQL.Exec(query)
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,23 @@
+package migrations
+
+import (
+	"gofr.dev/pkg/gofr/migration"
+)
+
+// Credit card terms are only set on CREDIT CARD accounts, every other account keeps them NULL.
+const addCreditCardTerms = `ALTER TABLE accounts ADD COLUMN credit_limit DECIMAL(19,4) DEFAULT NULL AFTER currency,
+ADD COLUMN statement_day TINYINT DEFAULT NULL AFTER credit_limit,
+ADD COLUMN payment_due_day TINYINT DEFAULT NULL AFTER statement_day;`
+
+func add_credit_cards() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			_, err := d.SQL.Exec(addCreditCardTerms)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,41 @@
+package migrations
+
+import (
+	"gofr.dev/pkg/gofr/migration"
+)
+
+const (
+	addLoanAccounts = "ALTER TABLE accounts MODIFY type ENUM('BANK', 'CASH', 'WALLET', 'CREDIT CARD', 'LOAN') NOT NULL;"
+
+	createSavingsValuations = `CREATE TABLE savings_valuations (
+  id INT AUTO_INCREMENT PRIMARY KEY,
+  savings_id INT NOT NULL,
+  user_id INT NOT NULL,
+  value DECIMAL(19,4) NOT NULL,
+  valued_on DATE NOT NULL,
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  INDEX idx_savings_valuations_user (user_id, savings_id, valued_on),
+  FOREIGN KEY (savings_id) REFERENCES savings(id),
+  FOREIGN KEY (user_id) REFERENCES users(id)
+);`
+
+	// When a current value was entered before valuations were kept, it is only known to hold from today
+	backfillSavingsValuations = `INSERT INTO savings_valuations (savings_id,user_id,value,valued_on)
+SELECT id,user_id,current_value,CURDATE() FROM savings WHERE deleted_at IS NULL AND current_value IS NOT NULL
+AND current_value <> 0;`
+)
+
+func add_net_worth() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			for _, query := range []string{addLoanAccounts, createSavingsValuations, backfillSavingsValuations} {
+				_, err := d.SQL.Exec(query)
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,31 @@
+package migrations
+
+import (
+	"gofr.dev/pkg/gofr/migration"
+)
+
+// Only a hash of each feed token is kept, the token itself is shown once when the feed is created
+const createCalendarFeeds = `CREATE TABLE calendar_feeds (
+  id INT AUTO_INCREMENT PRIMARY KEY,
+  user_id INT NOT NULL,
+  name VARCHAR(100) NOT NULL DEFAULT '',
+  token_hash CHAR(64) NOT NULL,
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  revoked_at TIMESTAMP NULL DEFAULT NULL,
+  UNIQUE KEY uq_calendar_feeds_token (token_hash),
+  INDEX idx_calendar_feeds_user (user_id),
+  FOREIGN KEY (user_id) REFERENCES users(id)
+);`
+
+func create_calendar_feeds() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			_, err := d.SQL.Exec(createCalendarFeeds)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
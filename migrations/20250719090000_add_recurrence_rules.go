@@ -0,0 +1,26 @@
+package migrations
+
+import (
+	"gofr.dev/pkg/gofr/migration"
+)
+
+const addRecurrenceRules = `ALTER TABLE recurring_transactions
+  MODIFY frequency ENUM('DAILY','WEEKLY','MONTHLY','QUARTERLY','YEARLY','CUSTOM'),
+  ADD COLUMN interval_count INT NOT NULL DEFAULT 1 AFTER custom_days,
+  ADD COLUMN month_days VARCHAR(100) NOT NULL DEFAULT '' AFTER interval_count,
+  ADD COLUMN weekday CHAR(2) NOT NULL DEFAULT '' AFTER month_days,
+  ADD COLUMN weekday_ordinal INT NOT NULL DEFAULT 0 AFTER weekday,
+  ADD COLUMN shift ENUM('NONE','BEFORE','AFTER') NOT NULL DEFAULT 'NONE' AFTER weekday_ordinal;`
+
+func add_recurrence_rules() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			_, err := d.SQL.Exec(addRecurrenceRules)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
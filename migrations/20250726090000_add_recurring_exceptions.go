@@ -0,0 +1,42 @@
+package migrations
+
+import (
+	"gofr.dev/pkg/gofr/migration"
+)
+
+const (
+	addRecurringPause = `ALTER TABLE recurring_transactions
+  ADD COLUMN paused_at TIMESTAMP NULL DEFAULT NULL AFTER next_run,
+  ADD COLUMN paused_until DATE NULL DEFAULT NULL AFTER paused_at;`
+
+	// An occurrence is the time the rule schedules it at, which stays its key when it is moved to another date
+	createRecurringExceptions = `CREATE TABLE recurring_exceptions (
+  id INT AUTO_INCREMENT PRIMARY KEY,
+  recurring_transaction_id INT NOT NULL,
+  user_id INT NOT NULL,
+  occurrence DATETIME NOT NULL,
+  skip BOOLEAN NOT NULL DEFAULT FALSE,
+  amount DECIMAL(19,4) NULL,
+  date DATETIME NULL,
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  UNIQUE KEY uq_recurring_exceptions_occurrence (recurring_transaction_id, occurrence),
+  INDEX idx_recurring_exceptions_user (user_id),
+  FOREIGN KEY (recurring_transaction_id) REFERENCES recurring_transactions(id),
+  FOREIGN KEY (user_id) REFERENCES users(id)
+);`
+)
+
+func add_recurring_exceptions() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			for _, query := range []string{addRecurringPause, createRecurringExceptions} {
+				_, err := d.SQL.Exec(query)
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
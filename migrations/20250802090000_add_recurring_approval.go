@@ -0,0 +1,21 @@
+package migrations
+
+import (
+	"gofr.dev/pkg/gofr/migration"
+)
+
+const addRecurringApproval = `ALTER TABLE recurring_transactions
+  ADD COLUMN requires_approval BOOLEAN NOT NULL DEFAULT FALSE AFTER shift;`
+
+func add_recurring_approval() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			_, err := d.SQL.Exec(addRecurringApproval)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,22 @@
+package migrations
+
+import (
+	"gofr.dev/pkg/gofr/migration"
+)
+
+// Every existing user is a regular user, admins are promoted in the database
+const addUserRoles = `ALTER TABLE users
+  ADD COLUMN role ENUM('ADMIN','USER') NOT NULL DEFAULT 'USER' AFTER home_currency;`
+
+func add_user_roles() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			_, err := d.SQL.Exec(addUserRoles)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,29 @@
+package migrations
+
+import (
+	"gofr.dev/pkg/gofr/migration"
+)
+
+// A user has at most one holiday on a day, shifting recurring transactions move their occurrences off it
+const createHolidays = `CREATE TABLE holidays (
+  id INT AUTO_INCREMENT PRIMARY KEY,
+  user_id INT NOT NULL,
+  date DATE NOT NULL,
+  name VARCHAR(100) NOT NULL DEFAULT '',
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  UNIQUE KEY uq_holidays_user_date (user_id, date),
+  FOREIGN KEY (user_id) REFERENCES users(id)
+);`
+
+func create_holidays() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			_, err := d.SQL.Exec(createHolidays)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
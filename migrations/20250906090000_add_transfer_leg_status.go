@@ -0,0 +1,27 @@
+package migrations
+
+import (
+	"gofr.dev/pkg/gofr/migration"
+)
+
+// The accounts of a transfer clear and reconcile it apart, to_status is the status of its destination leg. A transfer
+// had a single status for both of its legs so far, so both start with it.
+var transferLegStatusQueries = []string{
+	`ALTER TABLE transactions ADD COLUMN to_status VARCHAR(16) NOT NULL DEFAULT 'UNCLEARED' AFTER status;`,
+	`UPDATE transactions SET to_status = status WHERE type = 'TRANSFER';`,
+}
+
+func add_transfer_leg_status() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			for _, query := range transferLegStatusQueries {
+				_, err := d.SQL.Exec(query)
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
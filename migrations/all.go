@@ -9,5 +9,25 @@ func All() map[int64]migration.Migrate {
 	return map[int64]migration.Migrate{
 
 		20250322124457: create_tables(),
+		20250412103000: add_transfers(),
+		20250419091500: create_transaction_splits(),
+		20250426114500: create_categories(),
+		20250503100000: add_category_parent(),
+		20250510093000: create_budgets(),
+		20250517084500: add_budget_rollover(),
+		20250524090000: money_decimal(),
+		20250531093000: add_currencies(),
+		20250607101500: create_balance_ledger(),
+		20250614090000: add_balance_integrity(),
+		20250621093000: add_reconciliations(),
+		20250628094500: add_credit_cards(),
+		20250705091500: add_net_worth(),
+		20250712090000: create_calendar_feeds(),
+		20250719090000: add_recurrence_rules(),
+		20250726090000: add_recurring_exceptions(),
+		20250802090000: add_recurring_approval(),
+		20250809090000: add_user_roles(),
+		20250830090000: create_holidays(),
+		20250906090000: add_transfer_leg_status(),
 	}
 }
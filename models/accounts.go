@@ -1,14 +1,29 @@
 package models
 
+// LoanAccount is the account type of money borrowed, owed like a credit card balance.
+const LoanAccount = "LOAN"
+
+// Account.OpeningBalance is the balance of the account on OpeningDate, before any of its transactions. The balance check
+// and reconciliations start from it. Only CREDIT CARD accounts have CreditCard terms.
 type Account struct {
-	ID                int      `json:"id"`
-	Name              string   `json:"name"`
-	Type              string   `json:"type"`
-	UserID            int      `json:"userID"`
-	Balance           float64  `json:"balance"`
-	Status            string   `json:"status"`
-	ExpenseCategories []string `json:"expenseCategories"`
-	SavingCategories  []string `json:"savingCategories"`
-	CreatedAt         string   `json:"createdAt"`
-	DeletedAt         string   `json:"deletedAt,omitempty"`
+	ID                int         `json:"id"`
+	Name              string      `json:"name"`
+	Type              string      `json:"type"`
+	UserID            int         `json:"userID"`
+	Balance           Money       `json:"balance"`
+	OpeningBalance    Money       `json:"openingBalance"`
+	OpeningDate       string      `json:"openingDate"`
+	Currency          string      `json:"currency"`
+	Status            string      `json:"status"`
+	ExpenseCategories []string    `json:"expenseCategories"`
+	SavingCategories  []string    `json:"savingCategories"`
+	CreditCard        *CreditCard `json:"creditCard,omitempty"`
+	CreatedAt         string      `json:"createdAt"`
+	DeletedAt         string      `json:"deletedAt,omitempty"`
+}
+
+// IsLiability reports whether the account holds money owed rather than money owned. Its balance is negative while
+// anything is owed on it.
+func (a *Account) IsLiability() bool {
+	return a.Type == CreditCardAccount || a.Type == LoanAccount
 }
@@ -34,4 +34,5 @@ type GoogleClaims struct {
 	GivenName  string
 	FamilyName string
 	EntityID   int
+	Role       string
 }
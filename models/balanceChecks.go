@@ -0,0 +1,35 @@
+package models
+
+// BalanceCheck compares the stored Balance of an account with the balance recomputed from its opening balance, the
+// adjustments made to the balance by hand and all its transactions that are not deleted. Discrepancy is Balance minus
+// Expected, Repaired is set once the balance is replaced by Expected.
+type BalanceCheck struct {
+	Account        AccountDetails `json:"account"`
+	UserID         int            `json:"userID"`
+	Currency       string         `json:"currency"`
+	OpeningBalance Money          `json:"openingBalance"`
+	Adjustments    Money          `json:"adjustments"`
+	Transactions   Money          `json:"transactions"`
+	Expected       Money          `json:"expected"`
+	Balance        Money          `json:"balance"`
+	Discrepancy    Money          `json:"discrepancy"`
+	Repaired       bool           `json:"repaired"`
+}
+
+// BalanceCheckReport lists the accounts, out of the Checked ones, whose balance does not match the recomputed one.
+type BalanceCheckReport struct {
+	Checked       int             `json:"checked"`
+	Discrepancies int             `json:"discrepancies"`
+	Repaired      int             `json:"repaired"`
+	Accounts      []*BalanceCheck `json:"accounts"`
+}
+
+// BalanceRepair is the audit entry of a balance replaced by its recomputed value.
+type BalanceRepair struct {
+	ID              int    `json:"id"`
+	UserID          int    `json:"userID"`
+	AccountID       int    `json:"accountID"`
+	PreviousBalance Money  `json:"previousBalance"`
+	Balance         Money  `json:"balance"`
+	CreatedAt       string `json:"createdAt"`
+}
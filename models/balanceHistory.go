@@ -0,0 +1,29 @@
+package models
+
+// BalanceLedgerEntry is one change to the balance of an account, effective on EffectiveDate. Entries are only ever
+// added: editing or deleting a transaction adds entries that reverse its earlier ones. The balance of an account on a
+// date is its current balance minus every change effective after that date.
+type BalanceLedgerEntry struct {
+	ID            int    `json:"id"`
+	UserID        int    `json:"userID"`
+	AccountID     int    `json:"accountID"`
+	TransactionID *int   `json:"transactionID,omitempty"`
+	Change        Money  `json:"change"`
+	EffectiveDate string `json:"effectiveDate"`
+	CreatedAt     string `json:"createdAt"`
+}
+
+// BalancePoint is the balance of an account at the end of the interval that ends on Date.
+type BalancePoint struct {
+	Date    string `json:"date"`
+	Balance Money  `json:"balance"`
+}
+
+type BalanceHistory struct {
+	Account  AccountDetails `json:"account"`
+	Currency string         `json:"currency"`
+	From     string         `json:"from"`
+	To       string         `json:"to"`
+	Interval string         `json:"interval"`
+	Points   []BalancePoint `json:"points"`
+}
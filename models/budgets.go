@@ -0,0 +1,56 @@
+package models
+
+// Budget is a spending limit for an EXPENSE category, and its sub-categories, in a month. A budget with an Account
+// only counts the expenses of that account. With Rollover set, whatever is left of the budget, or overspent, is carried
+// into the budget of the same category in the next month.
+type Budget struct {
+	ID        int             `json:"id"`
+	UserID    int             `json:"userID"`
+	Category  string          `json:"category"`
+	Month     string          `json:"month"`
+	Account   *AccountDetails `json:"account,omitempty"`
+	Amount    Money           `json:"amount"`
+	Rollover  bool            `json:"rollover"`
+	CreatedAt string          `json:"createdAt"`
+	DeletedAt string          `json:"deletedAt,omitempty"`
+}
+
+// BudgetProgress is in Currency, the currency of the account of the budget or the home currency of the user for
+// budgets across all accounts.
+type BudgetProgress struct {
+	Budget          *Budget `json:"budget"`
+	Currency        string  `json:"currency"`
+	CarryOver       Money   `json:"carryOver"`
+	Available       Money   `json:"available"`
+	Spent           Money   `json:"spent"`
+	Remaining       Money   `json:"remaining"`
+	PercentConsumed float64 `json:"percentConsumed"`
+	ProjectedSpend  Money   `json:"projectedSpend"`
+	OverBudget      bool    `json:"overBudget"`
+}
+
+// BudgetStatus treats the budgets of a month as envelopes the month's income is assigned to. AvailableToAssign is
+// the INCOME of the month that is not assigned to any budget yet, negative when more is assigned than earned. The
+// totals are in Currency, the home currency of the user.
+type BudgetStatus struct {
+	Month             string           `json:"month"`
+	Currency          string           `json:"currency"`
+	DaysElapsed       int              `json:"daysElapsed"`
+	DaysInMonth       int              `json:"daysInMonth"`
+	TotalLimit        Money            `json:"totalLimit"`
+	TotalCarryOver    Money            `json:"totalCarryOver"`
+	TotalSpent        Money            `json:"totalSpent"`
+	TotalRemaining    Money            `json:"totalRemaining"`
+	Income            Money            `json:"income"`
+	AvailableToAssign Money            `json:"availableToAssign"`
+	Budgets           []BudgetProgress `json:"budgets"`
+}
+
+// EnvelopeSummary is the part of a BudgetStatus shown on the dashboard.
+type EnvelopeSummary struct {
+	Month             string `json:"month"`
+	Income            Money  `json:"income"`
+	Assigned          Money  `json:"assigned"`
+	CarryOver         Money  `json:"carryOver"`
+	AvailableToAssign Money  `json:"availableToAssign"`
+}
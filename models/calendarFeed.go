@@ -0,0 +1,25 @@
+package models
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// CalendarFeed is a token that lets calendar apps subscribe to the recurring bills and savings maturities of a user
+// without logging in. The token is only returned when the feed is created.
+type CalendarFeed struct {
+	ID        int    `json:"id"`
+	UserID    int    `json:"userID"`
+	Name      string `json:"name"`
+	Token     string `json:"token,omitempty"`
+	URL       string `json:"url,omitempty"`
+	CreatedAt string `json:"createdAt"`
+	RevokedAt string `json:"revokedAt,omitempty"`
+}
+
+// HashFeedToken is the form a feed token is stored and looked up in.
+func HashFeedToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,112 @@
+package models
+
+import "sort"
+
+var IncomeCategories = map[string]struct{}{
+	"Salary": {}, "Business": {}, "Interest": {}, "Dividends": {}, "Rental Income": {}, "Refunds": {}, "Other Income": {},
+}
+
+type Category struct {
+	ID        int    `json:"id"`
+	UserID    int    `json:"userID"`
+	ParentID  *int   `json:"parentID,omitempty"`
+	Name      string `json:"name"`
+	Type      Type   `json:"type"`
+	Icon      string `json:"icon"`
+	Color     string `json:"color"`
+	Archived  bool   `json:"archived"`
+	CreatedAt string `json:"createdAt"`
+	DeletedAt string `json:"deletedAt,omitempty"`
+}
+
+type CategoryMerge struct {
+	TargetID int `json:"targetID"`
+}
+
+// DefaultCategories returns the built-in categories a user starts with, in name order within each type, each with
+// a color of its own.
+func DefaultCategories(userID int) []*Category {
+	defaults := []struct {
+		categoryType Type
+		names        map[string]struct{}
+	}{
+		{INCOME, IncomeCategories},
+		{EXPENSE, ExpenseCategories},
+		{SAVINGS, SavingsCategories},
+	}
+
+	used := make(map[string]bool)
+
+	var categories []*Category
+
+	for _, d := range defaults {
+		names := make([]string, 0, len(d.names))
+		for name := range d.names {
+			names = append(names, name)
+		}
+
+		sort.Strings(names)
+
+		for _, name := range names {
+			color := CategoryColor(name, used)
+			used[color] = true
+
+			categories = append(categories, &Category{UserID: userID, Name: name, Type: d.categoryType, Color: color})
+		}
+	}
+
+	return categories
+}
+
+// WithDescendants returns the given category names together with the names of all their sub-categories among
+// categories.
+func WithDescendants(categories []*Category, names []string) []string {
+	children := make(map[int][]*Category)
+	for _, category := range categories {
+		if category.ParentID != nil {
+			children[*category.ParentID] = append(children[*category.ParentID], category)
+		}
+	}
+
+	seen := make(map[string]bool)
+	result := make([]string, 0, len(names))
+
+	for _, name := range names {
+		if !seen[name] {
+			seen[name] = true
+			result = append(result, name)
+		}
+	}
+
+	visited := make(map[int]bool)
+
+	var queue []*Category
+
+	for _, category := range categories {
+		if seen[category.Name] {
+			queue = append(queue, category)
+		}
+	}
+
+	for len(queue) != 0 {
+		category := queue[0]
+		queue = queue[1:]
+
+		if visited[category.ID] {
+			continue
+		}
+
+		visited[category.ID] = true
+
+		for _, child := range children[category.ID] {
+			if !seen[child.Name] {
+				seen[child.Name] = true
+				result = append(result, child.Name)
+			}
+
+			queue = append(queue, child)
+		}
+	}
+
+	return result
+}
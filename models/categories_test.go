@@ -0,0 +1,43 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_DefaultCategories(t *testing.T) {
+	categories := DefaultCategories(7)
+
+	assert.Len(t, categories, len(IncomeCategories)+len(ExpenseCategories)+len(SavingsCategories))
+	assert.Equal(t, &Category{UserID: 7, Name: "Business", Type: INCOME, Color: categories[0].Color}, categories[0])
+
+	colors := make(map[string]bool, len(categories))
+	for _, category := range categories {
+		colors[category.Color] = true
+	}
+
+	assert.Len(t, colors, len(categories), "no two default categories share a color")
+	assert.Equal(t, categories, DefaultCategories(7), "every user starts with the same colors")
+}
+
+func Test_WithDescendants(t *testing.T) {
+	food, groceries := 1, 2
+	categories := []*Category{{ID: 1, Name: "Food"}, {ID: 2, ParentID: &food, Name: "Groceries"},
+		{ID: 3, ParentID: &groceries, Name: "Fruit"}, {ID: 4, Name: "Rent"}}
+
+	tests := []struct {
+		description string
+		names       []string
+		expected    []string
+	}{
+		{"parent with every level below", []string{"Food"}, []string{"Food", "Groceries", "Fruit"}},
+		{"leaf", []string{"Fruit"}, []string{"Fruit"}},
+		{"names are listed once", []string{"Groceries", "Fruit", "Groceries"}, []string{"Groceries", "Fruit"}},
+		{"unknown name is kept", []string{"Travel"}, []string{"Travel"}},
+	}
+
+	for i, tc := range tests {
+		assert.Equalf(t, tc.expected, WithDescendants(categories, tc.names), "TEST[%d], failed.\n%s", i, tc.description)
+	}
+}
@@ -0,0 +1,59 @@
+package models
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math"
+	"strings"
+)
+
+// colorStep moves a color along the color wheel, in degrees. It shares no factor with 360, so it reaches every hue.
+const colorStep = 47
+
+// CategoryColor returns the pastel color of a category that has none of its own. Its hue comes from the name, so the
+// category gets the same color every time, and is moved along the color wheel until it is not one of used, a set of
+// upper-case hex codes.
+func CategoryColor(name string, used map[string]bool) string {
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(strings.ToLower(strings.TrimSpace(name))))
+
+	hue := int(hash.Sum32() % 360)
+
+	color := pastel(hue)
+
+	for i := 0; i < 360 && used[color]; i++ {
+		hue = (hue + colorStep) % 360
+		color = pastel(hue)
+	}
+
+	return color
+}
+
+// pastel returns the hex code of a soft color of the given hue.
+func pastel(hue int) string {
+	const saturation, value = 0.5, 0.9
+
+	chroma := value * saturation
+	x := chroma * (1 - math.Abs(math.Mod(float64(hue)/60, 2)-1))
+	m := value - chroma
+
+	var r, g, b float64
+
+	switch {
+	case hue < 60:
+		r, g, b = chroma, x, 0
+	case hue < 120:
+		r, g, b = x, chroma, 0
+	case hue < 180:
+		r, g, b = 0, chroma, x
+	case hue < 240:
+		r, g, b = 0, x, chroma
+	case hue < 300:
+		r, g, b = x, 0, chroma
+	default:
+		r, g, b = chroma, 0, x
+	}
+
+	return fmt.Sprintf("#%02X%02X%02X", uint8(math.Round((r+m)*255)), uint8(math.Round((g+m)*255)),
+		uint8(math.Round((b+m)*255)))
+}
@@ -0,0 +1,30 @@
+package models
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_CategoryColor(t *testing.T) {
+	hexCode := regexp.MustCompile(`^#[0-9A-F]{6}$`)
+
+	color := CategoryColor("Food", nil)
+
+	assert.Regexp(t, hexCode, color)
+	assert.Equal(t, color, CategoryColor(" food ", nil), "the color only depends on the name")
+
+	// A used color moves on to the next free one, which is again the same every time
+	next := CategoryColor("Food", map[string]bool{color: true})
+
+	assert.Regexp(t, hexCode, next)
+	assert.NotEqual(t, color, next)
+	assert.Equal(t, next, CategoryColor("Food", map[string]bool{color: true}))
+}
+
+func Test_Pastel(t *testing.T) {
+	assert.Equal(t, "#E67373", pastel(0))
+	assert.Equal(t, "#73E673", pastel(120))
+	assert.Equal(t, "#7373E6", pastel(240))
+}
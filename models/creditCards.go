@@ -0,0 +1,97 @@
+package models
+
+import (
+	"errors"
+	"time"
+)
+
+// CreditCardAccount is the account type that has credit card terms.
+const CreditCardAccount = "CREDIT CARD"
+
+// minimumPaymentPercent of a statement balance is the minimum due on it
+const minimumPaymentPercent = 5
+
+// CreditCard holds the terms of a CREDIT CARD account. A billing cycle closes on StatementDay of every month, or on the
+// last day of shorter months, and its balance is due on the first DueDay after the close.
+type CreditCard struct {
+	Limit        Money `json:"limit"`
+	StatementDay int   `json:"statementDay"`
+	DueDay       int   `json:"dueDay"`
+}
+
+// CreditCardStatement is one billing cycle of a credit card, from StartDate to CloseDate. Balance is what was owed when
+// the cycle closed and Paid adds up the transfers into the card from the close up to DueDate.
+type CreditCardStatement struct {
+	StartDate   string `json:"startDate"`
+	CloseDate   string `json:"closeDate"`
+	DueDate     string `json:"dueDate"`
+	Charges     Money  `json:"charges"`
+	Credits     Money  `json:"credits"`
+	Balance     Money  `json:"balance"`
+	MinimumDue  Money  `json:"minimumDue"`
+	Paid        Money  `json:"paid"`
+	PaidInFull  bool   `json:"paidInFull"`
+	MinimumPaid bool   `json:"minimumPaid"`
+}
+
+// CreditCardStatements is the current state of a credit card with its past billing cycles, newest first. Outstanding
+// is owed right now, StatementBalance and MinimumDue are what is still due on the last closed statement. Utilisation is
+// the percentage of the limit in use.
+type CreditCardStatements struct {
+	Account          AccountDetails        `json:"account"`
+	Currency         string                `json:"currency"`
+	Limit            Money                 `json:"limit"`
+	Outstanding      Money                 `json:"outstanding"`
+	AvailableCredit  Money                 `json:"availableCredit"`
+	Utilisation      float64               `json:"utilisation"`
+	StatementBalance Money                 `json:"statementBalance"`
+	MinimumDue       Money                 `json:"minimumDue"`
+	DueDate          string                `json:"dueDate,omitempty"`
+	Statements       []CreditCardStatement `json:"statements"`
+}
+
+func (c *CreditCard) Validate() error {
+	if c.Limit <= 0 {
+		return errors.New("credit limit must be positive")
+	}
+
+	if c.StatementDay < 1 || c.StatementDay > 31 {
+		return errors.New("statement day must be between 1 and 31")
+	}
+
+	if c.DueDay < 1 || c.DueDay > 31 {
+		return errors.New("due day must be between 1 and 31")
+	}
+
+	return nil
+}
+
+// CloseDate returns the day the billing cycle of a month closes.
+func (c *CreditCard) CloseDate(year int, month time.Month) time.Time {
+	return dayOfMonth(year, month, c.StatementDay)
+}
+
+// DueDate returns the day the balance of the cycle closing on closeDate is due.
+func (c *CreditCard) DueDate(closeDate time.Time) time.Time {
+	due := dayOfMonth(closeDate.Year(), closeDate.Month(), c.DueDay)
+	if due.After(closeDate) {
+		return due
+	}
+
+	return dayOfMonth(closeDate.Year(), closeDate.Month()+1, c.DueDay)
+}
+
+// MinimumDue returns the minimum payment due on a statement balance.
+func MinimumDue(balance Money) Money {
+	return balance.MulDiv(minimumPaymentPercent, 100)
+}
+
+// dayOfMonth returns day of the month, or the last day of months that are shorter.
+func dayOfMonth(year int, month time.Month, day int) time.Time {
+	last := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	if day > last {
+		day = last
+	}
+
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
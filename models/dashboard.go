@@ -1,17 +1,76 @@
 package models
 
+import "math"
+
+// Periods a dashboard can be compared with: the same length of time just before it, or the same dates a year earlier.
+const (
+	ComparePreviousPeriod = "previousPeriod"
+	ComparePreviousYear   = "previousYear"
+)
+
+// ChartData is one slice of a breakdown. When the breakdown is requested at a depth, Children holds the
+// sub-categories of the slice and Value includes their amounts.
 type ChartData struct {
-	Name  string  `json:"name"`
-	Value float64 `json:"value"`
-	Color string  `json:"color"`
+	Name     string      `json:"name"`
+	Value    Money       `json:"value"`
+	Color    string      `json:"color"`
+	Children []ChartData `json:"children,omitempty"`
 }
 
+// Dashboard amounts are in Currency, the home currency of the user, converted at the rate of each transaction date.
+// RemainingBalance is the balance of the account, or the total of the accounts when it covers several or all of them.
 type Dashboard struct {
-	TotalIncome      float64     `json:"totalIncome"`
-	TotalExpense     float64     `json:"totalExpense"`
-	TotalSavings     float64     `json:"totalSavings"`
-	RemainingBalance float64     `json:"remainingBalance"`
+	Currency         string      `json:"currency"`
+	TotalIncome      Money       `json:"totalIncome"`
+	TotalExpense     Money       `json:"totalExpense"`
+	TotalSavings     Money       `json:"totalSavings"`
+	RemainingBalance Money       `json:"remainingBalance"`
+	ExpenseBreakdown []ChartData `json:"expenseBreakdown"`
+	SavingsBreakdown []ChartData `json:"savingsBreakdown"`
+	IncomeBreakdown  []ChartData `json:"incomeBreakdown"`
+	// Envelopes is only set when the dashboard covers a single calendar month
+	Envelopes *EnvelopeSummary `json:"envelopes,omitempty"`
+	// Comparison is only set when the dashboard is compared with another period
+	Comparison *DashboardComparison `json:"comparison,omitempty"`
+}
+
+// DashboardComparison holds the totals and breakdowns of the period from StartDate to EndDate a dashboard is compared
+// with, and how much each total and top-level category of the dashboard changed from it.
+type DashboardComparison struct {
+	CompareTo        string      `json:"compareTo"`
+	StartDate        string      `json:"startDate"`
+	EndDate          string      `json:"endDate"`
+	TotalIncome      Money       `json:"totalIncome"`
+	TotalExpense     Money       `json:"totalExpense"`
+	TotalSavings     Money       `json:"totalSavings"`
 	ExpenseBreakdown []ChartData `json:"expenseBreakdown"`
 	SavingsBreakdown []ChartData `json:"savingsBreakdown"`
 	IncomeBreakdown  []ChartData `json:"incomeBreakdown"`
+	Income           Delta       `json:"income"`
+	Expense          Delta       `json:"expense"`
+	Savings          Delta       `json:"savings"`
+	ExpenseDeltas    []Delta     `json:"expenseDeltas"`
+	SavingsDeltas    []Delta     `json:"savingsDeltas"`
+	IncomeDeltas     []Delta     `json:"incomeDeltas"`
+}
+
+// Delta is the change of an amount from Previous to Current. Percent is the change relative to Previous, left out when
+// there was nothing to compare with.
+type Delta struct {
+	Name     string   `json:"name,omitempty"`
+	Current  Money    `json:"current"`
+	Previous Money    `json:"previous"`
+	Change   Money    `json:"change"`
+	Percent  *float64 `json:"percent,omitempty"`
+}
+
+func NewDelta(name string, current, previous Money) Delta {
+	delta := Delta{Name: name, Current: current, Previous: previous, Change: current - previous}
+
+	if previous != 0 {
+		percent := math.Round(delta.Change.Ratio(previous.Abs())*10000) / 100
+		delta.Percent = &percent
+	}
+
+	return delta
 }
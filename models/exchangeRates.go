@@ -0,0 +1,136 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DefaultCurrency is the currency of users and accounts that were created before currencies existed
+const DefaultCurrency = "INR"
+
+var (
+	currencyCode = regexp.MustCompile(`^[A-Z]{3}$`)
+	dateOnly     = regexp.MustCompile(`^[0-9]{4}-[0-9]{2}-[0-9]{2}$`)
+)
+
+// ExchangeRate is the price of one unit of From in To, in effect from Date until the next rate of the same pair.
+type ExchangeRate struct {
+	ID        int     `json:"id"`
+	From      string  `json:"from"`
+	To        string  `json:"to"`
+	Rate      float64 `json:"rate"`
+	Date      string  `json:"date"`
+	CreatedAt string  `json:"createdAt"`
+}
+
+// NormalizeCurrency upper-cases an ISO 4217 currency code and checks that it has three letters.
+func NormalizeCurrency(code string) (string, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if !currencyCode.MatchString(code) {
+		return "", fmt.Errorf("invalid currency %q, use a three letter ISO code", code)
+	}
+
+	return code, nil
+}
+
+func (e *ExchangeRate) Validate() error {
+	from, err := NormalizeCurrency(e.From)
+	if err != nil {
+		return err
+	}
+
+	to, err := NormalizeCurrency(e.To)
+	if err != nil {
+		return err
+	}
+
+	e.From, e.To = from, to
+
+	if e.From == e.To {
+		return errors.New("exchange rate currencies must be different")
+	}
+
+	if e.Rate <= 0 {
+		return errors.New("exchange rate must be positive")
+	}
+
+	if len(e.Date) < len("2006-01-02") || !dateOnly.MatchString(e.Date[:10]) {
+		return errors.New("invalid exchange rate date format, use YYYY-MM-DD")
+	}
+
+	e.Date = e.Date[:10]
+
+	return nil
+}
+
+// Converter converts amounts between currencies at the rate in effect on a date, the latest rate of the pair dated on
+// or before it. A pair without a direct rate is converted with the inverse of the opposite pair.
+type Converter struct {
+	HomeCurrency string
+	rates        map[[2]string][]*ExchangeRate
+}
+
+func NewConverter(homeCurrency string, rates []*ExchangeRate) *Converter {
+	c := &Converter{HomeCurrency: homeCurrency, rates: make(map[[2]string][]*ExchangeRate)}
+
+	for _, rate := range rates {
+		pair := [2]string{rate.From, rate.To}
+		c.rates[pair] = append(c.rates[pair], rate)
+	}
+
+	for _, pairRates := range c.rates {
+		sort.Slice(pairRates, func(i, j int) bool { return pairRates[i].Date < pairRates[j].Date })
+	}
+
+	return c
+}
+
+// Convert converts amount from one currency to another at the rate in effect on date, which may be a date or a
+// timestamp. Amounts in the same currency are returned unchanged.
+func (c *Converter) Convert(amount Money, from, to, date string) (Money, error) {
+	if from == to || amount == 0 {
+		return amount, nil
+	}
+
+	if len(date) > len("2006-01-02") {
+		date = date[:10]
+	}
+
+	if rate := c.rateOn(from, to, date); rate != nil {
+		return amount.mulRat(rate), nil
+	}
+
+	if rate := c.rateOn(to, from, date); rate != nil {
+		return amount.mulRat(rate.Inv(rate)), nil
+	}
+
+	return 0, fmt.Errorf("no exchange rate from %s to %s on %s", from, to, date)
+}
+
+// ToHome converts amount to the home currency at the rate in effect on date.
+func (c *Converter) ToHome(amount Money, from, date string) (Money, error) {
+	return c.Convert(amount, from, c.HomeCurrency, date)
+}
+
+func (c *Converter) rateOn(from, to, date string) *big.Rat {
+	pairRates := c.rates[[2]string{from, to}]
+
+	// Index of the first rate dated after date, the one before it is in effect
+	i := sort.Search(len(pairRates), func(i int) bool { return pairRates[i].Date > date })
+	if i == 0 {
+		return nil
+	}
+
+	// Go through the shortest decimal form so a rate like 0.012 is used exactly rather than its binary approximation
+	rate, ok := new(big.Rat).SetString(strconv.FormatFloat(pairRates[i-1].Rate, 'f', -1, 64))
+	if !ok {
+		return nil
+	}
+
+	return rate
+}
@@ -0,0 +1,81 @@
+package models
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ConverterConvert(t *testing.T) {
+	converter := NewConverter("INR", []*ExchangeRate{
+		{From: "USD", To: "INR", Rate: 84, Date: "2025-06-01"},
+		{From: "USD", To: "INR", Rate: 83.25, Date: "2025-05-01"},
+		{From: "INR", To: "EUR", Rate: 0.011, Date: "2025-05-01"},
+	})
+
+	tests := []struct {
+		description string
+		amount      Money
+		from        string
+		to          string
+		date        string
+		expected    Money
+		expectedErr error
+	}{
+		{"same currency", NewMoney(10), "INR", "INR", "2025-05-10", NewMoney(10), nil},
+		{"rate in effect on the date", NewMoney(10), "USD", "INR", "2025-05-31", NewMoney(832.5), nil},
+		{"later rate from its date", NewMoney(10), "USD", "INR", "2025-06-01 10:30:00", NewMoney(840), nil},
+		{"latest rate after the last date", NewMoney(10), "USD", "INR", "2025-09-15T00:00:00.000Z", NewMoney(840), nil},
+		{"inverse of the opposite pair", NewMoney(832.5), "INR", "USD", "2025-05-15", NewMoney(10), nil},
+		{"exact decimal rate", NewMoney(1000), "INR", "EUR", "2025-05-15", NewMoney(11), nil},
+		{"inverse rounds half away from zero", NewMoney(1), "EUR", "INR", "2025-05-15", NewMoney(90.9091), nil},
+		{"no rate before the first date", NewMoney(10), "USD", "INR", "2025-04-30", 0,
+			errors.New("no exchange rate from USD to INR on 2025-04-30")},
+		{"unknown pair", NewMoney(10), "USD", "EUR", "2025-05-15", 0,
+			errors.New("no exchange rate from USD to EUR on 2025-05-15")},
+	}
+
+	for i, tc := range tests {
+		output, err := converter.Convert(tc.amount, tc.from, tc.to, tc.date)
+
+		assert.Equalf(t, tc.expected, output, "TEST[%d], failed.\n%s", i, tc.description)
+		assert.Equalf(t, tc.expectedErr, err, "TEST[%d], failed.\n%s", i, tc.description)
+	}
+
+	home, err := converter.ToHome(NewMoney(2), "USD", "2025-05-02")
+
+	assert.NoError(t, err)
+	assert.Equal(t, NewMoney(166.5), home)
+}
+
+func Test_ExchangeRateValidate(t *testing.T) {
+	tests := []struct {
+		description string
+		input       ExchangeRate
+		expected    ExchangeRate
+		expectedErr error
+	}{
+		{"normalizes currencies and date", ExchangeRate{From: " usd", To: "inr", Rate: 83.25, Date: "2025-05-01T00:00:00Z"},
+			ExchangeRate{From: "USD", To: "INR", Rate: 83.25, Date: "2025-05-01"}, nil},
+		{"invalid currency", ExchangeRate{From: "US", To: "INR", Rate: 1, Date: "2025-05-01"},
+			ExchangeRate{From: "US", To: "INR", Rate: 1, Date: "2025-05-01"},
+			errors.New(`invalid currency "US", use a three letter ISO code`)},
+		{"same currencies", ExchangeRate{From: "INR", To: "inr", Rate: 1, Date: "2025-05-01"},
+			ExchangeRate{From: "INR", To: "INR", Rate: 1, Date: "2025-05-01"},
+			errors.New("exchange rate currencies must be different")},
+		{"non-positive rate", ExchangeRate{From: "USD", To: "INR", Rate: 0, Date: "2025-05-01"},
+			ExchangeRate{From: "USD", To: "INR", Rate: 0, Date: "2025-05-01"}, errors.New("exchange rate must be positive")},
+		{"invalid date", ExchangeRate{From: "USD", To: "INR", Rate: 1, Date: "01/05/2025"},
+			ExchangeRate{From: "USD", To: "INR", Rate: 1, Date: "01/05/2025"},
+			errors.New("invalid exchange rate date format, use YYYY-MM-DD")},
+	}
+
+	for i, tc := range tests {
+		rate := tc.input
+		err := rate.Validate()
+
+		assert.Equalf(t, tc.expected, rate, "TEST[%d], failed.\n%s", i, tc.description)
+		assert.Equalf(t, tc.expectedErr, err, "TEST[%d], failed.\n%s", i, tc.description)
+	}
+}
@@ -0,0 +1,52 @@
+package models
+
+// Reasons a forecast alerts on a date
+const (
+	ForecastNegative       = "NEGATIVE"
+	ForecastBelowThreshold = "BELOW THRESHOLD"
+)
+
+// Forecast projects the balance of an account over the next Days days, in its currency. Every day the balance goes
+// down by DailyDiscretionary, the average daily spending outside recurring transactions, and changes by the recurring
+// transactions due that day.
+type Forecast struct {
+	Account            AccountDetails  `json:"account"`
+	Currency           string          `json:"currency"`
+	From               string          `json:"from"`
+	To                 string          `json:"to"`
+	StartingBalance    Money           `json:"startingBalance"`
+	Threshold          Money           `json:"threshold"`
+	DailyDiscretionary Money           `json:"dailyDiscretionary"`
+	LowestBalance      Money           `json:"lowestBalance"`
+	LowestDate         string          `json:"lowestDate"`
+	Points             []ForecastPoint `json:"points"`
+	Alerts             []ForecastAlert `json:"alerts"`
+}
+
+// ForecastPoint is the projected balance at the end of Date and what changed it that day.
+type ForecastPoint struct {
+	Date           string         `json:"date"`
+	Balance        Money          `json:"balance"`
+	Change         Money          `json:"change"`
+	Items          []ForecastItem `json:"items,omitempty"`
+	Negative       bool           `json:"negative"`
+	BelowThreshold bool           `json:"belowThreshold"`
+}
+
+// ForecastItem is a recurring transaction due on a forecast date. Amount is its signed effect on the balance.
+type ForecastItem struct {
+	RecurringTransactionID int    `json:"recurringTransactionID"`
+	Type                   Type   `json:"type"`
+	Category               string `json:"category"`
+	Description            string `json:"description"`
+	Amount                 Money  `json:"amount"`
+}
+
+// ForecastAlert marks the first day of a run of days the balance is projected to stay negative or under the
+// threshold, with the recurring transactions due that day that took it there.
+type ForecastAlert struct {
+	Date    string         `json:"date"`
+	Reason  string         `json:"reason"`
+	Balance Money          `json:"balance"`
+	Items   []ForecastItem `json:"items,omitempty"`
+}
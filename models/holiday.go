@@ -0,0 +1,10 @@
+package models
+
+// Holiday is a day, as YYYY-MM-DD, that recurring transactions which shift off weekends are moved off as well.
+type Holiday struct {
+	ID        int    `json:"id"`
+	UserID    int    `json:"userID"`
+	Date      string `json:"date"`
+	Name      string `json:"name"`
+	CreatedAt string `json:"createdAt"`
+}
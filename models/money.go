@@ -0,0 +1,227 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// MoneyScale is the number of decimal places a Money keeps, matching the DECIMAL(19,4) columns.
+const MoneyScale = 4
+
+const moneyUnit = 10000
+
+// Money is an exact amount of money stored as an integer number of ten-thousandths, so adding and subtracting
+// amounts never drifts the way float64 does. It is encoded in JSON as a decimal string like "12.50" and accepts
+// both strings and numbers when decoded.
+type Money int64
+
+var errInvalidMoney = errors.New("invalid amount")
+
+// NewMoney converts a float to Money, rounding half away from zero to the nearest ten-thousandth.
+func NewMoney(value float64) Money {
+	return Money(math.Round(value * moneyUnit))
+}
+
+// ParseMoney parses a decimal string like "-1234.5", rounding half away from zero beyond four decimals. Thousands
+// separators are not accepted.
+func ParseMoney(value string) (Money, error) {
+	value = strings.TrimSpace(value)
+
+	negative := strings.HasPrefix(value, "-")
+	if negative || strings.HasPrefix(value, "+") {
+		value = value[1:]
+	}
+
+	whole, fraction, _ := strings.Cut(value, ".")
+	if (whole == "" && fraction == "") || !isDigits(whole) || !isDigits(fraction) {
+		return 0, errInvalidMoney
+	}
+
+	roundUp := len(fraction) > MoneyScale && fraction[MoneyScale] >= '5'
+	if len(fraction) > MoneyScale {
+		fraction = fraction[:MoneyScale]
+	}
+
+	fraction += strings.Repeat("0", MoneyScale-len(fraction))
+
+	units, err := strconv.ParseInt(whole+fraction, 10, 64)
+	if err != nil {
+		return 0, errInvalidMoney
+	}
+
+	if roundUp {
+		units++
+	}
+
+	if negative {
+		units = -units
+	}
+
+	return Money(units), nil
+}
+
+func isDigits(value string) bool {
+	for _, r := range value {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (m Money) Abs() Money {
+	if m < 0 {
+		return -m
+	}
+
+	return m
+}
+
+// Float64 returns the amount as a float, for ratios and chart values where exactness does not matter.
+func (m Money) Float64() float64 {
+	return float64(m) / moneyUnit
+}
+
+// MulDiv returns m * num / den rounded half away from zero, computed without overflow.
+func (m Money) MulDiv(num, den int64) Money {
+	if den == 0 {
+		return 0
+	}
+
+	return m.mulRat(big.NewRat(num, den))
+}
+
+// MulRate multiplies m by a rate such as an exchange rate, rounding half away from zero.
+func (m Money) MulRate(rate float64) Money {
+	r := new(big.Rat)
+	if r.SetFloat64(rate) == nil {
+		return 0
+	}
+
+	return m.mulRat(r)
+}
+
+func (m Money) mulRat(r *big.Rat) Money {
+	product := new(big.Rat).Mul(big.NewRat(int64(m), 1), r)
+
+	quotient, remainder := new(big.Int).QuoRem(product.Num(), product.Denom(), new(big.Int))
+
+	// Round half away from zero: compare twice the remainder with the denominator
+	remainder.Abs(remainder).Lsh(remainder, 1)
+	if remainder.Cmp(product.Denom()) >= 0 {
+		if product.Sign() < 0 {
+			quotient.Sub(quotient, big.NewInt(1))
+		} else {
+			quotient.Add(quotient, big.NewInt(1))
+		}
+	}
+
+	return Money(quotient.Int64())
+}
+
+// Ratio returns m / other as a float, or 0 when other is zero.
+func (m Money) Ratio(other Money) float64 {
+	if other == 0 {
+		return 0
+	}
+
+	return float64(m) / float64(other)
+}
+
+// String formats the amount with at least two and at most four decimals, e.g. "12.50" or "0.1234".
+func (m Money) String() string {
+	sign := ""
+	value := int64(m)
+
+	if value < 0 {
+		sign = "-"
+		value = -value
+	}
+
+	fraction := fmt.Sprintf("%04d", value%moneyUnit)
+	fraction = strings.TrimRight(fraction, "0")
+
+	if len(fraction) < 2 {
+		fraction += strings.Repeat("0", 2-len(fraction))
+	}
+
+	return sign + strconv.FormatInt(value/moneyUnit, 10) + "." + fraction
+}
+
+func (m Money) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.String())
+}
+
+func (m *Money) UnmarshalJSON(data []byte) error {
+	value := strings.TrimSpace(string(data))
+	if value == "null" {
+		return nil
+	}
+
+	value = strings.Trim(value, `"`)
+
+	// Numbers in exponent notation, e.g. 1e3, go through a float
+	if strings.ContainsAny(value, "eE") {
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return errInvalidMoney
+		}
+
+		*m = NewMoney(f)
+
+		return nil
+	}
+
+	parsed, err := ParseMoney(value)
+	if err != nil {
+		return err
+	}
+
+	*m = parsed
+
+	return nil
+}
+
+// Value stores the amount as a decimal string so MySQL converts it to DECIMAL exactly.
+func (m Money) Value() (driver.Value, error) {
+	return m.String(), nil
+}
+
+func (m *Money) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*m = 0
+	case []byte:
+		return m.scanString(string(v))
+	case string:
+		return m.scanString(v)
+	case float64:
+		*m = NewMoney(v)
+	case float32:
+		*m = NewMoney(float64(v))
+	case int64:
+		*m = Money(v * moneyUnit)
+	default:
+		return fmt.Errorf("cannot scan %T into Money", src)
+	}
+
+	return nil
+}
+
+func (m *Money) scanString(value string) error {
+	parsed, err := ParseMoney(value)
+	if err != nil {
+		return err
+	}
+
+	*m = parsed
+
+	return nil
+}
@@ -0,0 +1,174 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ParseMoney(t *testing.T) {
+	tests := []struct {
+		description string
+		input       string
+		expected    Money
+		expectedErr error
+	}{
+		{"whole number", "100", 1000000, nil},
+		{"two decimals", "12.34", 123400, nil},
+		{"four decimals", "0.0001", 1, nil},
+		{"negative", "-45.5", -455000, nil},
+		{"explicit plus", "+7", 70000, nil},
+		{"leading dot", ".5", 5000, nil},
+		{"rounds half away from zero", "1.00005", 10001, nil},
+		{"rounds down", "1.00004", 10000, nil},
+		{"rounds negative away from zero", "-1.00005", -10001, nil},
+		{"surrounding spaces", " 3.10 ", 31000, nil},
+		{"empty", "", 0, errInvalidMoney},
+		{"only a dot", ".", 0, errInvalidMoney},
+		{"letters", "12a", 0, errInvalidMoney},
+		{"thousands separator", "1,000", 0, errInvalidMoney},
+		{"two dots", "1.2.3", 0, errInvalidMoney},
+	}
+
+	for i, tc := range tests {
+		output, err := ParseMoney(tc.input)
+
+		assert.Equalf(t, tc.expected, output, "TEST[%d], failed.\n%s", i, tc.description)
+		assert.Equalf(t, tc.expectedErr, err, "TEST[%d], failed.\n%s", i, tc.description)
+	}
+}
+
+func Test_MoneyString(t *testing.T) {
+	tests := []struct {
+		description string
+		input       Money
+		expected    string
+	}{
+		{"zero", 0, "0.00"},
+		{"whole number", 1000000, "100.00"},
+		{"one decimal", 123000, "12.30"},
+		{"four decimals", 1, "0.0001"},
+		{"three decimals", 12340, "1.234"},
+		{"negative", -455000, "-45.50"},
+		{"negative below one", -5000, "-0.50"},
+	}
+
+	for i, tc := range tests {
+		assert.Equalf(t, tc.expected, tc.input.String(), "TEST[%d], failed.\n%s", i, tc.description)
+	}
+}
+
+func Test_MoneyJSON(t *testing.T) {
+	tests := []struct {
+		description string
+		input       string
+		expected    Money
+		expectedErr bool
+	}{
+		{"number", `{"amount":0.1}`, 1000, false},
+		{"string", `{"amount":"19.99"}`, 199900, false},
+		{"integer", `{"amount":250}`, 2500000, false},
+		{"exponent", `{"amount":1e3}`, 10000000, false},
+		{"null", `{"amount":null}`, 0, false},
+		{"invalid", `{"amount":"abc"}`, 0, true},
+	}
+
+	for i, tc := range tests {
+		var body struct {
+			Amount Money `json:"amount"`
+		}
+
+		err := json.Unmarshal([]byte(tc.input), &body)
+
+		assert.Equalf(t, tc.expected, body.Amount, "TEST[%d], failed.\n%s", i, tc.description)
+		assert.Equalf(t, tc.expectedErr, err != nil, "TEST[%d], failed.\n%s", i, tc.description)
+	}
+
+	output, err := json.Marshal(Transaction{Amount: 1234500})
+
+	assert.NoError(t, err)
+	assert.Contains(t, string(output), `"amount":"123.45"`)
+}
+
+func Test_MoneyScan(t *testing.T) {
+	tests := []struct {
+		description string
+		input       interface{}
+		expected    Money
+		expectedErr bool
+	}{
+		{"decimal column as bytes", []byte("1234.5600"), 12345600, false},
+		{"decimal column as string", "0.3000", 3000, false},
+		{"float column", 0.1, 1000, false},
+		{"integer column", int64(42), 420000, false},
+		{"null column", nil, 0, false},
+		{"unsupported type", true, 0, true},
+	}
+
+	for i, tc := range tests {
+		var m Money
+
+		err := m.Scan(tc.input)
+
+		assert.Equalf(t, tc.expected, m, "TEST[%d], failed.\n%s", i, tc.description)
+		assert.Equalf(t, tc.expectedErr, err != nil, "TEST[%d], failed.\n%s", i, tc.description)
+	}
+
+	value, err := Money(3000).Value()
+
+	assert.NoError(t, err)
+	assert.Equal(t, "0.30", value)
+}
+
+func Test_MoneyArithmetic(t *testing.T) {
+	a, _ := ParseMoney("0.1")
+	b, _ := ParseMoney("0.2")
+	c, _ := ParseMoney("0.3")
+
+	assert.Equal(t, c, a+b, "0.1 + 0.2 must be exactly 0.3")
+
+	tests := []struct {
+		description string
+		output      Money
+		expected    Money
+	}{
+		{"MulDiv rounds half away from zero", Money(10).MulDiv(1, 4), 3},
+		{"MulDiv negative", Money(-10).MulDiv(1, 4), -3},
+		{"MulDiv projection", Money(1000000).MulDiv(31, 10), 3100000},
+		{"MulDiv by zero", Money(1000000).MulDiv(1, 0), 0},
+		{"MulRate", Money(1000000).MulRate(83.25), 83250000},
+		{"MulRate rounds", Money(1).MulRate(0.5), 1},
+		{"Abs", Money(-5).Abs(), 5},
+	}
+
+	for i, tc := range tests {
+		assert.Equalf(t, tc.expected, tc.output, "TEST[%d], failed.\n%s", i, tc.description)
+	}
+
+	assert.InDelta(t, 0.25, Money(250).Ratio(1000), 1e-12)
+	assert.Equal(t, 0.0, Money(250).Ratio(0))
+}
+
+func Test_MoneyNoDrift(t *testing.T) {
+	cent, _ := ParseMoney("0.01")
+	tenth, _ := ParseMoney("0.1")
+
+	var balance Money
+
+	floatBalance := 0.0
+
+	for i := 0; i < 100000; i++ {
+		balance += tenth
+		floatBalance += 0.1
+	}
+
+	for i := 0; i < 100000; i++ {
+		balance -= cent
+	}
+
+	expected, _ := ParseMoney("9000")
+
+	assert.Equal(t, expected, balance)
+	assert.NotEqual(t, 10000.0, floatBalance, "float64 drifts, which is why Money exists")
+}
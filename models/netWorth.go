@@ -0,0 +1,43 @@
+package models
+
+// NetWorth is what a user owns minus what they owe, in their home currency. Assets add up the balances of every active
+// account that is not a liability and the value of open savings, Liabilities what is owed on credit cards and loans.
+type NetWorth struct {
+	Currency    string            `json:"currency"`
+	Assets      Money             `json:"assets"`
+	Liabilities Money             `json:"liabilities"`
+	NetWorth    Money             `json:"netWorth"`
+	Savings     Money             `json:"savings"`
+	Accounts    []NetWorthAccount `json:"accounts"`
+	History     []NetWorthPoint   `json:"history"`
+}
+
+// NetWorthAccount is the balance of one account converted to the home currency.
+type NetWorthAccount struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	Type      string `json:"type"`
+	Currency  string `json:"currency"`
+	Balance   Money  `json:"balance"`
+	Liability bool   `json:"liability"`
+}
+
+// NetWorthPoint is the net worth at the end of Month, on Date, which is today for the current month.
+type NetWorthPoint struct {
+	Month       string `json:"month"`
+	Date        string `json:"date"`
+	Assets      Money  `json:"assets"`
+	Liabilities Money  `json:"liabilities"`
+	NetWorth    Money  `json:"netWorth"`
+}
+
+// Add counts a balance as an asset, or for a liability what is owed on it.
+func (p *NetWorthPoint) Add(balance Money, liability bool) {
+	if liability {
+		p.Liabilities -= balance
+	} else {
+		p.Assets += balance
+	}
+
+	p.NetWorth = p.Assets - p.Liabilities
+}
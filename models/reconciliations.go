@@ -0,0 +1,46 @@
+package models
+
+import (
+	"errors"
+	"time"
+)
+
+type ReconciliationStatus string
+
+const (
+	OPEN   ReconciliationStatus = "OPEN"
+	LOCKED ReconciliationStatus = "LOCKED"
+)
+
+// Reconciliation checks an account against a bank statement closing on StatementDate with StatementBalance.
+// ClearedBalance is the opening balance of the account plus every CLEARED or RECONCILED transaction up to the statement
+// date, and Difference is StatementBalance minus ClearedBalance. Once the difference is zero the reconciliation can be
+// locked, which turns its cleared transactions RECONCILED. Transactions lists the transactions of an OPEN
+// reconciliation that are not reconciled yet. A transfer is cleared and reconciled by its leg on the account.
+type Reconciliation struct {
+	ID               int                  `json:"id"`
+	UserID           int                  `json:"userID"`
+	Account          AccountDetails       `json:"account"`
+	StatementDate    string               `json:"statementDate"`
+	StatementBalance Money                `json:"statementBalance"`
+	ClearedBalance   Money                `json:"clearedBalance"`
+	Difference       Money                `json:"difference"`
+	Status           ReconciliationStatus `json:"status"`
+	Transactions     []*Transaction       `json:"transactions,omitempty"`
+	CreatedAt        string               `json:"createdAt"`
+	LockedAt         string               `json:"lockedAt,omitempty"`
+}
+
+// ReconciliationClearing marks transactions of a reconciliation as cleared, or back as uncleared.
+type ReconciliationClearing struct {
+	Cleared   []int `json:"cleared"`
+	Uncleared []int `json:"uncleared"`
+}
+
+func (r *Reconciliation) Validate() error {
+	if _, err := time.Parse("2006-01-02", r.StatementDate); err != nil {
+		return errors.New("invalid statement date format, use YYYY-MM-DD")
+	}
+
+	return nil
+}
@@ -0,0 +1,472 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// maxPeriods bounds the periods searched for the next occurrence, enough for a fifth weekday that most months lack
+	maxPeriods = 120
+	// maxShiftDays bounds how far an occurrence is moved off weekends and holidays
+	maxShiftDays = 31
+)
+
+// Shift moves an occurrence that falls on a weekend or a holiday to the business day before or after it.
+type Shift string
+
+const (
+	ShiftNone   Shift = "NONE"
+	ShiftBefore Shift = "BEFORE"
+	ShiftAfter  Shift = "AFTER"
+)
+
+var weekdays = map[string]time.Weekday{
+	"SU": time.Sunday, "MO": time.Monday, "TU": time.Tuesday, "WE": time.Wednesday, "TH": time.Thursday,
+	"FR": time.Friday, "SA": time.Saturday,
+}
+
+// Recurrence is the schedule of a recurring transaction, anchored on its first occurrence Start.
+//
+// Daily, weekly and custom schedules repeat every Interval days or weeks from Start, weekly ones on Weekday when it is
+// set. Monthly, quarterly and yearly ones repeat every Interval periods on the MonthDays, where -1 is the last day of
+// the month, on the WeekdayOrdinal Weekday of the month, e.g. 2 and "TU" for the second Tuesday, or otherwise on the
+// day of the month of Start. A day past the end of a shorter month falls on its last day, so a schedule starting on
+// January 31 runs on February 28. Holidays are the days, as YYYY-MM-DD, that Shift moves occurrences off besides
+// weekends.
+type Recurrence struct {
+	Start          time.Time
+	Frequency      Frequency
+	Interval       int
+	MonthDays      []int
+	Weekday        string
+	WeekdayOrdinal int
+	Shift          Shift
+	Holidays       map[string]bool
+}
+
+func (r *Recurrence) Validate() error {
+	switch r.Frequency {
+	case DAILY, WEEKLY, MONTHLY, QUARTERLY, YEARLY:
+	case CUSTOM:
+		if r.Interval <= 0 {
+			return errors.New("invalid customDays: must be > 0")
+		}
+	default:
+		return errors.New("unsupported frequency")
+	}
+
+	if r.Interval < 0 {
+		return errors.New("invalid interval: must be > 0")
+	}
+
+	if len(r.MonthDays) != 0 && !r.monthly() {
+		return errors.New("monthDays need a MONTHLY, QUARTERLY or YEARLY frequency")
+	}
+
+	if r.Weekday != "" && !r.monthly() && r.Frequency != WEEKLY {
+		return errors.New("weekday needs a WEEKLY, MONTHLY, QUARTERLY or YEARLY frequency")
+	}
+
+	if len(r.MonthDays) != 0 && r.Weekday != "" {
+		return errors.New("use either monthDays or weekday, not both")
+	}
+
+	for _, day := range r.MonthDays {
+		if day == 0 || day < -31 || day > 31 {
+			return errors.New("invalid monthDays: use 1 to 31, or -1 to -31 counting back from the end of the month")
+		}
+	}
+
+	if r.Weekday != "" {
+		if _, ok := weekdays[r.Weekday]; !ok {
+			return errors.New("invalid weekday: use MO, TU, WE, TH, FR, SA or SU")
+		}
+
+		switch {
+		case r.Frequency == WEEKLY:
+			if r.WeekdayOrdinal != 0 {
+				return errors.New("weekdayOrdinal needs a MONTHLY, QUARTERLY or YEARLY frequency")
+			}
+		case r.WeekdayOrdinal == 0 || r.WeekdayOrdinal < -5 || r.WeekdayOrdinal > 5:
+			return errors.New("invalid weekdayOrdinal: use 1 to 5, or -1 to -5 counting back from the end of the month")
+		}
+	}
+
+	switch r.Shift {
+	case "", ShiftNone, ShiftBefore, ShiftAfter:
+	default:
+		return errors.New("invalid shift: use NONE, BEFORE or AFTER")
+	}
+
+	return nil
+}
+
+// Next returns the first occurrence after the given time.
+func (r *Recurrence) Next(after time.Time) (time.Time, error) {
+	err := r.Validate()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	first := r.periodOf(after) - 1
+	if first < 0 {
+		first = 0
+	}
+
+	for k := first; k < first+maxPeriods; k++ {
+		for _, scheduled := range r.period(k) {
+			if scheduled.Before(r.Start) {
+				continue
+			}
+
+			if at := r.shift(scheduled); at.After(after) {
+				return at, nil
+			}
+		}
+	}
+
+	return time.Time{}, errors.New("recurrence has no further occurrence")
+}
+
+// Following returns the occurrence after the one at scheduled. A holiday added since the occurrence was scheduled
+// moves it off the holiday, and the occurrence after it comes after where it moved to.
+func (r *Recurrence) Following(scheduled time.Time) (time.Time, error) {
+	if at := r.shift(scheduled); at.After(scheduled) {
+		scheduled = at
+	}
+
+	return r.Next(scheduled)
+}
+
+// Instance is an occurrence of a schedule as its RRule lists it, Scheduled, and the time Shift moves it to, At. At is
+// zero when the shift moves it onto an earlier occurrence, which it is merged into.
+type Instance struct {
+	Scheduled time.Time
+	At        time.Time
+}
+
+// Instances lists the occurrences of the schedule from its start up to until, both included, as its RRule lists them,
+// with the time each one is shifted to.
+func (r *Recurrence) Instances(until time.Time) ([]Instance, error) {
+	err := r.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	var instances []Instance
+
+	// Shifted times are compared to the last one the same way Next compares them to the time it is given
+	last := r.Start.Add(-time.Nanosecond)
+
+	for k := 0; k <= r.periodOf(until); k++ {
+		for _, scheduled := range r.period(k) {
+			if scheduled.Before(r.Start) || scheduled.After(until) {
+				continue
+			}
+
+			instance := Instance{Scheduled: scheduled}
+
+			if at := r.shift(scheduled); at.After(last) {
+				instance.At, last = at, at
+			}
+
+			instances = append(instances, instance)
+		}
+	}
+
+	return instances, nil
+}
+
+// RRule is the RFC 5545 recurrence rule of the schedule, e.g. "FREQ=MONTHLY;BYDAY=2TU". The weekend and holiday shift
+// has no RRULE equivalent and is left out.
+func (r *Recurrence) RRule() string {
+	interval := r.step()
+	if r.Frequency == WEEKLY || r.Frequency == YEARLY {
+		interval = max(r.Interval, 1)
+	}
+
+	parts := []string{"FREQ=" + string(r.Frequency)}
+
+	switch r.Frequency {
+	case CUSTOM:
+		parts[0] = "FREQ=DAILY"
+	case QUARTERLY:
+		parts[0] = "FREQ=MONTHLY"
+	}
+
+	if interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(interval))
+	}
+
+	if r.Frequency == YEARLY && (len(r.MonthDays) != 0 || r.Weekday != "" || r.Start.Day() > 28) {
+		parts = append(parts, "BYMONTH="+strconv.Itoa(int(r.Start.Month())))
+	}
+
+	switch {
+	case len(r.MonthDays) != 0:
+		days := make([]string, 0, len(r.MonthDays))
+		for _, day := range r.MonthDays {
+			days = append(days, strconv.Itoa(day))
+		}
+
+		parts = append(parts, "BYMONTHDAY="+strings.Join(days, ","))
+	case r.Weekday != "" && r.WeekdayOrdinal == 0:
+		parts = append(parts, "BYDAY="+r.Weekday)
+	case r.Weekday != "":
+		parts = append(parts, "BYDAY="+strconv.Itoa(r.WeekdayOrdinal)+r.Weekday)
+	case r.monthly() && r.Start.Day() > 28:
+		// The last of the days up to the start day that the month has, as a short month falls on its last day
+		days := make([]string, 0, 4)
+		for day := 28; day <= r.Start.Day(); day++ {
+			days = append(days, strconv.Itoa(day))
+		}
+
+		parts = append(parts, "BYMONTHDAY="+strings.Join(days, ","), "BYSETPOS=-1")
+	}
+
+	return strings.Join(parts, ";")
+}
+
+// ParseRRule reads an RFC 5545 recurrence rule into a schedule starting at start, and returns the UNTIL date of the
+// rule as well, zero when it has none. Only the parts a Recurrence can express are accepted.
+func ParseRRule(rule string, start time.Time) (*Recurrence, time.Time, error) {
+	var (
+		until    time.Time
+		monthDay []int
+		setPos   string
+	)
+
+	r := &Recurrence{Start: start, Interval: 1}
+
+	for _, part := range strings.Split(strings.TrimPrefix(strings.TrimSpace(rule), "RRULE:"), ";") {
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, until, fmt.Errorf("invalid RRULE part %q", part)
+		}
+
+		var err error
+
+		switch strings.ToUpper(key) {
+		case "FREQ":
+			r.Frequency = Frequency(strings.ToUpper(value))
+			if r.Frequency != DAILY && r.Frequency != WEEKLY && r.Frequency != MONTHLY && r.Frequency != YEARLY {
+				return nil, until, fmt.Errorf("unsupported RRULE frequency %q", value)
+			}
+		case "INTERVAL":
+			r.Interval, err = strconv.Atoi(value)
+			if err != nil || r.Interval <= 0 {
+				return nil, until, fmt.Errorf("invalid RRULE interval %q", value)
+			}
+		case "BYMONTHDAY":
+			for _, day := range strings.Split(value, ",") {
+				d, err := strconv.Atoi(day)
+				if err != nil {
+					return nil, until, fmt.Errorf("invalid RRULE month day %q", day)
+				}
+
+				monthDay = append(monthDay, d)
+			}
+		case "BYDAY":
+			// A weekly rule repeats on a plain weekday like MO, a monthly or yearly one on an ordinal weekday like 2TU
+			if len(value) < 2 {
+				return nil, until, fmt.Errorf("invalid RRULE day %q, use a weekday like MO or 2TU", value)
+			}
+
+			r.Weekday = strings.ToUpper(value[len(value)-2:])
+
+			if len(value) > 2 {
+				r.WeekdayOrdinal, err = strconv.Atoi(value[:len(value)-2])
+				if err != nil {
+					return nil, until, fmt.Errorf("invalid RRULE day %q, use a weekday like MO or 2TU", value)
+				}
+			}
+		case "BYMONTH":
+			if value != strconv.Itoa(int(start.Month())) {
+				return nil, until, errors.New("RRULE BYMONTH must be the month of the start date")
+			}
+		case "BYSETPOS":
+			setPos = value
+		case "UNTIL":
+			until, err = time.Parse("20060102T150405Z", value)
+			if err != nil {
+				until, err = time.Parse("20060102", value)
+			}
+
+			if err != nil {
+				return nil, until, fmt.Errorf("invalid RRULE until %q", value)
+			}
+		default:
+			return nil, until, fmt.Errorf("unsupported RRULE part %q", key)
+		}
+	}
+
+	switch {
+	case setPos == "":
+		r.MonthDays = monthDay
+	case setPos == "-1" && len(monthDay) != 0:
+		// The last listed day the month has, which is the greatest one falling on the last day of shorter months
+		sort.Ints(monthDay)
+		r.MonthDays = []int{monthDay[len(monthDay)-1]}
+	default:
+		return nil, until, errors.New("RRULE BYSETPOS is only supported as -1 with BYMONTHDAY")
+	}
+
+	if r.Frequency == "" {
+		return nil, until, errors.New("RRULE FREQ is required")
+	}
+
+	err := r.Validate()
+	if err != nil {
+		return nil, until, err
+	}
+
+	return r, until, nil
+}
+
+func (r *Recurrence) monthly() bool {
+	return r.Frequency == MONTHLY || r.Frequency == QUARTERLY || r.Frequency == YEARLY
+}
+
+// step is the number of days, weeks or months between periods.
+func (r *Recurrence) step() int {
+	interval := r.Interval
+	if interval <= 0 {
+		interval = 1
+	}
+
+	switch r.Frequency {
+	case WEEKLY:
+		return interval * 7
+	case QUARTERLY:
+		return interval * 3
+	case YEARLY:
+		return interval * 12
+	default:
+		return interval
+	}
+}
+
+// periodOf returns the index of the period t falls in, negative when it is before the start.
+func (r *Recurrence) periodOf(t time.Time) int {
+	if r.monthly() {
+		months := (t.Year()-r.Start.Year())*12 + int(t.Month()) - int(r.Start.Month())
+
+		return floorDiv(months, r.step())
+	}
+
+	return floorDiv(dayNumber(t)-dayNumber(r.Start), r.step())
+}
+
+// period returns the scheduled occurrences of the k-th period in order, before any shift.
+func (r *Recurrence) period(k int) []time.Time {
+	if !r.monthly() {
+		start := r.Start
+
+		// A weekly schedule on a weekday starts on the first of those days from Start
+		if r.Weekday != "" {
+			start = start.AddDate(0, 0, (int(weekdays[r.Weekday])-int(start.Weekday())+7)%7)
+		}
+
+		return []time.Time{start.AddDate(0, 0, k*r.step())}
+	}
+
+	month := time.Date(r.Start.Year(), r.Start.Month()+time.Month(k*r.step()), 1, r.Start.Hour(), r.Start.Minute(),
+		r.Start.Second(), r.Start.Nanosecond(), r.Start.Location())
+	last := month.AddDate(0, 1, -1).Day()
+
+	switch {
+	case len(r.MonthDays) != 0:
+		days := make([]int, 0, len(r.MonthDays))
+
+		for _, day := range r.MonthDays {
+			if day < 0 {
+				day = last + day + 1
+			}
+
+			days = append(days, min(max(day, 1), last))
+		}
+
+		sort.Ints(days)
+
+		occurrences := make([]time.Time, 0, len(days))
+
+		for i, day := range days {
+			if i == 0 || day != days[i-1] {
+				occurrences = append(occurrences, month.AddDate(0, 0, day-1))
+			}
+		}
+
+		return occurrences
+	case r.Weekday != "":
+		day, ok := nthWeekday(month, weekdays[r.Weekday], r.WeekdayOrdinal)
+		if !ok {
+			return nil
+		}
+
+		return []time.Time{month.AddDate(0, 0, day-1)}
+	default:
+		return []time.Time{month.AddDate(0, 0, min(r.Start.Day(), last)-1)}
+	}
+}
+
+// shift moves a scheduled occurrence off weekends and holidays as the schedule asks.
+func (r *Recurrence) shift(scheduled time.Time) time.Time {
+	direction := 0
+
+	switch r.Shift {
+	case ShiftBefore:
+		direction = -1
+	case ShiftAfter:
+		direction = 1
+	default:
+		return scheduled
+	}
+
+	// Bounded, so that a calendar closing every day cannot keep it looking
+	for n := 0; n < maxShiftDays && r.closed(scheduled); n++ {
+		scheduled = scheduled.AddDate(0, 0, direction)
+	}
+
+	return scheduled
+}
+
+// closed reports whether the day of t is no business day: a weekend or one of the holidays of the schedule.
+func (r *Recurrence) closed(t time.Time) bool {
+	return t.Weekday() == time.Saturday || t.Weekday() == time.Sunday || r.Holidays[t.Format("2006-01-02")]
+}
+
+// nthWeekday returns the day of the month of its ordinal-th weekday, counting back from the end when ordinal is
+// negative, and whether the month has one.
+func nthWeekday(month time.Time, weekday time.Weekday, ordinal int) (int, bool) {
+	last := month.AddDate(0, 1, -1).Day()
+
+	var day int
+
+	if ordinal > 0 {
+		first := (int(weekday)-int(month.Weekday())+7)%7 + 1
+		day = first + (ordinal-1)*7
+	} else {
+		lastWeekday := month.AddDate(0, 0, last-1).Weekday()
+		day = last - (int(lastWeekday)-int(weekday)+7)%7 + (ordinal+1)*7
+	}
+
+	return day, day >= 1 && day <= last
+}
+
+// dayNumber counts the calendar days since the Unix epoch up to the date of t, ignoring its time of day.
+func dayNumber(t time.Time) int {
+	return int(time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC).Unix() / 86400)
+}
+
+func floorDiv(a, b int) int {
+	if a < 0 {
+		return -((-a + b - 1) / b)
+	}
+
+	return a / b
+}
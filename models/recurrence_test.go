@@ -0,0 +1,235 @@
+package models
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_RecurrenceNext(t *testing.T) {
+	date := func(value string) time.Time {
+		d, _ := time.Parse("2006-01-02 15:04", value)
+		return d
+	}
+
+	tests := []struct {
+		description string
+		recurrence  Recurrence
+		after       time.Time
+		expected    []time.Time
+	}{
+		{"month end salary stays on the last day", Recurrence{Start: date("2025-01-31 09:00"), Frequency: MONTHLY},
+			date("2025-01-31 09:00"), []time.Time{date("2025-02-28 09:00"), date("2025-03-31 09:00"), date("2025-04-30 09:00")}},
+		{"first occurrence is the start", Recurrence{Start: date("2025-01-31 09:00"), Frequency: MONTHLY},
+			date("2025-01-01 00:00"), []time.Time{date("2025-01-31 09:00"), date("2025-02-28 09:00")}},
+		{"last day of month", Recurrence{Start: date("2024-01-01 00:00"), Frequency: MONTHLY, MonthDays: []int{-1}},
+			date("2024-01-15 00:00"), []time.Time{date("2024-01-31 00:00"), date("2024-02-29 00:00"), date("2024-03-31 00:00")}},
+		{"specific days of month", Recurrence{Start: date("2025-01-01 00:00"), Frequency: MONTHLY, MonthDays: []int{15, 1}},
+			date("2025-01-10 00:00"), []time.Time{date("2025-01-15 00:00"), date("2025-02-01 00:00"), date("2025-02-15 00:00")}},
+		{"second Tuesday", Recurrence{Start: date("2025-01-01 00:00"), Frequency: MONTHLY, Weekday: "TU", WeekdayOrdinal: 2},
+			date("2025-01-01 00:00"), []time.Time{date("2025-01-14 00:00"), date("2025-02-11 00:00"), date("2025-03-11 00:00")}},
+		{"last Friday", Recurrence{Start: date("2025-01-01 00:00"), Frequency: MONTHLY, Weekday: "FR", WeekdayOrdinal: -1},
+			date("2025-01-01 00:00"), []time.Time{date("2025-01-31 00:00"), date("2025-02-28 00:00"), date("2025-03-28 00:00")}},
+		{"fifth Monday skips months without one", Recurrence{Start: date("2025-01-01 00:00"), Frequency: MONTHLY,
+			Weekday: "MO", WeekdayOrdinal: 5}, date("2025-01-01 00:00"),
+			[]time.Time{date("2025-03-31 00:00"), date("2025-06-30 00:00"), date("2025-09-29 00:00")}},
+		{"quarterly", Recurrence{Start: date("2025-01-15 00:00"), Frequency: QUARTERLY}, date("2025-01-15 00:00"),
+			[]time.Time{date("2025-04-15 00:00"), date("2025-07-15 00:00"), date("2025-10-15 00:00")}},
+		{"yearly on a leap day", Recurrence{Start: date("2024-02-29 00:00"), Frequency: YEARLY}, date("2024-02-29 00:00"),
+			[]time.Time{date("2025-02-28 00:00"), date("2026-02-28 00:00"), date("2027-02-28 00:00"), date("2028-02-29 00:00")}},
+		{"every other week", Recurrence{Start: date("2025-01-03 00:00"), Frequency: WEEKLY, Interval: 2},
+			date("2025-01-20 00:00"), []time.Time{date("2025-01-31 00:00"), date("2025-02-14 00:00")}},
+		{"custom days", Recurrence{Start: date("2025-01-01 00:00"), Frequency: CUSTOM, Interval: 10},
+			date("2025-01-01 00:00"), []time.Time{date("2025-01-11 00:00"), date("2025-01-21 00:00")}},
+		{"weekend moved to the Friday before", Recurrence{Start: date("2025-01-01 00:00"), Frequency: MONTHLY,
+			MonthDays: []int{-1}, Shift: ShiftBefore}, date("2025-05-01 00:00"),
+			[]time.Time{date("2025-05-30 00:00"), date("2025-06-30 00:00"), date("2025-07-31 00:00"), date("2025-08-29 00:00")}},
+		{"weekend moved to the Monday after", Recurrence{Start: date("2025-01-01 00:00"), Frequency: MONTHLY,
+			MonthDays: []int{1}, Shift: ShiftAfter}, date("2025-05-15 00:00"),
+			[]time.Time{date("2025-06-02 00:00"), date("2025-07-01 00:00")}},
+		{"holiday moved to the business day before", Recurrence{Start: date("2025-01-01 00:00"), Frequency: MONTHLY,
+			MonthDays: []int{25}, Shift: ShiftBefore, Holidays: map[string]bool{"2025-12-25": true, "2025-12-24": true}},
+			date("2025-11-30 00:00"), []time.Time{date("2025-12-23 00:00"), date("2026-01-23 00:00")}},
+		{"holiday after a weekend moved to the day after", Recurrence{Start: date("2025-01-01 00:00"), Frequency: MONTHLY,
+			MonthDays: []int{1}, Shift: ShiftAfter, Holidays: map[string]bool{"2025-06-02": true}},
+			date("2025-05-15 00:00"), []time.Time{date("2025-06-03 00:00"), date("2025-07-01 00:00")}},
+		{"holidays are kept without a shift", Recurrence{Start: date("2025-01-01 00:00"), Frequency: MONTHLY,
+			MonthDays: []int{25}, Holidays: map[string]bool{"2025-12-25": true}},
+			date("2025-11-30 00:00"), []time.Time{date("2025-12-25 00:00")}},
+		{"weekly on a weekday after the start", Recurrence{Start: date("2025-01-01 09:00"), Frequency: WEEKLY,
+			Weekday: "MO"}, date("2025-01-01 00:00"),
+			[]time.Time{date("2025-01-06 09:00"), date("2025-01-13 09:00"), date("2025-01-20 09:00")}},
+		{"every other week on a weekday", Recurrence{Start: date("2025-01-06 00:00"), Frequency: WEEKLY,
+			Interval: 2, Weekday: "FR"}, date("2025-01-06 00:00"), []time.Time{date("2025-01-10 00:00"),
+			date("2025-01-24 00:00")}},
+	}
+
+	for i, tc := range tests {
+		var occurrences []time.Time
+
+		after := tc.after
+
+		for range tc.expected {
+			next, err := tc.recurrence.Next(after)
+			assert.Equalf(t, nil, err, "TEST[%d], failed.\n%s", i, tc.description)
+
+			occurrences = append(occurrences, next)
+			after = next
+		}
+
+		assert.Equalf(t, tc.expected, occurrences, "TEST[%d], failed.\n%s", i, tc.description)
+	}
+}
+
+func Test_RecurrenceInstances(t *testing.T) {
+	date := func(value string) time.Time {
+		d, _ := time.Parse("2006-01-02 15:04", value)
+		return d
+	}
+
+	tests := []struct {
+		description string
+		recurrence  Recurrence
+		until       time.Time
+		expected    []Instance
+	}{
+		{"weekend instances moved to the Monday after", Recurrence{Start: date("2025-03-01 09:00"), Frequency: MONTHLY,
+			Shift: ShiftAfter}, date("2025-06-30 00:00"), []Instance{
+			{Scheduled: date("2025-03-01 09:00"), At: date("2025-03-03 09:00")},
+			{Scheduled: date("2025-04-01 09:00"), At: date("2025-04-01 09:00")},
+			{Scheduled: date("2025-05-01 09:00"), At: date("2025-05-01 09:00")},
+			{Scheduled: date("2025-06-01 09:00"), At: date("2025-06-02 09:00")}}},
+		{"weekend instances merged into the Friday before", Recurrence{Start: date("2025-01-03 00:00"), Frequency: DAILY,
+			Shift: ShiftBefore}, date("2025-01-06 00:00"), []Instance{
+			{Scheduled: date("2025-01-03 00:00"), At: date("2025-01-03 00:00")},
+			{Scheduled: date("2025-01-04 00:00")},
+			{Scheduled: date("2025-01-05 00:00")},
+			{Scheduled: date("2025-01-06 00:00"), At: date("2025-01-06 00:00")}}},
+		{"holiday instance moved to the day after", Recurrence{Start: date("2025-12-01 00:00"), Frequency: MONTHLY,
+			MonthDays: []int{25}, Shift: ShiftAfter, Holidays: map[string]bool{"2025-12-25": true}},
+			date("2026-01-31 00:00"), []Instance{
+				{Scheduled: date("2025-12-25 00:00"), At: date("2025-12-26 00:00")},
+				{Scheduled: date("2026-01-25 00:00"), At: date("2026-01-26 00:00")}}},
+		{"weekly on a weekday after the start", Recurrence{Start: date("2025-01-01 09:00"), Frequency: WEEKLY,
+			Weekday: "MO"}, date("2025-01-14 00:00"), []Instance{
+			{Scheduled: date("2025-01-06 09:00"), At: date("2025-01-06 09:00")},
+			{Scheduled: date("2025-01-13 09:00"), At: date("2025-01-13 09:00")}}},
+		{"until before the start", Recurrence{Start: date("2025-01-01 00:00"), Frequency: DAILY},
+			date("2024-12-31 00:00"), nil},
+	}
+
+	for i, tc := range tests {
+		instances, err := tc.recurrence.Instances(tc.until)
+
+		assert.Equalf(t, nil, err, "TEST[%d], failed.\n%s", i, tc.description)
+		assert.Equalf(t, tc.expected, instances, "TEST[%d], failed.\n%s", i, tc.description)
+	}
+}
+
+func Test_RecurrenceValidate(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		description string
+		recurrence  Recurrence
+		expectedErr error
+	}{
+		{"valid", Recurrence{Start: start, Frequency: YEARLY, Weekday: "MO", WeekdayOrdinal: -1}, nil},
+		{"unsupported frequency", Recurrence{Start: start, Frequency: "HOURLY"}, errors.New("unsupported frequency")},
+		{"custom without days", Recurrence{Start: start, Frequency: CUSTOM}, errors.New("invalid customDays: must be > 0")},
+		{"month days on a weekly schedule", Recurrence{Start: start, Frequency: WEEKLY, MonthDays: []int{1}},
+			errors.New("monthDays need a MONTHLY, QUARTERLY or YEARLY frequency")},
+		{"month days and weekday", Recurrence{Start: start, Frequency: MONTHLY, MonthDays: []int{1}, Weekday: "MO",
+			WeekdayOrdinal: 1}, errors.New("use either monthDays or weekday, not both")},
+		{"month day out of range", Recurrence{Start: start, Frequency: MONTHLY, MonthDays: []int{32}},
+			errors.New("invalid monthDays: use 1 to 31, or -1 to -31 counting back from the end of the month")},
+		{"weekday on a daily schedule", Recurrence{Start: start, Frequency: DAILY, Weekday: "MO"},
+			errors.New("weekday needs a WEEKLY, MONTHLY, QUARTERLY or YEARLY frequency")},
+		{"ordinal weekday on a weekly schedule", Recurrence{Start: start, Frequency: WEEKLY, Weekday: "MO",
+			WeekdayOrdinal: 2}, errors.New("weekdayOrdinal needs a MONTHLY, QUARTERLY or YEARLY frequency")},
+		{"weekday without ordinal", Recurrence{Start: start, Frequency: MONTHLY, Weekday: "MO"},
+			errors.New("invalid weekdayOrdinal: use 1 to 5, or -1 to -5 counting back from the end of the month")},
+		{"invalid shift", Recurrence{Start: start, Frequency: MONTHLY, Shift: "NEAREST"},
+			errors.New("invalid shift: use NONE, BEFORE or AFTER")},
+	}
+
+	for i, tc := range tests {
+		assert.Equalf(t, tc.expectedErr, tc.recurrence.Validate(), "TEST[%d], failed.\n%s", i, tc.description)
+	}
+}
+
+func Test_RRule(t *testing.T) {
+	start := time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		description string
+		recurrence  Recurrence
+		rule        string
+	}{
+		{"daily", Recurrence{Start: start, Frequency: DAILY}, "FREQ=DAILY"},
+		{"custom days", Recurrence{Start: start, Frequency: CUSTOM, Interval: 10}, "FREQ=DAILY;INTERVAL=10"},
+		{"every other week", Recurrence{Start: start, Frequency: WEEKLY, Interval: 2}, "FREQ=WEEKLY;INTERVAL=2"},
+		{"weekly on Monday", Recurrence{Start: start, Frequency: WEEKLY, Weekday: "MO"}, "FREQ=WEEKLY;BYDAY=MO"},
+		{"quarterly", Recurrence{Start: start, Frequency: QUARTERLY, MonthDays: []int{-1}},
+			"FREQ=MONTHLY;INTERVAL=3;BYMONTHDAY=-1"},
+		{"month end", Recurrence{Start: start, Frequency: MONTHLY}, "FREQ=MONTHLY;BYMONTHDAY=28,29,30,31;BYSETPOS=-1"},
+		{"second Tuesday", Recurrence{Start: start, Frequency: MONTHLY, Weekday: "TU", WeekdayOrdinal: 2},
+			"FREQ=MONTHLY;BYDAY=2TU"},
+		{"last Monday of January", Recurrence{Start: start, Frequency: YEARLY, Weekday: "MO", WeekdayOrdinal: -1},
+			"FREQ=YEARLY;BYMONTH=1;BYDAY=-1MO"},
+	}
+
+	for i, tc := range tests {
+		assert.Equalf(t, tc.rule, tc.recurrence.RRule(), "TEST[%d], failed.\n%s", i, tc.description)
+
+		parsed, _, err := ParseRRule(tc.rule, start)
+		assert.Equalf(t, nil, err, "TEST[%d], failed.\n%s", i, tc.description)
+
+		// A parsed rule runs on the same dates, even where it is written differently
+		for after, n := start.AddDate(0, 0, -1), 0; n < 6; n++ {
+			expected, _ := tc.recurrence.Next(after)
+			actual, _ := parsed.Next(after)
+
+			assert.Equalf(t, expected, actual, "TEST[%d], failed.\n%s", i, tc.description)
+
+			after = expected
+		}
+	}
+}
+
+func Test_ParseRRule(t *testing.T) {
+	start := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		description string
+		rule        string
+		expected    *Recurrence
+		until       time.Time
+		expectedErr error
+	}{
+		{"with until", "RRULE:FREQ=MONTHLY;BYMONTHDAY=1,15;UNTIL=20251231",
+			&Recurrence{Start: start, Frequency: MONTHLY, Interval: 1, MonthDays: []int{1, 15}},
+			time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC), nil},
+		{"nth weekday", "FREQ=MONTHLY;INTERVAL=2;BYDAY=-2FR",
+			&Recurrence{Start: start, Frequency: MONTHLY, Interval: 2, Weekday: "FR", WeekdayOrdinal: -2}, time.Time{}, nil},
+		{"plain weekday", "FREQ=WEEKLY;BYDAY=mo",
+			&Recurrence{Start: start, Frequency: WEEKLY, Interval: 1, Weekday: "MO"}, time.Time{}, nil},
+		{"plain weekday of a month", "FREQ=MONTHLY;BYDAY=MO", nil, time.Time{},
+			errors.New("invalid weekdayOrdinal: use 1 to 5, or -1 to -5 counting back from the end of the month")},
+		{"count is not supported", "FREQ=DAILY;COUNT=3", nil, time.Time{}, errors.New(`unsupported RRULE part "COUNT"`)},
+		{"by month of another month", "FREQ=YEARLY;BYMONTH=4;BYMONTHDAY=1", nil, time.Time{},
+			errors.New("RRULE BYMONTH must be the month of the start date")},
+		{"missing frequency", "INTERVAL=2", nil, time.Time{}, errors.New("RRULE FREQ is required")},
+		{"hourly", "FREQ=HOURLY", nil, time.Time{}, errors.New(`unsupported RRULE frequency "HOURLY"`)},
+	}
+
+	for i, tc := range tests {
+		recurrence, until, err := ParseRRule(tc.rule, start)
+
+		assert.Equalf(t, tc.expected, recurrence, "TEST[%d], failed.\n%s", i, tc.description)
+		assert.Equalf(t, tc.until, until, "TEST[%d], failed.\n%s", i, tc.description)
+		assert.Equalf(t, tc.expectedErr, err, "TEST[%d], failed.\n%s", i, tc.description)
+	}
+}
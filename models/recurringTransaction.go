@@ -1,28 +1,351 @@
 package models
 
+import (
+	"errors"
+	"time"
+)
+
+// maxOccurrences bounds the occurrences of a rule listed at once
+const maxOccurrences = 10000
+
 type Frequency string
 
 const (
-	DAILY   Frequency = "DAILY"
-	WEEKLY  Frequency = "WEEKLY"
-	MONTHLY Frequency = "MONTHLY"
-	CUSTOM  Frequency = "CUSTOM"
+	DAILY     Frequency = "DAILY"
+	WEEKLY    Frequency = "WEEKLY"
+	MONTHLY   Frequency = "MONTHLY"
+	QUARTERLY Frequency = "QUARTERLY"
+	YEARLY    Frequency = "YEARLY"
+	CUSTOM    Frequency = "CUSTOM"
 )
 
 type RecurringTransaction struct {
-	ID          int            `json:"id"`
-	UserID      int            `json:"userID"`
-	Account     AccountDetails `json:"account"`
-	Amount      float64        `json:"amount"`
-	Type        Type           `json:"type"`
-	Category    string         `json:"category"`
-	Description string         `json:"description"`
-	Frequency   Frequency      `json:"frequency"`
-	CustomDays  int            `json:"customDays"`
-	StartDate   string         `json:"startDate"`
-	EndDate     string         `json:"endDate"`
-	LastRun     string         `json:"lastRun"`
-	NextRun     string         `json:"nextRun"`
-	CreatedAt   string         `json:"createdAt"`
-	DeletedAt   string         `json:"deletedAt,omitempty"`
+	ID               int                  `json:"id"`
+	UserID           int                  `json:"userID"`
+	Account          AccountDetails       `json:"account"`
+	Amount           Money                `json:"amount"`
+	Type             Type                 `json:"type"`
+	Category         string               `json:"category"`
+	Description      string               `json:"description"`
+	Frequency        Frequency            `json:"frequency"`
+	CustomDays       int                  `json:"customDays"`
+	Interval         int                  `json:"interval,omitempty"`
+	MonthDays        []int                `json:"monthDays,omitempty"`
+	Weekday          string               `json:"weekday,omitempty"`
+	WeekdayOrdinal   int                  `json:"weekdayOrdinal,omitempty"`
+	Shift            Shift                `json:"shift,omitempty"`
+	RRule            string               `json:"rrule,omitempty"`
+	RequiresApproval bool                 `json:"requiresApproval"`
+	StartDate        string               `json:"startDate"`
+	EndDate          string               `json:"endDate"`
+	LastRun          string               `json:"lastRun"`
+	NextRun          string               `json:"nextRun"`
+	PausedAt         string               `json:"pausedAt,omitempty"`
+	PausedUntil      string               `json:"pausedUntil,omitempty"`
+	Exceptions       []RecurringException `json:"exceptions,omitempty"`
+	CreatedAt        string               `json:"createdAt"`
+	DeletedAt        string               `json:"deletedAt,omitempty"`
+
+	// Holidays are the holidays of the user, as YYYY-MM-DD, that the shift moves occurrences off
+	Holidays map[string]bool `json:"-"`
+}
+
+// RecurringException changes a single Occurrence of a recurring transaction, the time it is scheduled at: it is
+// skipped, or posted with another amount or at another date, without changing the rule.
+type RecurringException struct {
+	ID                     int    `json:"id"`
+	RecurringTransactionID int    `json:"recurringTransactionID"`
+	UserID                 int    `json:"userID"`
+	Occurrence             string `json:"occurrence"`
+	Skip                   bool   `json:"skip"`
+	Amount                 *Money `json:"amount,omitempty"`
+	Date                   string `json:"date,omitempty"`
+	CreatedAt              string `json:"createdAt"`
+}
+
+// RecurringConfirmation settles the pending Occurrence of a recurring transaction that requires approval, the time
+// the rule schedules it at. A confirmed occurrence is posted with Amount and at Date when they are given.
+type RecurringConfirmation struct {
+	RecurringTransactionID int    `json:"recurringTransactionID"`
+	Occurrence             string `json:"occurrence"`
+	Amount                 *Money `json:"amount,omitempty"`
+	Date                   string `json:"date,omitempty"`
+}
+
+// RecurringPause pauses a recurring transaction until a date, or until it is resumed when Until is empty.
+type RecurringPause struct {
+	Until string `json:"until"`
+}
+
+// Schedule returns the recurrence of the rule, anchored on its start date, or on its next run when it has none.
+func (r *RecurringTransaction) Schedule() (*Recurrence, error) {
+	anchor := r.StartDate
+	if anchor == "" {
+		anchor = r.NextRun
+	}
+
+	start, err := time.Parse(time.RFC3339, anchor)
+	if err != nil {
+		return nil, errors.New("invalid startDate")
+	}
+
+	recurrence := &Recurrence{Start: start, Frequency: r.Frequency, Interval: r.Interval, MonthDays: r.MonthDays,
+		Weekday: r.Weekday, WeekdayOrdinal: r.WeekdayOrdinal, Shift: r.Shift, Holidays: r.Holidays}
+
+	if r.Frequency == CUSTOM {
+		recurrence.Interval = r.CustomDays
+	}
+
+	err = recurrence.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return recurrence, nil
+}
+
+// ShiftPolicy is how the rule moves occurrences off weekends and holidays, not at all unless it says otherwise.
+func (r *RecurringTransaction) ShiftPolicy() Shift {
+	if r.Shift == "" {
+		return ShiftNone
+	}
+
+	return r.Shift
+}
+
+// ApplyRRule replaces the schedule of the rule with its RRule, read from its start date, and takes the end date from
+// its UNTIL when it has one.
+func (r *RecurringTransaction) ApplyRRule() error {
+	start, err := time.Parse(time.RFC3339, r.StartDate)
+	if err != nil {
+		return errors.New("invalid startDate")
+	}
+
+	recurrence, until, err := ParseRRule(r.RRule, start)
+	if err != nil {
+		return err
+	}
+
+	r.Frequency, r.Interval, r.CustomDays = recurrence.Frequency, recurrence.Interval, 0
+	r.MonthDays, r.Weekday, r.WeekdayOrdinal = recurrence.MonthDays, recurrence.Weekday, recurrence.WeekdayOrdinal
+
+	if !until.IsZero() {
+		r.EndDate = until.Format("2006-01-02T15:04:05.000Z")
+	}
+
+	return nil
+}
+
+// ScheduleRRule is the RFC 5545 recurrence rule of the rule, ending on its end date when it has one.
+func (r *RecurringTransaction) ScheduleRRule() (string, error) {
+	recurrence, err := r.Schedule()
+	if err != nil {
+		return "", err
+	}
+
+	rule := recurrence.RRule()
+
+	if r.EndDate != "" {
+		endDate, err := time.Parse(time.RFC3339, r.EndDate)
+		if err != nil {
+			return "", err
+		}
+
+		rule += ";UNTIL=" + endDate.UTC().Format("20060102T150405Z")
+	}
+
+	return rule, nil
+}
+
+// RecurringOccurrence is a single time a recurring transaction is to post at.
+type RecurringOccurrence struct {
+	RecurringTransactionID int            `json:"recurringTransactionID"`
+	Date                   string         `json:"date"`
+	Account                AccountDetails `json:"account"`
+	Amount                 Money          `json:"amount"`
+	Type                   Type           `json:"type"`
+	Category               string         `json:"category"`
+	Description            string         `json:"description"`
+	Scheduled              string         `json:"scheduled,omitempty"`
+	Overridden             bool           `json:"overridden,omitempty"`
+}
+
+// RecurringCalendar lays out the occurrences of all the recurring transactions of a user from From to To by day.
+type RecurringCalendar struct {
+	From string                 `json:"from"`
+	To   string                 `json:"to"`
+	Days []RecurringCalendarDay `json:"days"`
+}
+
+type RecurringCalendarDay struct {
+	Date        string                `json:"date"`
+	Occurrences []RecurringOccurrence `json:"occurrences"`
+}
+
+// Occurrences returns what the rule is still to post, from its next run up to until, both included, and not past its
+// end date. Skipped and paused occurrences are left out and overridden ones carry their own amount and date. A rule
+// without a next run has finished.
+func (r *RecurringTransaction) Occurrences(until time.Time) ([]RecurringOccurrence, error) {
+	return r.occurrences(until, maxOccurrences)
+}
+
+// Upcoming returns the next count occurrences the rule is to post, fewer when it ends before.
+func (r *RecurringTransaction) Upcoming(count int) ([]RecurringOccurrence, error) {
+	return r.occurrences(time.Time{}, count)
+}
+
+// Occurrence describes the posting of the rule scheduled at a time, with its exception applied, and reports whether
+// it is posted at all. An occurrence scheduled before one of the holidays was added is moved off it as well.
+func (r *RecurringTransaction) Occurrence(scheduled time.Time) (RecurringOccurrence, bool) {
+	occurrence := RecurringOccurrence{
+		RecurringTransactionID: r.ID,
+		Date:                   scheduled.Format("2006-01-02T15:04:05.000Z"),
+		Account:                r.Account,
+		Amount:                 r.Amount,
+		Type:                   r.Type,
+		Category:               r.Category,
+		Description:            r.Description,
+	}
+
+	if r.PausedOn(scheduled) {
+		return occurrence, false
+	}
+
+	if recurrence, err := r.Schedule(); err == nil {
+		if at := recurrence.shift(scheduled); !at.Equal(scheduled) {
+			occurrence.Scheduled, occurrence.Date = occurrence.Date, at.Format("2006-01-02T15:04:05.000Z")
+		}
+	}
+
+	exception := r.Exception(scheduled)
+	if exception == nil {
+		return occurrence, true
+	}
+
+	if exception.Skip {
+		return occurrence, false
+	}
+
+	occurrence.Overridden = true
+
+	if exception.Amount != nil {
+		occurrence.Amount = *exception.Amount
+	}
+
+	if exception.Date != "" {
+		occurrence.Scheduled, occurrence.Date = scheduled.Format("2006-01-02T15:04:05.000Z"), exception.Date
+	}
+
+	return occurrence, true
+}
+
+// Exception returns the exception of the occurrence scheduled at a time, nil when it has none.
+func (r *RecurringTransaction) Exception(scheduled time.Time) *RecurringException {
+	for i := range r.Exceptions {
+		occurrence, err := time.Parse(time.RFC3339, r.Exceptions[i].Occurrence)
+		if err == nil && occurrence.Equal(scheduled) {
+			return &r.Exceptions[i]
+		}
+	}
+
+	return nil
+}
+
+// PausedOn reports whether the rule is paused at a time: from when it was paused until its pausedUntil date, or
+// until it is resumed when it has none.
+func (r *RecurringTransaction) PausedOn(at time.Time) bool {
+	if r.PausedAt == "" {
+		return false
+	}
+
+	pausedAt, err := time.Parse(time.RFC3339, r.PausedAt)
+	if err != nil || at.Before(pausedAt) {
+		return false
+	}
+
+	if r.PausedUntil == "" {
+		return true
+	}
+
+	pausedUntil, err := time.Parse("2006-01-02", r.PausedUntil[:min(len(r.PausedUntil), len("2006-01-02"))])
+
+	return err == nil && at.Before(pausedUntil)
+}
+
+// Around returns the occurrences scheduled just before and just after a time the rule is scheduled at, the one before
+// being zero for the first occurrence. It fails when the rule is not scheduled at that time.
+func (r *RecurringTransaction) Around(scheduled time.Time) (time.Time, time.Time, error) {
+	recurrence, err := r.Schedule()
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	var previous time.Time
+
+	at, err := recurrence.Next(recurrence.Start.Add(-time.Nanosecond))
+
+	for n := 0; err == nil && at.Before(scheduled) && n < maxOccurrences; n++ {
+		previous = at
+		at, err = recurrence.Next(at)
+	}
+
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	if !at.Equal(scheduled) {
+		return time.Time{}, time.Time{}, errors.New("the recurring transaction is not scheduled at that time")
+	}
+
+	next, err := recurrence.Next(scheduled)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	return previous, next, nil
+}
+
+// occurrences lists at most limit occurrences to post from the next run up to until, or up to the end date alone
+// when until is zero.
+func (r *RecurringTransaction) occurrences(until time.Time, limit int) ([]RecurringOccurrence, error) {
+	if r.NextRun == "" {
+		return nil, nil
+	}
+
+	next, err := time.Parse(time.RFC3339, r.NextRun)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.EndDate != "" {
+		endDate, err := time.Parse(time.RFC3339, r.EndDate)
+		if err != nil {
+			return nil, err
+		}
+
+		if until.IsZero() || endDate.Before(until) {
+			until = endDate
+		}
+	}
+
+	recurrence, err := r.Schedule()
+	if err != nil {
+		return nil, err
+	}
+
+	var occurrences []RecurringOccurrence
+
+	// Skipped and paused occurrences count towards the bound too, so that a rule paused for good still ends the list
+	for n := 0; (until.IsZero() || !next.After(until)) && len(occurrences) < limit && n < maxOccurrences; n++ {
+		if occurrence, posted := r.Occurrence(next); posted {
+			occurrences = append(occurrences, occurrence)
+		}
+
+		next, err = recurrence.Following(next)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return occurrences, nil
 }
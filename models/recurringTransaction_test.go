@@ -0,0 +1,149 @@
+package models
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Occurrences(t *testing.T) {
+	until := time.Date(2025, 3, 31, 23, 59, 59, 0, time.UTC)
+	amount := NewMoney(75)
+
+	tests := []struct {
+		description string
+		rule        RecurringTransaction
+		expected    []string
+		expectedErr error
+	}{
+		{"monthly up to until", RecurringTransaction{Frequency: MONTHLY, NextRun: "2025-01-15T09:00:00.000Z"},
+			[]string{"2025-01-15T09:00:00.000Z", "2025-02-15T09:00:00.000Z", "2025-03-15T09:00:00.000Z"}, nil},
+		{"stops at the end date", RecurringTransaction{Frequency: CUSTOM, CustomDays: 10,
+			NextRun: "2025-03-01T00:00:00.000Z", EndDate: "2025-03-15T00:00:00.000Z"},
+			[]string{"2025-03-01T00:00:00.000Z", "2025-03-11T00:00:00.000Z"}, nil},
+		{"skipped and moved occurrences", RecurringTransaction{Frequency: MONTHLY, NextRun: "2025-01-15T09:00:00.000Z",
+			Exceptions: []RecurringException{{Occurrence: "2025-02-15T09:00:00.000Z", Skip: true},
+				{Occurrence: "2025-03-15T09:00:00.000Z", Amount: &amount, Date: "2025-03-20T09:00:00.000Z"}}},
+			[]string{"2025-01-15T09:00:00.000Z", "2025-03-20T09:00:00.000Z"}, nil},
+		{"paused until a date", RecurringTransaction{Frequency: MONTHLY, NextRun: "2025-01-15T09:00:00.000Z",
+			PausedAt: "2025-01-01T00:00:00.000Z", PausedUntil: "2025-03-01"},
+			[]string{"2025-03-15T09:00:00.000Z"}, nil},
+		{"paused until resumed", RecurringTransaction{Frequency: MONTHLY, NextRun: "2025-01-15T09:00:00.000Z",
+			PausedAt: "2025-02-01T00:00:00.000Z"}, []string{"2025-01-15T09:00:00.000Z"}, nil},
+		{"next run on a holiday added since is moved off it", RecurringTransaction{Frequency: MONTHLY, Shift: ShiftAfter,
+			NextRun: "2025-01-15T09:00:00.000Z", Holidays: map[string]bool{"2025-01-15": true, "2025-03-14": true}},
+			[]string{"2025-01-16T09:00:00.000Z", "2025-02-17T09:00:00.000Z", "2025-03-17T09:00:00.000Z"}, nil},
+		{"finished rule", RecurringTransaction{Frequency: DAILY}, nil, nil},
+		{"custom without days", RecurringTransaction{Frequency: CUSTOM, NextRun: "2025-03-01T00:00:00.000Z"}, nil,
+			errors.New("invalid customDays: must be > 0")},
+	}
+
+	for i, tc := range tests {
+		occurrences, err := tc.rule.Occurrences(until)
+
+		assert.Equalf(t, tc.expected, occurrenceDates(occurrences), "TEST[%d], failed.\n%s", i, tc.description)
+		assert.Equalf(t, tc.expectedErr, err, "TEST[%d], failed.\n%s", i, tc.description)
+	}
+}
+
+func Test_Upcoming(t *testing.T) {
+	tests := []struct {
+		description string
+		rule        RecurringTransaction
+		count       int
+		expected    []string
+	}{
+		{"next count occurrences", RecurringTransaction{Frequency: WEEKLY, NextRun: "2025-01-01T00:00:00.000Z"}, 3,
+			[]string{"2025-01-01T00:00:00.000Z", "2025-01-08T00:00:00.000Z", "2025-01-15T00:00:00.000Z"}},
+		{"fewer when it ends first", RecurringTransaction{Frequency: WEEKLY, NextRun: "2025-01-01T00:00:00.000Z",
+			EndDate: "2025-01-10T00:00:00.000Z"}, 5, []string{"2025-01-01T00:00:00.000Z", "2025-01-08T00:00:00.000Z"}},
+		{"skipped occurrences are not counted", RecurringTransaction{Frequency: WEEKLY, NextRun: "2025-01-01T00:00:00.000Z",
+			Exceptions: []RecurringException{{Occurrence: "2025-01-08T00:00:00.000Z", Skip: true}}}, 2,
+			[]string{"2025-01-01T00:00:00.000Z", "2025-01-15T00:00:00.000Z"}},
+	}
+
+	for i, tc := range tests {
+		occurrences, err := tc.rule.Upcoming(tc.count)
+
+		assert.Equalf(t, tc.expected, occurrenceDates(occurrences), "TEST[%d], failed.\n%s", i, tc.description)
+		assert.Equalf(t, nil, err, "TEST[%d], failed.\n%s", i, tc.description)
+	}
+}
+
+func Test_Occurrence(t *testing.T) {
+	amount := NewMoney(75)
+	scheduled := time.Date(2025, 2, 15, 9, 0, 0, 0, time.UTC)
+	rule := RecurringTransaction{ID: 4, Amount: NewMoney(50), Type: EXPENSE, Category: "Rent"}
+
+	tests := []struct {
+		description string
+		exceptions  []RecurringException
+		pausedAt    string
+		expected    RecurringOccurrence
+		posted      bool
+	}{
+		{"as scheduled", nil, "", RecurringOccurrence{RecurringTransactionID: 4, Date: "2025-02-15T09:00:00.000Z",
+			Amount: NewMoney(50), Type: EXPENSE, Category: "Rent"}, true},
+		{"exception of another occurrence", []RecurringException{{Occurrence: "2025-03-15T09:00:00.000Z", Skip: true}}, "",
+			RecurringOccurrence{RecurringTransactionID: 4, Date: "2025-02-15T09:00:00.000Z", Amount: NewMoney(50),
+				Type: EXPENSE, Category: "Rent"}, true},
+		{"overridden amount and date", []RecurringException{{Occurrence: "2025-02-15T09:00:00.000Z", Amount: &amount,
+			Date: "2025-02-17T09:00:00.000Z"}}, "", RecurringOccurrence{RecurringTransactionID: 4,
+			Date: "2025-02-17T09:00:00.000Z", Amount: NewMoney(75), Type: EXPENSE, Category: "Rent",
+			Scheduled: "2025-02-15T09:00:00.000Z", Overridden: true}, true},
+		{"skipped", []RecurringException{{Occurrence: "2025-02-15T09:00:00.000Z", Skip: true}}, "",
+			RecurringOccurrence{RecurringTransactionID: 4, Date: "2025-02-15T09:00:00.000Z", Amount: NewMoney(50),
+				Type: EXPENSE, Category: "Rent"}, false},
+		{"paused", nil, "2025-02-01T00:00:00.000Z", RecurringOccurrence{RecurringTransactionID: 4,
+			Date: "2025-02-15T09:00:00.000Z", Amount: NewMoney(50), Type: EXPENSE, Category: "Rent"}, false},
+	}
+
+	for i, tc := range tests {
+		rule.Exceptions, rule.PausedAt = tc.exceptions, tc.pausedAt
+
+		occurrence, posted := rule.Occurrence(scheduled)
+
+		assert.Equalf(t, tc.expected, occurrence, "TEST[%d], failed.\n%s", i, tc.description)
+		assert.Equalf(t, tc.posted, posted, "TEST[%d], failed.\n%s", i, tc.description)
+	}
+}
+
+func Test_Around(t *testing.T) {
+	rule := RecurringTransaction{Frequency: MONTHLY, StartDate: "2025-01-31T00:00:00.000Z",
+		NextRun: "2025-01-31T00:00:00.000Z"}
+
+	tests := []struct {
+		description string
+		scheduled   time.Time
+		previous    time.Time
+		next        time.Time
+		expectedErr error
+	}{
+		{"first occurrence", time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC), time.Time{},
+			time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC), nil},
+		{"later occurrence", time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC), time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC),
+			time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC), nil},
+		{"not scheduled", time.Date(2025, 2, 27, 0, 0, 0, 0, time.UTC), time.Time{}, time.Time{},
+			errors.New("the recurring transaction is not scheduled at that time")},
+	}
+
+	for i, tc := range tests {
+		previous, next, err := rule.Around(tc.scheduled)
+
+		assert.Equalf(t, tc.previous, previous, "TEST[%d], failed.\n%s", i, tc.description)
+		assert.Equalf(t, tc.next, next, "TEST[%d], failed.\n%s", i, tc.description)
+		assert.Equalf(t, tc.expectedErr, err, "TEST[%d], failed.\n%s", i, tc.description)
+	}
+}
+
+func occurrenceDates(occurrences []RecurringOccurrence) []string {
+	var dates []string
+
+	for _, occurrence := range occurrences {
+		dates = append(dates, occurrence.Date)
+	}
+
+	return dates
+}
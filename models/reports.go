@@ -0,0 +1,45 @@
+package models
+
+// BucketTotal adds up the transactions of one type and category, in one currency, dated in the interval starting on
+// Bucket.
+type BucketTotal struct {
+	Bucket   string `json:"bucket"`
+	Type     Type   `json:"type"`
+	Category string `json:"category"`
+	Currency string `json:"currency"`
+	Amount   Money  `json:"amount"`
+}
+
+// TrendReport is the income, expense and savings of every day, week, month or year from From to To in the home
+// currency. Series holds the amounts of each category per bucket, for stacked charts.
+type TrendReport struct {
+	Currency string        `json:"currency"`
+	Interval string        `json:"interval"`
+	From     string        `json:"from"`
+	To       string        `json:"to"`
+	Window   int           `json:"window"`
+	Buckets  []TrendBucket `json:"buckets"`
+	Series   []TrendSeries `json:"series"`
+}
+
+// TrendBucket is one interval of a trend report. SavingsRate is the percentage of all the income up to the end of the
+// bucket that was not spent, and the averages are over the last Window buckets up to this one.
+type TrendBucket struct {
+	Start          string  `json:"start"`
+	End            string  `json:"end"`
+	Income         Money   `json:"income"`
+	Expense        Money   `json:"expense"`
+	Savings        Money   `json:"savings"`
+	SavingsRate    float64 `json:"savingsRate"`
+	IncomeAverage  Money   `json:"incomeAverage"`
+	ExpenseAverage Money   `json:"expenseAverage"`
+	SavingsAverage Money   `json:"savingsAverage"`
+}
+
+// TrendSeries is the amount of a category in each bucket of a trend report, in the order of the buckets.
+type TrendSeries struct {
+	Type     Type    `json:"type"`
+	Category string  `json:"category"`
+	Total    Money   `json:"total"`
+	Values   []Money `json:"values"`
+}
@@ -1,15 +1,37 @@
 package models
 
+// Savings.Currency is the currency of the account its transaction was paid from. It is only filled in where savings
+// are valued together with accounts.
 type Savings struct {
-	ID            int     `json:"id"`
-	UserID        int     `json:"userID"`
-	TransactionID int     `json:"transactionID"`
-	Amount        float64 `json:"amount"`
-	Type          string  `json:"type"`
-	Category      string  `json:"category"`
-	CurrentValue  float64 `json:"currentValue"`
-	StartDate     string  `json:"startDate"`
-	MaturityDate  string  `json:"maturityDate,omitempty"`
-	CreatedAt     string  `json:"createdAt"`
-	DeletedAt     string  `json:"deletedAt,omitempty"`
+	ID            int    `json:"id"`
+	UserID        int    `json:"userID"`
+	TransactionID int    `json:"transactionID"`
+	Amount        Money  `json:"amount"`
+	Type          string `json:"type"`
+	Category      string `json:"category"`
+	CurrentValue  Money  `json:"currentValue"`
+	Currency      string `json:"currency,omitempty"`
+	StartDate     string `json:"startDate"`
+	MaturityDate  string `json:"maturityDate,omitempty"`
+	CreatedAt     string `json:"createdAt"`
+	DeletedAt     string `json:"deletedAt,omitempty"`
+}
+
+// Value is what the savings are worth now, the amount put in until a current value is entered.
+func (s *Savings) Value() Money {
+	if s.CurrentValue != 0 {
+		return s.CurrentValue
+	}
+
+	return s.Amount
+}
+
+// SavingsValuation is the value of savings from ValuedOn until their next valuation.
+type SavingsValuation struct {
+	ID        int    `json:"id"`
+	SavingsID int    `json:"savingsID"`
+	UserID    int    `json:"userID"`
+	Value     Money  `json:"value"`
+	ValuedOn  string `json:"valuedOn"`
+	CreatedAt string `json:"createdAt"`
 }
@@ -0,0 +1,11 @@
+package models
+
+type StatementDraft struct {
+	Layout       string         `json:"layout"`
+	Transactions []*Transaction `json:"transactions"`
+}
+
+type StatementConfirmation struct {
+	AccountID    int            `json:"accountID"`
+	Transactions []*Transaction `json:"transactions"`
+}
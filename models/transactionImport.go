@@ -0,0 +1,33 @@
+package models
+
+// ImportColumnMapping maps transaction fields to CSV header names. Empty fields fall back to the
+// lower-case field name, e.g. a "date" header for Date.
+type ImportColumnMapping struct {
+	Date        string `json:"date"`
+	Amount      string `json:"amount"`
+	Type        string `json:"type"`
+	Category    string `json:"category"`
+	Description string `json:"description"`
+	Account     string `json:"account"`
+	DateFormat  string `json:"dateFormat"`
+}
+
+type ImportRowError struct {
+	Row   int    `json:"row"`
+	Error string `json:"error"`
+}
+
+type ImportAccountSummary struct {
+	Account         AccountDetails `json:"account"`
+	PreviousBalance Money          `json:"previousBalance"`
+	NewBalance      Money          `json:"newBalance"`
+}
+
+type ImportResult struct {
+	DryRun       bool                   `json:"dryRun"`
+	Committed    bool                   `json:"committed"`
+	TotalRows    int                    `json:"totalRows"`
+	Transactions []*Transaction         `json:"transactions"`
+	Accounts     []ImportAccountSummary `json:"accounts"`
+	Errors       []ImportRowError       `json:"errors"`
+}
@@ -0,0 +1,9 @@
+package models
+
+type TransactionSplit struct {
+	ID            int    `json:"id"`
+	TransactionID int    `json:"transactionID"`
+	Category      string `json:"category"`
+	Amount        Money  `json:"amount"`
+	Note          string `json:"note"`
+}
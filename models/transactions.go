@@ -11,8 +11,22 @@ const (
 	INCOME  Type = "INCOME"
 	EXPENSE Type = "EXPENSE"
 	SAVINGS Type = "SAVINGS"
+	// TRANSFER moves money from Account to ToAccount and is neither income nor expense
+	TRANSFER Type = "TRANSFER"
 )
 
+// TransactionStatus tracks a transaction against the statements of its bank. A CLEARED transaction is matched on a
+// statement being reconciled, a RECONCILED one is part of a locked reconciliation. Only UNCLEARED transactions can be
+// changed freely, the others keep their effect on the balance.
+type TransactionStatus string
+
+const (
+	UNCLEARED  TransactionStatus = "UNCLEARED"
+	CLEARED    TransactionStatus = "CLEARED"
+	RECONCILED TransactionStatus = "RECONCILED"
+)
+
+// ExpenseCategories, SavingsCategories and IncomeCategories are the defaults every user starts with
 var ExpenseCategories = map[string]struct{}{
 	"Housing": {}, "Utilities": {}, "Groceries": {}, "Transportation": {}, "Education": {},
 	"Healthcare": {}, "Loan & Debt Payments": {}, "Dining Out": {}, "Entertainment": {},
@@ -24,17 +38,27 @@ var SavingsCategories = map[string]struct{}{
 	"FD": {}, "Mutual Funds": {}, "Stocks": {}, "Gold ETFs": {}, "Other": {},
 }
 
+// Transaction.Amount is in Currency, the currency of Account. A TRANSFER between accounts of different currencies
+// also records ToAmount, what it credits to ToAccount in ToCurrency. Each account of a TRANSFER clears and reconciles
+// it on its own statement, so Status is that of its Account leg and ToStatus that of its ToAccount leg.
 type Transaction struct {
-	ID              int            `json:"id"`
-	UserID          int            `json:"userID"`
-	Account         AccountDetails `json:"account"`
-	Amount          float64        `json:"amount"`
-	Type            Type           `json:"type"`
-	Category        string         `json:"category"`
-	Description     string         `json:"description"`
-	TransactionDate string         `json:"transactionDate"`
-	CreatedAt       string         `json:"createdAt"`
-	DeletedAt       string         `json:"deletedAt,omitempty"`
+	ID              int                `json:"id"`
+	UserID          int                `json:"userID"`
+	Account         AccountDetails     `json:"account"`
+	ToAccount       *AccountDetails    `json:"toAccount,omitempty"`
+	Amount          Money              `json:"amount"`
+	Currency        string             `json:"currency"`
+	ToAmount        *Money             `json:"toAmount,omitempty"`
+	ToCurrency      string             `json:"toCurrency,omitempty"`
+	Type            Type               `json:"type"`
+	Category        string             `json:"category"`
+	Description     string             `json:"description"`
+	TransactionDate string             `json:"transactionDate"`
+	Splits          []TransactionSplit `json:"splits,omitempty"`
+	Status          TransactionStatus  `json:"status"`
+	ToStatus        TransactionStatus  `json:"toStatus,omitempty"`
+	CreatedAt       string             `json:"createdAt"`
+	DeletedAt       string             `json:"deletedAt,omitempty"`
 }
 
 type AccountDetails struct {
@@ -42,12 +66,69 @@ type AccountDetails struct {
 	Name string `json:"name"`
 }
 
+// BalanceEffects returns the signed change a transaction makes to the balance of each account it touches.
+func (t *Transaction) BalanceEffects() map[int]Money {
+	effects := make(map[int]Money)
+
+	switch t.Type {
+	case INCOME:
+		effects[t.Account.ID] += t.Amount
+	case EXPENSE, SAVINGS:
+		effects[t.Account.ID] -= t.Amount
+	case TRANSFER:
+		effects[t.Account.ID] -= t.Amount
+
+		// Between accounts of different currencies the destination is credited ToAmount in its own currency
+		credit := t.Amount
+		if t.ToAmount != nil {
+			credit = *t.ToAmount
+		}
+
+		if t.ToAccount != nil {
+			effects[t.ToAccount.ID] += credit
+		}
+	}
+
+	return effects
+}
+
+// StatusOn returns the status of the transaction on one of its accounts, that of its leg on it for a TRANSFER.
+func (t *Transaction) StatusOn(accountID int) TransactionStatus {
+	if t.Type == TRANSFER && t.ToAccount != nil && t.ToAccount.ID == accountID {
+		return t.ToStatus
+	}
+
+	return t.Status
+}
+
+// SettledStatus returns the furthest status any leg of the transaction has reached, which limits how it can change.
+func (t *Transaction) SettledStatus() TransactionStatus {
+	switch {
+	case t.Status == RECONCILED || t.ToStatus == RECONCILED:
+		return RECONCILED
+	case t.Status == CLEARED || t.ToStatus == CLEARED:
+		return CLEARED
+	default:
+		return t.Status
+	}
+}
+
 // Validate checks if the transaction fields are valid
 func (t *Transaction) Validate() error {
-	// Validate category for EXPENSES type
-	if t.Type == EXPENSE {
-		if _, exists := ExpenseCategories[t.Category]; !exists {
-			return errors.New("invalid category for EXPENSES type")
+	// Categories are per user and are validated against the categories table by the transaction service
+	err := t.ValidateSplits()
+	if err != nil {
+		return err
+	}
+
+	// Validate destination account for TRANSFER type
+	if t.Type == TRANSFER {
+		if t.ToAccount == nil || t.ToAccount.ID == 0 {
+			return errors.New("destination account is required for TRANSFER type")
+		}
+
+		if t.ToAccount.ID == t.Account.ID {
+			return errors.New("source and destination accounts must be different")
 		}
 	}
 
@@ -61,3 +142,30 @@ func (t *Transaction) Validate() error {
 
 	return nil
 }
+
+// ValidateSplits checks that the splits of an INCOME or EXPENSE transaction add up to its amount
+func (t *Transaction) ValidateSplits() error {
+	if len(t.Splits) == 0 {
+		return nil
+	}
+
+	if t.Type != INCOME && t.Type != EXPENSE {
+		return errors.New("splits are only allowed for INCOME and EXPENSE types")
+	}
+
+	var total Money
+
+	for _, split := range t.Splits {
+		if split.Amount <= 0 {
+			return errors.New("split amount must be positive")
+		}
+
+		total += split.Amount
+	}
+
+	if total != t.Amount {
+		return errors.New("sum of splits must equal the transaction amount")
+	}
+
+	return nil
+}
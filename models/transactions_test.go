@@ -0,0 +1,33 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_TransactionStatus(t *testing.T) {
+	transfer := func(status, toStatus TransactionStatus) *Transaction {
+		return &Transaction{Account: AccountDetails{ID: 1}, ToAccount: &AccountDetails{ID: 2}, Type: TRANSFER,
+			Status: status, ToStatus: toStatus}
+	}
+
+	tests := []struct {
+		description     string
+		transaction     *Transaction
+		accountID       int
+		expectedOn      TransactionStatus
+		expectedSettled TransactionStatus
+	}{
+		{"expense", &Transaction{Account: AccountDetails{ID: 1}, Type: EXPENSE, Status: CLEARED}, 1, CLEARED, CLEARED},
+		{"transfer on its source account", transfer(CLEARED, UNCLEARED), 1, CLEARED, CLEARED},
+		{"transfer on its destination account", transfer(CLEARED, UNCLEARED), 2, UNCLEARED, CLEARED},
+		{"transfer reconciled on its destination account", transfer(CLEARED, RECONCILED), 2, RECONCILED, RECONCILED},
+		{"uncleared transfer", transfer(UNCLEARED, UNCLEARED), 2, UNCLEARED, UNCLEARED},
+	}
+
+	for i, tc := range tests {
+		assert.Equalf(t, tc.expectedOn, tc.transaction.StatusOn(tc.accountID), "TEST[%d], failed.\n%s", i, tc.description)
+		assert.Equalf(t, tc.expectedSettled, tc.transaction.SettledStatus(), "TEST[%d], failed.\n%s", i, tc.description)
+	}
+}
@@ -1,11 +1,20 @@
 package models
 
+// Roles a user can have, ADMIN being given in the database only
+const (
+	RoleAdmin = "ADMIN"
+	RoleUser  = "USER"
+)
+
+// User.HomeCurrency is the currency dashboards and cross-account totals are converted to.
 type User struct {
-	ID        int    `json:"id"`
-	FirstName string `json:"firstName"`
-	LastName  string `json:"lastName"`
-	Email     string `json:"email"`
-	Status    string `json:"status"`
-	CreatedAt string `json:"createdAt"`
-	DeletedAt string `json:"deletedAt,omitempty"`
+	ID           int    `json:"id"`
+	FirstName    string `json:"firstName"`
+	LastName     string `json:"lastName"`
+	Email        string `json:"email"`
+	HomeCurrency string `json:"homeCurrency"`
+	Role         string `json:"role,omitempty"`
+	Status       string `json:"status"`
+	CreatedAt    string `json:"createdAt"`
+	DeletedAt    string `json:"deletedAt,omitempty"`
 }
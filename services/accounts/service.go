@@ -8,16 +8,26 @@ import (
 	"moneyManagement/models"
 	"moneyManagement/services"
 	"moneyManagement/stores"
+	"strings"
+	"time"
 )
 
+// maxBalancePoints limits the length of a balance history
+const maxBalancePoints = 1000
+
 type accountSvc struct {
 	accountStore stores.Account
+	ledgerStore  stores.BalanceLedger
+	repairStore  stores.BalanceRepairs
 	userSvc      services.User
 }
 
-func New(accountStore stores.Account, userSvc services.User) services.Account {
+func New(accountStore stores.Account, ledgerStore stores.BalanceLedger, repairStore stores.BalanceRepairs,
+	userSvc services.User) services.Account {
 	return &accountSvc{
 		accountStore: accountStore,
+		ledgerStore:  ledgerStore,
+		repairStore:  repairStore,
 		userSvc:      userSvc,
 	}
 }
@@ -33,6 +43,47 @@ func (s *accountSvc) Create(ctx *gofr.Context, account *models.Account) (*models
 	account.Status = "ACTIVE"
 	account.UserID = userID
 
+	// A new account has no transactions yet, so its balance is the opening balance; accounts created with only a
+	// balance open with it today
+	if account.OpeningBalance == 0 {
+		account.OpeningBalance = account.Balance
+	}
+
+	account.Balance = account.OpeningBalance
+
+	if account.OpeningDate == "" {
+		account.OpeningDate = time.Now().UTC().Format("2006-01-02")
+	}
+
+	if _, err := time.Parse("2006-01-02", account.OpeningDate); err != nil {
+		return nil, errors.New("invalid opening date format, use YYYY-MM-DD")
+	}
+
+	err := validateCreditCard(account)
+	if err != nil {
+		return nil, err
+	}
+
+	// Accounts are in the home currency of the user unless another currency is given
+	if account.Currency == "" {
+		user, err := s.userSvc.GetByID(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+
+		account.Currency = models.DefaultCurrency
+		if user != nil {
+			account.Currency = user.HomeCurrency
+		}
+	}
+
+	currency, err := models.NormalizeCurrency(account.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	account.Currency = currency
+
 	id, err := s.accountStore.Create(ctx, account)
 	if err != nil {
 		return nil, err
@@ -94,11 +145,60 @@ func (s *accountSvc) Update(ctx *gofr.Context, account *models.Account) (*models
 
 	account.UserID = userID
 
+	existing, err := s.accountStore.GetByIDForUpdate(ctx, account.ID, userID, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The currency of an account is fixed, its balance and transactions are recorded in it
+	if existing != nil && account.Currency != "" && !strings.EqualFold(existing.Currency, account.Currency) {
+		return nil, errors.New("account currency cannot be changed")
+	}
+
+	var adjustment models.Money
+
+	if existing != nil {
+		adjustment = account.Balance - existing.Balance
+
+		// The opening balance only changes when sent with its date. The balance moves with it, as every balance of the
+		// account starts from it, so the change is not an adjustment
+		if account.OpeningDate == "" {
+			account.OpeningBalance, account.OpeningDate = existing.OpeningBalance, existing.OpeningDate
+		} else if _, err = time.Parse("2006-01-02", account.OpeningDate); err != nil {
+			return nil, errors.New("invalid opening date format, use YYYY-MM-DD")
+		}
+
+		account.Balance += account.OpeningBalance - existing.OpeningBalance
+
+		// Credit card terms are kept unless new ones are sent
+		if account.CreditCard == nil && account.Type == models.CreditCardAccount {
+			account.CreditCard = existing.CreditCard
+		}
+	}
+
+	err = validateCreditCard(account)
+	if err != nil {
+		return nil, err
+	}
+
 	err = s.accountStore.Update(ctx, account, tx)
 	if err != nil {
 		return nil, err
 	}
 
+	// A balance edited by hand is recorded as an adjustment effective now, so the history before it is kept
+	if adjustment != 0 {
+		err = s.RecordBalanceChanges(ctx, []*models.BalanceLedgerEntry{{
+			UserID:        userID,
+			AccountID:     account.ID,
+			Change:        adjustment,
+			EffectiveDate: time.Now().UTC().Format("2006-01-02 15:04:05"),
+		}}, tx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return nil, err
@@ -164,3 +264,250 @@ func (s *accountSvc) GetByIDForUpdate(ctx *gofr.Context, id, userID int, tx *sql
 
 	return account, nil
 }
+
+// RecordBalanceChanges adds entries to the balance ledger inside tx, the SQL transaction that changes the balances.
+func (s *accountSvc) RecordBalanceChanges(ctx *gofr.Context, entries []*models.BalanceLedgerEntry, tx *sql.Tx) error {
+	for _, entry := range entries {
+		err := s.ledgerStore.Create(ctx, entry, tx)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// BalanceHistory returns the balance of an account at the end of every day, week or month between from and to, both
+// YYYY-MM-DD and inclusive. It defaults to the daily balance of the last 30 days. Weeks start on Monday.
+func (s *accountSvc) BalanceHistory(ctx *gofr.Context, id int, from, to, interval string) (*models.BalanceHistory, error) {
+	start, end, err := historyRange(from, to, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	if interval == "" {
+		interval = "day"
+	}
+
+	ends, err := intervalEnds(start, end, interval)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := s.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if account == nil {
+		return nil, errors.New("account not found")
+	}
+
+	// Only changes effective after the first point are needed to walk back from the current balance
+	entries, err := s.ledgerStore.GetAll(ctx, &filters.BalanceLedger{UserID: account.UserID, AccountID: id,
+		After: ends[0].Format("2006-01-02 15:04:05")})
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.BalanceHistory{
+		Account:  models.AccountDetails{ID: account.ID, Name: account.Name},
+		Currency: account.Currency,
+		From:     start.Format("2006-01-02"),
+		To:       end.Format("2006-01-02"),
+		Interval: interval,
+		Points:   balancePoints(account.Balance, entries, ends),
+	}, nil
+}
+
+// CheckBalances recomputes the balance of every account from its opening balance, the adjustments made by hand and
+// all its transactions that are not deleted, and reports the accounts whose balance does not match. With repair, each
+// of them is locked and its balance replaced by the recomputed one, recorded in the balance repairs.
+func (s *accountSvc) CheckBalances(ctx *gofr.Context, repair bool) (*models.BalanceCheckReport, error) {
+	checks, err := s.accountStore.GetBalanceChecks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &models.BalanceCheckReport{Checked: len(checks), Accounts: []*models.BalanceCheck{}}
+
+	for _, check := range checks {
+		settleBalanceCheck(check)
+
+		if check.Discrepancy == 0 {
+			continue
+		}
+
+		if repair {
+			check, err = s.repairBalance(ctx, check.Account.ID, check.UserID)
+			if err != nil {
+				return nil, err
+			}
+
+			// The account was deleted, or its balance fixed, since it was checked
+			if check == nil || check.Discrepancy == 0 {
+				continue
+			}
+
+			report.Repaired++
+		}
+
+		report.Discrepancies++
+		report.Accounts = append(report.Accounts, check)
+	}
+
+	return report, nil
+}
+
+// repairBalance replaces the balance of an account by its recomputed value. The account is locked before the sums are
+// read, so no transaction of the account can change them until the repair commits. The repair is not a ledger entry:
+// the ledger already holds the changes of the transactions, it is the stored balance that lost some of them.
+func (s *accountSvc) repairBalance(ctx *gofr.Context, id, userID int) (*models.BalanceCheck, error) {
+	tx, err := ctx.SQL.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	account, err := s.accountStore.GetByIDForUpdate(ctx, id, userID, tx)
+	if err != nil || account == nil {
+		return nil, err
+	}
+
+	check, err := s.accountStore.GetBalanceCheckWithTx(ctx, id, tx)
+	if err != nil || check == nil {
+		return nil, err
+	}
+
+	settleBalanceCheck(check)
+
+	if check.Discrepancy == 0 {
+		return check, nil
+	}
+
+	account.Balance = check.Expected
+
+	err = s.accountStore.Update(ctx, account, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repairStore.Create(ctx, &models.BalanceRepair{UserID: userID, AccountID: id, PreviousBalance: check.Balance,
+		Balance: check.Expected}, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	check.Repaired = true
+
+	return check, nil
+}
+
+func validateCreditCard(account *models.Account) error {
+	if account.CreditCard == nil {
+		return nil
+	}
+
+	if account.Type != models.CreditCardAccount {
+		return errors.New("only CREDIT CARD accounts have credit card terms")
+	}
+
+	return account.CreditCard.Validate()
+}
+
+func settleBalanceCheck(check *models.BalanceCheck) {
+	check.Expected = check.OpeningBalance + check.Adjustments + check.Transactions
+	check.Discrepancy = check.Balance - check.Expected
+}
+
+func historyRange(from, to string, now time.Time) (start, end time.Time, err error) {
+	end = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	if to != "" {
+		end, err = time.Parse("2006-01-02", to)
+		if err != nil {
+			return start, end, errors.New("invalid to date, use YYYY-MM-DD")
+		}
+	}
+
+	start = end.AddDate(0, 0, -30)
+
+	if from != "" {
+		start, err = time.Parse("2006-01-02", from)
+		if err != nil {
+			return start, end, errors.New("invalid from date, use YYYY-MM-DD")
+		}
+	}
+
+	if start.After(end) {
+		return start, end, errors.New("from must not be after to")
+	}
+
+	return start, end, nil
+}
+
+// intervalEnds returns the last second of every interval from start to end, the last one cut off at the end of end.
+func intervalEnds(start, end time.Time, interval string) ([]time.Time, error) {
+	var next func(t time.Time) time.Time
+
+	switch interval {
+	case "day":
+		next = func(t time.Time) time.Time { return t.AddDate(0, 0, 1) }
+	case "week":
+		next = func(t time.Time) time.Time { return t.AddDate(0, 0, 7-(int(t.Weekday())+6)%7) }
+	case "month":
+		next = func(t time.Time) time.Time { return time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC) }
+	default:
+		return nil, errors.New("invalid interval, use day, week or month")
+	}
+
+	limit := end.AddDate(0, 0, 1)
+
+	var ends []time.Time
+
+	for t := start; t.Before(limit); t = next(t) {
+		if len(ends) == maxBalancePoints {
+			return nil, errors.New("too many points, use a shorter range or a longer interval")
+		}
+
+		intervalEnd := next(t)
+		if intervalEnd.After(limit) {
+			intervalEnd = limit
+		}
+
+		ends = append(ends, intervalEnd.Add(-time.Second))
+	}
+
+	return ends, nil
+}
+
+// balancePoints walks back from the current balance, taking off the changes effective after the end of each interval.
+// entries must be ordered by effective date.
+func balancePoints(balance models.Money, entries []*models.BalanceLedgerEntry, ends []time.Time) []models.BalancePoint {
+	points := make([]models.BalancePoint, len(ends))
+
+	i := len(entries) - 1
+
+	for k := len(ends) - 1; k >= 0; k-- {
+		for ; i >= 0; i-- {
+			effective, err := time.Parse(time.RFC3339, entries[i].EffectiveDate)
+			if err == nil && !effective.After(ends[k]) {
+				break
+			}
+
+			balance -= entries[i].Change
+		}
+
+		points[k] = models.BalancePoint{Date: ends[k].Format("2006-01-02"), Balance: balance}
+	}
+
+	return points
+}
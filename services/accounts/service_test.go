@@ -0,0 +1,109 @@
+package accounts
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"moneyManagement/models"
+)
+
+func Test_IntervalEnds(t *testing.T) {
+	date := func(value string) time.Time {
+		d, _ := time.Parse("2006-01-02 15:04:05", value)
+		return d
+	}
+
+	tests := []struct {
+		description string
+		start       string
+		end         string
+		interval    string
+		expected    []time.Time
+		expectedErr error
+	}{
+		{"days", "2025-03-01", "2025-03-03", "day",
+			[]time.Time{date("2025-03-01 23:59:59"), date("2025-03-02 23:59:59"), date("2025-03-03 23:59:59")}, nil},
+		{"weeks end on Sunday", "2025-03-05", "2025-03-18", "week",
+			[]time.Time{date("2025-03-09 23:59:59"), date("2025-03-16 23:59:59"), date("2025-03-18 23:59:59")}, nil},
+		{"calendar months", "2025-01-15", "2025-03-10", "month",
+			[]time.Time{date("2025-01-31 23:59:59"), date("2025-02-28 23:59:59"), date("2025-03-10 23:59:59")}, nil},
+		{"single day", "2025-03-01", "2025-03-01", "month", []time.Time{date("2025-03-01 23:59:59")}, nil},
+		{"invalid interval", "2025-03-01", "2025-03-01", "year", nil, errors.New("invalid interval, use day, week or month")},
+		{"too many points", "2020-01-01", "2025-01-01", "day", nil,
+			errors.New("too many points, use a shorter range or a longer interval")},
+	}
+
+	for i, tc := range tests {
+		start, _ := time.Parse("2006-01-02", tc.start)
+		end, _ := time.Parse("2006-01-02", tc.end)
+
+		output, err := intervalEnds(start, end, tc.interval)
+
+		assert.Equalf(t, tc.expected, output, "TEST[%d], failed.\n%s", i, tc.description)
+		assert.Equalf(t, tc.expectedErr, err, "TEST[%d], failed.\n%s", i, tc.description)
+	}
+}
+
+func Test_HistoryRange(t *testing.T) {
+	now := time.Date(2025, 3, 31, 15, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		description   string
+		from          string
+		to            string
+		expectedStart string
+		expectedEnd   string
+		expectedErr   error
+	}{
+		{"defaults to the last 30 days", "", "", "2025-03-01", "2025-03-31", nil},
+		{"explicit range", "2025-01-01", "2025-02-01", "2025-01-01", "2025-02-01", nil},
+		{"invalid from", "01-01-2025", "", "", "", errors.New("invalid from date, use YYYY-MM-DD")},
+		{"invalid to", "", "tomorrow", "", "", errors.New("invalid to date, use YYYY-MM-DD")},
+		{"from after to", "2025-03-02", "2025-03-01", "", "", errors.New("from must not be after to")},
+	}
+
+	for i, tc := range tests {
+		start, end, err := historyRange(tc.from, tc.to, now)
+
+		assert.Equalf(t, tc.expectedErr, err, "TEST[%d], failed.\n%s", i, tc.description)
+
+		if tc.expectedErr == nil {
+			assert.Equalf(t, tc.expectedStart, start.Format("2006-01-02"), "TEST[%d], failed.\n%s", i, tc.description)
+			assert.Equalf(t, tc.expectedEnd, end.Format("2006-01-02"), "TEST[%d], failed.\n%s", i, tc.description)
+		}
+	}
+}
+
+func Test_BalancePoints(t *testing.T) {
+	start, _ := time.Parse("2006-01-02", "2025-03-01")
+	end, _ := time.Parse("2006-01-02", "2025-03-03")
+	ends, _ := intervalEnds(start, end, "day")
+
+	// Current balance 1000 after: +500 on the 2nd, -200 at the very end of the 3rd and -50 on the 5th
+	entries := []*models.BalanceLedgerEntry{
+		{Change: models.NewMoney(500), EffectiveDate: "2025-03-02T10:00:00.000Z"},
+		{Change: models.NewMoney(-200), EffectiveDate: "2025-03-03T23:59:59.000Z"},
+		{Change: models.NewMoney(-50), EffectiveDate: "2025-03-05T08:00:00.000Z"},
+	}
+
+	expected := []models.BalancePoint{
+		{Date: "2025-03-01", Balance: models.NewMoney(750)},
+		{Date: "2025-03-02", Balance: models.NewMoney(1250)},
+		{Date: "2025-03-03", Balance: models.NewMoney(1050)},
+	}
+
+	assert.Equal(t, expected, balancePoints(models.NewMoney(1000), entries, ends))
+}
+
+func Test_SettleBalanceCheck(t *testing.T) {
+	check := &models.BalanceCheck{OpeningBalance: models.NewMoney(1000), Adjustments: models.NewMoney(-50),
+		Transactions: models.NewMoney(250.5), Balance: models.NewMoney(1300)}
+
+	settleBalanceCheck(check)
+
+	assert.Equal(t, models.NewMoney(1200.5), check.Expected)
+	assert.Equal(t, models.NewMoney(99.5), check.Discrepancy)
+}
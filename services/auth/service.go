@@ -75,6 +75,7 @@ func (s *authSvc) GenerateRefreshToken(claims *models.GoogleClaims) (string, err
 func (s *authSvc) GenerateAccessToken(claims *models.GoogleClaims) (string, error) {
 	jwtClaims := jwt.MapClaims{
 		"userID":      claims.EntityID,
+		"role":        claims.Role,
 		"email":       claims.Email,
 		"name":        claims.Name,
 		"given_name":  claims.GivenName,
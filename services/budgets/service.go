@@ -0,0 +1,493 @@
+package budgets
+
+import (
+	"errors"
+	"gofr.dev/pkg/gofr"
+	"math"
+	"moneyManagement/filters"
+	"moneyManagement/models"
+	"moneyManagement/services"
+	"moneyManagement/stores"
+	"slices"
+	"strings"
+	"time"
+)
+
+// maxRolloverMonths limits how far back leftovers of rollover budgets are carried
+const maxRolloverMonths = 12
+
+type budgetSvc struct {
+	budgetStore     stores.Budgets
+	transactionSvc  services.Transactions
+	accountSvc      services.Account
+	categorySvc     services.Categories
+	exchangeRateSvc services.ExchangeRates
+}
+
+func New(budgetStore stores.Budgets, transactionSvc services.Transactions, accountSvc services.Account,
+	categorySvc services.Categories, exchangeRateSvc services.ExchangeRates) services.Budgets {
+	return &budgetSvc{
+		budgetStore:     budgetStore,
+		transactionSvc:  transactionSvc,
+		accountSvc:      accountSvc,
+		categorySvc:     categorySvc,
+		exchangeRateSvc: exchangeRateSvc,
+	}
+}
+
+func (s *budgetSvc) Create(ctx *gofr.Context, budget *models.Budget) (*models.Budget, error) {
+	userID, _ := ctx.Value("userID").(int)
+
+	budget.UserID = userID
+
+	err := s.validate(ctx, budget)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.budgetStore.Create(ctx, budget)
+	if err != nil {
+		return nil, err
+	}
+
+	newBudget, err := s.GetByID(ctx, budget.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return newBudget, nil
+}
+
+func (s *budgetSvc) GetByID(ctx *gofr.Context, id int) (*models.Budget, error) {
+	userID, _ := ctx.Value("userID").(int)
+
+	budget, err := s.budgetStore.GetByID(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return budget, nil
+}
+
+func (s *budgetSvc) GetAll(ctx *gofr.Context, f *filters.Budget) ([]*models.Budget, error) {
+	userID, _ := ctx.Value("userID").(int)
+
+	f.UserID = userID
+
+	budgets, err := s.budgetStore.GetAll(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+
+	return budgets, nil
+}
+
+func (s *budgetSvc) Update(ctx *gofr.Context, budget *models.Budget) (*models.Budget, error) {
+	userID, _ := ctx.Value("userID").(int)
+
+	original, err := s.GetByID(ctx, budget.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if original == nil || original.DeletedAt != "" {
+		return nil, errors.New("budget not found")
+	}
+
+	budget.UserID = userID
+
+	err = s.validate(ctx, budget)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.budgetStore.Update(ctx, budget)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedBudget, err := s.GetByID(ctx, budget.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return updatedBudget, nil
+}
+
+func (s *budgetSvc) Delete(ctx *gofr.Context, id int) error {
+	budget, err := s.GetByID(ctx, id)
+	if err != nil || budget == nil || budget.DeletedAt != "" {
+		return errors.New("unauthorised")
+	}
+
+	err = s.budgetStore.Delete(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Status compares every budget of the month with the EXPENSE transactions of its category and sub-categories and
+// projects the spend at the end of the month from the pace so far. The leftover of rollover budgets of previous
+// months is added to what is available, and the month's INCOME is compared with what is assigned to the budgets.
+// Budgets of an account are tracked in its currency, the income and totals in the home currency of the user.
+func (s *budgetSvc) Status(ctx *gofr.Context, month string) (*models.BudgetStatus, error) {
+	start, err := time.Parse("2006-01", month)
+	if err != nil {
+		return nil, errors.New("invalid month, use YYYY-MM")
+	}
+
+	end := start.AddDate(0, 1, -1)
+
+	status := &models.BudgetStatus{Month: month, DaysInMonth: end.Day(), Budgets: []models.BudgetProgress{}}
+	status.DaysElapsed = daysElapsed(start, end, time.Now().UTC())
+
+	// The leftover of previous months is converted at their rates, so the rates are loaded from the start
+	converter, err := s.exchangeRateSvc.Converter(ctx, "", end.Format("2006-01-02"))
+	if err != nil {
+		return nil, err
+	}
+
+	status.Currency = converter.HomeCurrency
+
+	// Budget figures are added to the totals at the rate in effect at the end of the month
+	rateDate := end.Format("2006-01-02")
+
+	income, err := s.transactionSvc.GetAll(ctx, &filters.Transactions{
+		Type:      []string{string(models.INCOME)},
+		StartDate: start.Format("2006-01-02") + " 00:00:00",
+		EndDate:   end.Format("2006-01-02") + " 23:59:59",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, transaction := range income {
+		amount, err := converter.ToHome(transaction.Amount, transaction.Currency, transaction.TransactionDate)
+		if err != nil {
+			return nil, err
+		}
+
+		status.Income += amount
+	}
+
+	// The budgets of the previous months are loaded with the month's for the rollover chains
+	history, err := s.GetAll(ctx, &filters.Budget{FromMonth: start.AddDate(0, -maxRolloverMonths, 0).Format("2006-01"),
+		ToMonth: month})
+	if err != nil {
+		return nil, err
+	}
+
+	byMonth := make(map[string][]*models.Budget)
+	for _, budget := range history {
+		byMonth[budget.Month] = append(byMonth[budget.Month], budget)
+	}
+
+	categories, err := s.categorySvc.GetAll(ctx, &filters.Category{IncludeArchived: true})
+	if err != nil {
+		return nil, err
+	}
+
+	currencies, err := s.accountCurrencies(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	expenses := make(map[string][]*models.Transaction)
+
+	for _, budget := range byMonth[month] {
+		currency := converter.HomeCurrency
+		if budget.Account != nil {
+			currency = currencies[budget.Account.ID]
+		}
+
+		spent, err := s.spent(ctx, budget, categories, expenses, converter)
+		if err != nil {
+			return nil, err
+		}
+
+		carryOver, err := s.carryOver(ctx, budget, byMonth, categories, expenses, converter)
+		if err != nil {
+			return nil, err
+		}
+
+		available := budget.Amount + carryOver
+
+		progress := models.BudgetProgress{
+			Budget:         budget,
+			Currency:       currency,
+			CarryOver:      carryOver,
+			Available:      available,
+			Spent:          spent,
+			Remaining:      available - spent,
+			ProjectedSpend: projectedSpend(spent, status.DaysElapsed, status.DaysInMonth),
+			OverBudget:     spent > available,
+		}
+
+		if available > 0 {
+			progress.PercentConsumed = math.Round(spent.Ratio(available)*10000) / 100
+		}
+
+		totals, err := toHome(converter, currency, rateDate, budget.Amount, carryOver, spent)
+		if err != nil {
+			return nil, err
+		}
+
+		status.TotalLimit += totals[0]
+		status.TotalCarryOver += totals[1]
+		status.TotalSpent += totals[2]
+		status.Budgets = append(status.Budgets, progress)
+	}
+
+	status.TotalRemaining = status.TotalLimit + status.TotalCarryOver - status.TotalSpent
+	status.AvailableToAssign = status.Income - status.TotalLimit
+
+	return status, nil
+}
+
+// carryOver walks back through the consecutive previous months that have a rollover budget for the same category and
+// account, at most maxRolloverMonths, and returns what is left of them, negative when they were overspent. byMonth
+// holds the budgets of those months.
+func (s *budgetSvc) carryOver(ctx *gofr.Context, budget *models.Budget, byMonth map[string][]*models.Budget,
+	categories []*models.Category, expenses map[string][]*models.Transaction,
+	converter *models.Converter) (models.Money, error) {
+	month, _ := time.Parse("2006-01", budget.Month)
+
+	var chain []*models.Budget
+
+	for i := 0; i < maxRolloverMonths; i++ {
+		month = month.AddDate(0, -1, 0)
+
+		var found *models.Budget
+
+		for _, b := range byMonth[month.Format("2006-01")] {
+			if b.Category == budget.Category && sameAccount(b.Account, budget.Account) {
+				found = b
+				break
+			}
+		}
+
+		if found == nil || !found.Rollover {
+			break
+		}
+
+		chain = append(chain, found)
+	}
+
+	var carryOver models.Money
+
+	// Oldest month first, each month's leftover includes what was carried into it
+	for i := len(chain) - 1; i >= 0; i-- {
+		spent, err := s.spent(ctx, chain[i], categories, expenses, converter)
+		if err != nil {
+			return 0, err
+		}
+
+		carryOver = chain[i].Amount + carryOver - spent
+	}
+
+	return carryOver, nil
+}
+
+// spent returns the expenses counted against a budget, those of its category and the sub-categories of it among
+// categories. The EXPENSE transactions of each month are loaded once and kept in expenses by month.
+func (s *budgetSvc) spent(ctx *gofr.Context, budget *models.Budget, categories []*models.Category,
+	expenses map[string][]*models.Transaction, converter *models.Converter) (models.Money, error) {
+	transactions, ok := expenses[budget.Month]
+	if !ok {
+		start, err := time.Parse("2006-01", budget.Month)
+		if err != nil {
+			return 0, err
+		}
+
+		transactions, err = s.transactionSvc.GetAll(ctx, &filters.Transactions{
+			Type:      []string{string(models.EXPENSE)},
+			StartDate: start.Format("2006-01-02") + " 00:00:00",
+			EndDate:   start.AddDate(0, 1, -1).Format("2006-01-02") + " 23:59:59",
+		})
+		if err != nil {
+			return 0, err
+		}
+
+		expenses[budget.Month] = transactions
+	}
+
+	return spentIn(transactions, models.WithDescendants(categories, []string{budget.Category}), budget.Account, converter)
+}
+
+// accountCurrencies maps the id of every account of the user to its currency.
+func (s *budgetSvc) accountCurrencies(ctx *gofr.Context) (map[int]string, error) {
+	accounts, err := s.accountSvc.GetAll(ctx, &filters.Account{})
+	if err != nil {
+		return nil, err
+	}
+
+	currencies := make(map[int]string, len(accounts))
+	for _, account := range accounts {
+		currencies[account.ID] = account.Currency
+	}
+
+	return currencies, nil
+}
+
+func (s *budgetSvc) validate(ctx *gofr.Context, budget *models.Budget) error {
+	budget.Category = strings.TrimSpace(budget.Category)
+
+	if _, err := time.Parse("2006-01", budget.Month); err != nil {
+		return errors.New("invalid month, use YYYY-MM")
+	}
+
+	if budget.Amount <= 0 {
+		return errors.New("budget amount must be positive")
+	}
+
+	categories, err := s.categorySvc.GetAll(ctx, &filters.Category{Type: []string{string(models.EXPENSE)},
+		Name: budget.Category})
+	if err != nil {
+		return err
+	}
+
+	if len(categories) == 0 {
+		return errors.New("invalid category for EXPENSE type")
+	}
+
+	if budget.Account != nil && budget.Account.ID == 0 {
+		budget.Account = nil
+	}
+
+	if budget.Account != nil {
+		account, err := s.accountSvc.GetByID(ctx, budget.Account.ID)
+		if err != nil {
+			return err
+		}
+
+		if account == nil {
+			return errors.New("account not found")
+		}
+	}
+
+	existing, err := s.GetAll(ctx, &filters.Budget{Month: budget.Month})
+	if err != nil {
+		return err
+	}
+
+	all, err := s.categorySvc.GetAll(ctx, &filters.Category{IncludeArchived: true})
+	if err != nil {
+		return err
+	}
+
+	// An expense counted by two budgets of the month would be counted twice in the totals
+	covered := models.WithDescendants(all, []string{budget.Category})
+
+	for _, b := range existing {
+		if b.ID == budget.ID || !accountsOverlap(b.Account, budget.Account) {
+			continue
+		}
+
+		if b.Category == budget.Category && sameAccount(b.Account, budget.Account) {
+			return errors.New("budget already exists for this category and month")
+		}
+
+		if slices.Contains(covered, b.Category) ||
+			slices.Contains(models.WithDescendants(all, []string{b.Category}), budget.Category) {
+			return errors.New("budget overlaps the " + b.Category + " budget of the month")
+		}
+	}
+
+	return nil
+}
+
+// spentIn sums the expenses attributed to the given categories, per split for split transactions. The expenses of a
+// single account are in its currency, expenses across accounts are converted to the home currency.
+func spentIn(transactions []*models.Transaction, categories []string, account *models.AccountDetails,
+	converter *models.Converter) (models.Money, error) {
+	names := make(map[string]bool, len(categories))
+	for _, category := range categories {
+		names[category] = true
+	}
+
+	var spent models.Money
+
+	for _, transaction := range transactions {
+		if account != nil && transaction.Account.ID != account.ID {
+			continue
+		}
+
+		var amount models.Money
+
+		if len(transaction.Splits) == 0 {
+			if names[transaction.Category] {
+				amount = transaction.Amount
+			}
+		}
+
+		for _, split := range transaction.Splits {
+			if names[split.Category] {
+				amount += split.Amount
+			}
+		}
+
+		if account == nil {
+			var err error
+
+			amount, err = converter.ToHome(amount, transaction.Currency, transaction.TransactionDate)
+			if err != nil {
+				return 0, err
+			}
+		}
+
+		spent += amount
+	}
+
+	return spent, nil
+}
+
+// toHome converts amounts in currency to the home currency at the rate in effect on date.
+func toHome(converter *models.Converter, currency, date string, amounts ...models.Money) ([]models.Money, error) {
+	converted := make([]models.Money, len(amounts))
+
+	for i, amount := range amounts {
+		var err error
+
+		converted[i], err = converter.ToHome(amount, currency, date)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return converted, nil
+}
+
+// daysElapsed returns how many days of the month starting at start and ending at end have passed at now.
+func daysElapsed(start, end, now time.Time) int {
+	switch {
+	case now.Before(start):
+		return 0
+	case !now.Before(end.AddDate(0, 0, 1)):
+		return end.Day()
+	default:
+		return now.Day()
+	}
+}
+
+func projectedSpend(spent models.Money, elapsed, daysInMonth int) models.Money {
+	if elapsed == 0 {
+		return spent
+	}
+
+	return spent.MulDiv(int64(daysInMonth), int64(elapsed))
+}
+
+func sameAccount(a, b *models.AccountDetails) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+
+	return a.ID == b.ID
+}
+
+// accountsOverlap reports whether budgets of the accounts a and b, nil for every account, can count the same expense.
+func accountsOverlap(a, b *models.AccountDetails) bool {
+	return a == nil || b == nil || a.ID == b.ID
+}
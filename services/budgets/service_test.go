@@ -0,0 +1,145 @@
+package budgets
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+
+	"moneyManagement/filters"
+	"moneyManagement/models"
+	"moneyManagement/services"
+	"moneyManagement/stores"
+)
+
+func Test_Status(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	budgetStore := stores.NewMockBudgets(ctrl)
+	transactionSvc := services.NewMockTransactions(ctrl)
+	accountSvc := services.NewMockAccount(ctrl)
+	categorySvc := services.NewMockCategories(ctrl)
+	exchangeRateSvc := services.NewMockExchangeRates(ctrl)
+
+	parentID := 1
+	categories := []*models.Category{{ID: 1, Name: "Food", Type: models.EXPENSE},
+		{ID: 2, ParentID: &parentID, Name: "Groceries", Type: models.EXPENSE}}
+
+	march := &models.Budget{ID: 4, UserID: 1, Category: "Food", Month: "2025-03", Amount: models.NewMoney(500)}
+	history := []*models.Budget{
+		{ID: 1, UserID: 1, Category: "Food", Month: "2024-12", Amount: models.NewMoney(250)},
+		{ID: 2, UserID: 1, Category: "Food", Month: "2025-01", Amount: models.NewMoney(300), Rollover: true},
+		{ID: 3, UserID: 1, Category: "Food", Month: "2025-02", Amount: models.NewMoney(400), Rollover: true},
+		march,
+	}
+
+	expense := func(category, date string, amount float64) *models.Transaction {
+		return &models.Transaction{Account: models.AccountDetails{ID: 2}, Amount: models.NewMoney(amount),
+			Currency: "INR", Type: models.EXPENSE, Category: category, TransactionDate: date}
+	}
+
+	expenses := func(month, end string) *filters.Transactions {
+		return &filters.Transactions{Type: []string{string(models.EXPENSE)}, StartDate: month + "-01 00:00:00",
+			EndDate: end + " 23:59:59"}
+	}
+
+	mockContainer, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{Context: context.WithValue(context.Background(), "userID", 1), Container: mockContainer}
+
+	exchangeRateSvc.EXPECT().Converter(ctx, "", "2025-03-31").Return(models.NewConverter("INR", nil), nil)
+	transactionSvc.EXPECT().GetAll(ctx, &filters.Transactions{Type: []string{string(models.INCOME)},
+		StartDate: "2025-03-01 00:00:00", EndDate: "2025-03-31 23:59:59"}).Return(nil, nil)
+	// The budgets of the previous months and the categories are loaded once for the whole status
+	budgetStore.EXPECT().GetAll(ctx, &filters.Budget{UserID: 1, FromMonth: "2024-03", ToMonth: "2025-03"}).
+		Return(history, nil).Times(1)
+	categorySvc.EXPECT().GetAll(ctx, &filters.Category{IncludeArchived: true}).Return(categories, nil).Times(1)
+	accountSvc.EXPECT().GetAll(ctx, &filters.Account{}).Return(nil, nil)
+	transactionSvc.EXPECT().GetAll(ctx, expenses("2025-03", "2025-03-31")).Return([]*models.Transaction{
+		expense("Groceries", "2025-03-04 10:00:00", 120), expense("Food", "2025-03-05 10:00:00", 30),
+		expense("Rent", "2025-03-01 10:00:00", 900)}, nil)
+	transactionSvc.EXPECT().GetAll(ctx, expenses("2025-01", "2025-01-31")).Return([]*models.Transaction{
+		expense("Groceries", "2025-01-10 10:00:00", 200)}, nil)
+	transactionSvc.EXPECT().GetAll(ctx, expenses("2025-02", "2025-02-28")).Return([]*models.Transaction{
+		expense("Food", "2025-02-10 10:00:00", 350)}, nil)
+
+	s := New(budgetStore, transactionSvc, accountSvc, categorySvc, exchangeRateSvc)
+
+	status, err := s.Status(ctx, "2025-03")
+
+	assert.Equal(t, nil, err)
+	assert.Len(t, status.Budgets, 1)
+	assert.Equal(t, march, status.Budgets[0].Budget)
+	assert.Equal(t, models.NewMoney(150), status.Budgets[0].Spent, "sub-category expenses count")
+	assert.Equal(t, models.NewMoney(150), status.Budgets[0].CarryOver, "January leaves 100, February 150")
+	assert.Equal(t, models.NewMoney(500), status.Budgets[0].Remaining)
+	assert.Equal(t, models.NewMoney(500), status.TotalLimit)
+	assert.Equal(t, models.NewMoney(150), status.TotalSpent)
+}
+
+func Test_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	budgetStore := stores.NewMockBudgets(ctrl)
+	accountSvc := services.NewMockAccount(ctrl)
+	categorySvc := services.NewMockCategories(ctrl)
+
+	parentID := 1
+	categories := []*models.Category{{ID: 1, Name: "Food", Type: models.EXPENSE},
+		{ID: 2, ParentID: &parentID, Name: "Groceries", Type: models.EXPENSE},
+		{ID: 3, Name: "Rent", Type: models.EXPENSE}}
+
+	existing := []*models.Budget{
+		{ID: 1, UserID: 1, Category: "Food", Month: "2025-03", Amount: models.NewMoney(500)},
+		{ID: 2, UserID: 1, Category: "Rent", Month: "2025-03", Account: &models.AccountDetails{ID: 2},
+			Amount: models.NewMoney(900)},
+	}
+
+	budget := func(category string, accountID int) *models.Budget {
+		b := &models.Budget{Category: category, Month: "2025-03", Amount: models.NewMoney(100)}
+		if accountID != 0 {
+			b.Account = &models.AccountDetails{ID: accountID}
+		}
+
+		return b
+	}
+
+	tests := []struct {
+		description string
+		input       *models.Budget
+		expectedErr error
+	}{
+		{"Success Case: budget of another account", budget("Rent", 3), nil},
+		{"Failure Case: same category and account", budget("Rent", 2),
+			errors.New("budget already exists for this category and month")},
+		{"Failure Case: sub-category of a budget across accounts", budget("Groceries", 2),
+			errors.New("budget overlaps the Food budget of the month")},
+		{"Failure Case: across accounts over a budget of an account", budget("Rent", 0),
+			errors.New("budget overlaps the Rent budget of the month")},
+	}
+
+	for i, tc := range tests {
+		mockContainer, _ := container.NewMockContainer(t)
+		ctx := &gofr.Context{Context: context.WithValue(context.Background(), "userID", 1), Container: mockContainer}
+
+		categorySvc.EXPECT().GetAll(ctx, &filters.Category{Type: []string{string(models.EXPENSE)},
+			Name: tc.input.Category}).Return(categories[:1], nil)
+
+		if tc.input.Account != nil {
+			accountSvc.EXPECT().GetByID(ctx, tc.input.Account.ID).Return(&models.Account{ID: tc.input.Account.ID}, nil)
+		}
+
+		budgetStore.EXPECT().GetAll(ctx, &filters.Budget{UserID: 1, Month: "2025-03"}).Return(existing, nil)
+		categorySvc.EXPECT().GetAll(ctx, &filters.Category{IncludeArchived: true}).Return(categories, nil)
+
+		if tc.expectedErr == nil {
+			budgetStore.EXPECT().Create(ctx, tc.input).Return(nil)
+			budgetStore.EXPECT().GetByID(ctx, 0, 1).Return(tc.input, nil)
+		}
+
+		_, err := New(budgetStore, nil, accountSvc, categorySvc, nil).Create(ctx, tc.input)
+
+		assert.Equalf(t, tc.expectedErr, err, "TEST[%d], failed.\n%s", i, tc.description)
+	}
+}
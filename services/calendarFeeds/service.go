@@ -0,0 +1,318 @@
+package calendarFeeds
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"gofr.dev/pkg/gofr"
+	"moneyManagement/filters"
+	"moneyManagement/models"
+	"moneyManagement/services"
+	"moneyManagement/stores"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// tokenBytes of randomness make up a feed token
+	tokenBytes = 32
+
+	maxNameLength = 100
+
+	// maxLineOctets is the longest an iCalendar content line may be before it is folded
+	maxLineOctets = 75
+
+	// overrideYears is how far ahead of now the occurrences of a series are left out or moved
+	overrideYears = 1
+)
+
+type calendarFeedSvc struct {
+	calendarFeedStore       stores.CalendarFeeds
+	recurringTransactionSvc services.RecurringTransactions
+	savingsSvc              services.Savings
+}
+
+func New(calendarFeedStore stores.CalendarFeeds, recurringTransactionSvc services.RecurringTransactions,
+	savingsSvc services.Savings) services.CalendarFeeds {
+	return &calendarFeedSvc{
+		calendarFeedStore:       calendarFeedStore,
+		recurringTransactionSvc: recurringTransactionSvc,
+		savingsSvc:              savingsSvc,
+	}
+}
+
+// Create issues a new feed token for the user. The token is only returned here, it is stored as a hash.
+func (s *calendarFeedSvc) Create(ctx *gofr.Context, feed *models.CalendarFeed) (*models.CalendarFeed, error) {
+	userID, _ := ctx.Value("userID").(int)
+
+	feed.Name = strings.TrimSpace(feed.Name)
+	if len(feed.Name) > maxNameLength {
+		return nil, errors.New("calendar feed name must be at most 100 characters")
+	}
+
+	random := make([]byte, tokenBytes)
+
+	_, err := rand.Read(random)
+	if err != nil {
+		return nil, err
+	}
+
+	token := hex.EncodeToString(random)
+
+	feed.UserID = userID
+	feed.RevokedAt = ""
+
+	err = s.calendarFeedStore.Create(ctx, feed, models.HashFeedToken(token))
+	if err != nil {
+		return nil, err
+	}
+
+	feed.Token = token
+	feed.URL = "/calendar/" + token + ".ics"
+
+	return feed, nil
+}
+
+func (s *calendarFeedSvc) GetAll(ctx *gofr.Context) ([]*models.CalendarFeed, error) {
+	userID, _ := ctx.Value("userID").(int)
+
+	return s.calendarFeedStore.GetAll(ctx, userID)
+}
+
+// Revoke stops a feed from being served, without touching the logins of the user.
+func (s *calendarFeedSvc) Revoke(ctx *gofr.Context, id int) error {
+	userID, _ := ctx.Value("userID").(int)
+
+	revoked, err := s.calendarFeedStore.Revoke(ctx, id, userID)
+	if err != nil {
+		return err
+	}
+
+	if !revoked {
+		return errors.New("calendar feed not found")
+	}
+
+	return nil
+}
+
+// Render builds the iCalendar document of the user a feed token belongs to: an event for every recurring transaction,
+// repeating by its RRULE, and one for the maturity of every open savings.
+func (s *calendarFeedSvc) Render(ctx *gofr.Context, token string) ([]byte, error) {
+	feed, err := s.calendarFeedStore.GetByTokenHash(ctx, models.HashFeedToken(token))
+	if err != nil {
+		return nil, err
+	}
+
+	if feed == nil {
+		return nil, errors.New("calendar feed not found")
+	}
+
+	userCtx := services.WithUser(ctx, feed.UserID)
+
+	recurringTransactions, err := s.recurringTransactionSvc.GetAll(userCtx, &filters.RecurringTransactions{})
+	if err != nil {
+		return nil, err
+	}
+
+	savings, err := s.savingsSvc.GetOpen(userCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return renderCalendar(recurringTransactions, savings, time.Now().UTC())
+}
+
+// renderCalendar lays out the events as an RFC 5545 calendar. Bills and maturities are all-day events that do not
+// block time.
+func renderCalendar(recurringTransactions []*models.RecurringTransaction, savings []*models.Savings,
+	now time.Time) ([]byte, error) {
+	var b strings.Builder
+
+	stamp := now.Format("20060102T150405Z")
+
+	writeLine(&b, "BEGIN:VCALENDAR")
+	writeLine(&b, "VERSION:2.0")
+	writeLine(&b, "PRODID:-//moneyManagement//Bills and maturities//EN")
+	writeLine(&b, "CALSCALE:GREGORIAN")
+	writeLine(&b, "METHOD:PUBLISH")
+	writeLine(&b, "X-WR-CALNAME:Bills and maturities")
+
+	for _, recurringTransaction := range recurringTransactions {
+		err := writeRecurringEvent(&b, recurringTransaction, now, stamp)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, s := range savings {
+		if s.MaturityDate == "" {
+			continue
+		}
+
+		maturity, err := time.Parse("2006-01-02", s.MaturityDate[:len("2006-01-02")])
+		if err != nil {
+			return nil, err
+		}
+
+		description := fmt.Sprintf("Invested %s %s, currently worth %s %s", s.Amount, s.Currency, s.Value(), s.Currency)
+
+		writeLine(&b, "BEGIN:VEVENT")
+		writeLine(&b, "UID:savings-"+strconv.Itoa(s.ID)+"@moneymanagement")
+		writeLine(&b, "DTSTAMP:"+stamp)
+		writeLine(&b, "DTSTART;VALUE=DATE:"+maturity.Format("20060102"))
+		writeLine(&b, "SUMMARY:"+escapeText(strings.TrimSpace(s.Type+" maturity: "+s.Category)))
+		writeLine(&b, "DESCRIPTION:"+escapeText(description))
+		writeLine(&b, "TRANSP:TRANSPARENT")
+		writeLine(&b, "END:VEVENT")
+	}
+
+	writeLine(&b, "END:VCALENDAR")
+
+	return []byte(b.String()), nil
+}
+
+// writeRecurringEvent writes a recurring transaction as an event repeating from the start of its schedule, so that the
+// occurrences already posted stay on the calendar. Occurrences that are skipped, paused or shifted into an earlier
+// one are left out of the series, and those moved by an exception or off a weekend or holiday, or posted with another
+// amount, are replaced by their own event, up to a year ahead.
+func writeRecurringEvent(b *strings.Builder, recurringTransaction *models.RecurringTransaction, now time.Time,
+	stamp string) error {
+	if recurringTransaction.StartDate == "" && recurringTransaction.NextRun == "" {
+		return nil
+	}
+
+	recurrence, err := recurringTransaction.Schedule()
+	if err != nil {
+		return err
+	}
+
+	rule := recurrence.RRule()
+	until := now.AddDate(overrideYears, 0, 0)
+
+	var endDate time.Time
+
+	if recurringTransaction.EndDate != "" {
+		endDate, err = time.Parse(time.RFC3339, recurringTransaction.EndDate)
+		if err != nil {
+			return err
+		}
+
+		rule += ";UNTIL=" + endDate.Format("20060102")
+
+		if endDate.Before(until) {
+			until = endDate
+		}
+	}
+
+	instances, err := recurrence.Instances(until)
+	if err != nil {
+		return err
+	}
+
+	// The start of the series is always one of its occurrences, so it must be one the rule lists
+	start := recurrence.Start
+	if len(instances) != 0 {
+		start = instances[0].Scheduled
+	}
+
+	var (
+		excluded  []time.Time
+		overrides []models.RecurringOccurrence
+	)
+
+	for _, instance := range instances {
+		if instance.At.IsZero() || (!endDate.IsZero() && instance.At.After(endDate)) {
+			excluded = append(excluded, instance.Scheduled)
+			continue
+		}
+
+		occurrence, posted := recurringTransaction.Occurrence(instance.At)
+		if !posted {
+			excluded = append(excluded, instance.Scheduled)
+			continue
+		}
+
+		if occurrence.Overridden || !strings.HasPrefix(occurrence.Date, instance.Scheduled.Format("2006-01-02")) {
+			// Scheduled is where the series lists it, which the override is matched to
+			occurrence.Scheduled = instance.Scheduled.Format("2006-01-02T15:04:05.000Z")
+			overrides = append(overrides, occurrence)
+		}
+	}
+
+	uid := "UID:recurring-transaction-" + strconv.Itoa(recurringTransaction.ID) + "@moneymanagement"
+
+	writeLine(b, "BEGIN:VEVENT")
+	writeLine(b, uid)
+	writeLine(b, "DTSTAMP:"+stamp)
+	writeLine(b, "DTSTART;VALUE=DATE:"+start.Format("20060102"))
+	writeLine(b, "RRULE:"+rule)
+
+	for _, scheduled := range excluded {
+		writeLine(b, "EXDATE;VALUE=DATE:"+scheduled.Format("20060102"))
+	}
+
+	writeRecurringDetails(b, recurringTransaction, recurringTransaction.Amount)
+	writeLine(b, "END:VEVENT")
+
+	for _, occurrence := range overrides {
+		scheduled, err := time.Parse(time.RFC3339, occurrence.Scheduled)
+		if err != nil {
+			return err
+		}
+
+		date, err := time.Parse(time.RFC3339, occurrence.Date)
+		if err != nil {
+			return err
+		}
+
+		writeLine(b, "BEGIN:VEVENT")
+		writeLine(b, uid)
+		writeLine(b, "DTSTAMP:"+stamp)
+		writeLine(b, "RECURRENCE-ID;VALUE=DATE:"+scheduled.Format("20060102"))
+		writeLine(b, "DTSTART;VALUE=DATE:"+date.Format("20060102"))
+		writeRecurringDetails(b, recurringTransaction, occurrence.Amount)
+		writeLine(b, "END:VEVENT")
+	}
+
+	return nil
+}
+
+// writeRecurringDetails writes what an occurrence of a recurring transaction posts.
+func writeRecurringDetails(b *strings.Builder, recurringTransaction *models.RecurringTransaction, amount models.Money) {
+	description := fmt.Sprintf("%s %s on %s", recurringTransaction.Type, amount, recurringTransaction.Account.Name)
+	if recurringTransaction.Description != "" {
+		description += "\n" + recurringTransaction.Description
+	}
+
+	writeLine(b, "SUMMARY:"+escapeText(recurringTransaction.Category+": "+amount.String()))
+	writeLine(b, "DESCRIPTION:"+escapeText(description))
+	writeLine(b, "TRANSP:TRANSPARENT")
+}
+
+// escapeText escapes the characters that have a meaning in iCalendar text values.
+func escapeText(text string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`).Replace(text)
+}
+
+// writeLine writes a content line, folding it every 75 octets without splitting a character.
+func writeLine(b *strings.Builder, line string) {
+	limit := maxLineOctets
+
+	for len(line) > limit {
+		cut := limit
+		for !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+
+		b.WriteString(line[:cut] + "\r\n ")
+		line = line[cut:]
+
+		// The leading space of a continuation line counts towards its length
+		limit = maxLineOctets - 1
+	}
+
+	b.WriteString(line + "\r\n")
+}
@@ -0,0 +1,176 @@
+package calendarFeeds
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"moneyManagement/models"
+)
+
+func Test_RenderCalendar(t *testing.T) {
+	now := time.Date(2025, 7, 12, 8, 30, 0, 0, time.UTC)
+	fee := models.NewMoney(20)
+
+	recurringTransactions := []*models.RecurringTransaction{
+		{ID: 1, Account: models.AccountDetails{ID: 1, Name: "HDFC"}, Amount: models.NewMoney(1200), Type: models.EXPENSE,
+			Category: "Rent", Description: "Flat 4, Baker St", Frequency: models.MONTHLY,
+			StartDate: "2025-01-31T09:00:00.000Z", LastRun: "2025-06-30T09:00:00.000Z", NextRun: "2025-07-31T09:00:00.000Z",
+			EndDate: "2026-06-30T00:00:00.000Z"},
+		{ID: 2, Account: models.AccountDetails{ID: 2, Name: "Cash"}, Amount: models.NewMoney(15), Type: models.EXPENSE,
+			Category: "Gym", Frequency: models.CUSTOM, CustomDays: 14, NextRun: "2025-07-20T00:00:00.000Z",
+			Exceptions: []models.RecurringException{{Occurrence: "2025-08-03T00:00:00.000Z", Skip: true},
+				{Occurrence: "2025-08-17T00:00:00.000Z", Amount: &fee, Date: "2025-08-18T00:00:00.000Z"}}},
+		// Finished before it ever ran, nothing to show
+		{ID: 3, Frequency: models.DAILY},
+	}
+
+	savings := []*models.Savings{
+		{ID: 5, Type: "FD", Category: "Emergency fund", Amount: models.NewMoney(10000), CurrentValue: models.NewMoney(10250),
+			Currency: "INR", MaturityDate: "2026-01-15"},
+		{ID: 6, Type: "STOCKS", Category: "Index", Amount: models.NewMoney(500), Currency: "INR"},
+	}
+
+	calendar, err := renderCalendar(recurringTransactions, savings, now)
+
+	expected := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//moneyManagement//Bills and maturities//EN",
+		"CALSCALE:GREGORIAN",
+		"METHOD:PUBLISH",
+		"X-WR-CALNAME:Bills and maturities",
+		"BEGIN:VEVENT",
+		"UID:recurring-transaction-1@moneymanagement",
+		"DTSTAMP:20250712T083000Z",
+		"DTSTART;VALUE=DATE:20250131",
+		"RRULE:FREQ=MONTHLY;BYMONTHDAY=28,29,30,31;BYSETPOS=-1;UNTIL=20260630",
+		"SUMMARY:Rent: 1200.00",
+		`DESCRIPTION:EXPENSE 1200.00 on HDFC\nFlat 4\, Baker St`,
+		"TRANSP:TRANSPARENT",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:recurring-transaction-2@moneymanagement",
+		"DTSTAMP:20250712T083000Z",
+		"DTSTART;VALUE=DATE:20250720",
+		"RRULE:FREQ=DAILY;INTERVAL=14",
+		"EXDATE;VALUE=DATE:20250803",
+		"SUMMARY:Gym: 15.00",
+		"DESCRIPTION:EXPENSE 15.00 on Cash",
+		"TRANSP:TRANSPARENT",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:recurring-transaction-2@moneymanagement",
+		"DTSTAMP:20250712T083000Z",
+		"RECURRENCE-ID;VALUE=DATE:20250817",
+		"DTSTART;VALUE=DATE:20250818",
+		"SUMMARY:Gym: 20.00",
+		"DESCRIPTION:EXPENSE 20.00 on Cash",
+		"TRANSP:TRANSPARENT",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:savings-5@moneymanagement",
+		"DTSTAMP:20250712T083000Z",
+		"DTSTART;VALUE=DATE:20260115",
+		"SUMMARY:FD maturity: Emergency fund",
+		`DESCRIPTION:Invested 10000.00 INR\, currently worth 10250.00 INR`,
+		"TRANSP:TRANSPARENT",
+		"END:VEVENT",
+		"END:VCALENDAR",
+		"",
+	}, "\r\n")
+
+	assert.Equal(t, nil, err)
+	assert.Equal(t, expected, string(calendar))
+}
+
+func Test_WriteRecurringEvent(t *testing.T) {
+	now := time.Date(2025, 3, 10, 8, 30, 0, 0, time.UTC)
+
+	rule := func(frequency models.Frequency, startDate, endDate string, shift models.Shift) *models.RecurringTransaction {
+		return &models.RecurringTransaction{ID: 7, Account: models.AccountDetails{ID: 1, Name: "HDFC"},
+			Amount: models.NewMoney(900), Type: models.EXPENSE, Category: "Rent", Frequency: frequency,
+			StartDate: startDate, EndDate: endDate, Shift: shift}
+	}
+
+	shifted := rule(models.MONTHLY, "2025-03-01T09:00:00.000Z", "2025-06-30T00:00:00.000Z", models.ShiftAfter)
+	shifted.Holidays = map[string]bool{"2025-05-01": true}
+
+	paused := rule(models.WEEKLY, "2025-07-07T09:00:00.000Z", "2025-07-31T00:00:00.000Z", "")
+	paused.PausedAt, paused.PausedUntil = "2025-07-10T00:00:00.000Z", "2025-07-22"
+
+	merged := rule(models.DAILY, "2025-01-03T00:00:00.000Z", "2025-01-06T00:00:00.000Z", models.ShiftBefore)
+
+	details := []string{"SUMMARY:Rent: 900.00", "DESCRIPTION:EXPENSE 900.00 on HDFC", "TRANSP:TRANSPARENT"}
+
+	override := func(recurrenceID, start string) []string {
+		return append([]string{"BEGIN:VEVENT", "UID:recurring-transaction-7@moneymanagement", "DTSTAMP:20250310T083000Z",
+			"RECURRENCE-ID;VALUE=DATE:" + recurrenceID, "DTSTART;VALUE=DATE:" + start}, append(details, "END:VEVENT")...)
+	}
+
+	series := func(start, rrule string, exdates ...string) []string {
+		lines := []string{"BEGIN:VEVENT", "UID:recurring-transaction-7@moneymanagement", "DTSTAMP:20250310T083000Z",
+			"DTSTART;VALUE=DATE:" + start, "RRULE:" + rrule}
+
+		for _, exdate := range exdates {
+			lines = append(lines, "EXDATE;VALUE=DATE:"+exdate)
+		}
+
+		return append(lines, append(details, "END:VEVENT")...)
+	}
+
+	tests := []struct {
+		description          string
+		recurringTransaction *models.RecurringTransaction
+		expected             [][]string
+	}{
+		{"occurrences shifted off weekends and holidays are moved", shifted, [][]string{
+			series("20250301", "FREQ=MONTHLY;UNTIL=20250630"), override("20250301", "20250303"),
+			override("20250501", "20250502"), override("20250601", "20250602")}},
+		{"paused occurrences are left out", paused, [][]string{
+			series("20250707", "FREQ=WEEKLY;UNTIL=20250731", "20250714", "20250721")}},
+		{"weekend occurrences shifted into the Friday before are left out", merged, [][]string{
+			series("20250103", "FREQ=DAILY;UNTIL=20250106", "20250104", "20250105")}},
+	}
+
+	for i, tc := range tests {
+		var (
+			b        strings.Builder
+			expected []string
+		)
+
+		for _, event := range tc.expected {
+			expected = append(expected, event...)
+		}
+
+		err := writeRecurringEvent(&b, tc.recurringTransaction, now, "20250310T083000Z")
+
+		assert.Equalf(t, nil, err, "TEST[%d], failed.\n%s", i, tc.description)
+		assert.Equalf(t, strings.Join(expected, "\r\n")+"\r\n", b.String(), "TEST[%d], failed.\n%s", i, tc.description)
+	}
+}
+
+func Test_WriteLine(t *testing.T) {
+	tests := []struct {
+		description string
+		line        string
+		expected    string
+	}{
+		{"short line", "SUMMARY:Rent", "SUMMARY:Rent\r\n"},
+		{"folded every 75 octets", "DESCRIPTION:" + strings.Repeat("a", 150),
+			"DESCRIPTION:" + strings.Repeat("a", 63) + "\r\n " + strings.Repeat("a", 74) + "\r\n " +
+				strings.Repeat("a", 13) + "\r\n"},
+		{"characters are not split", "SUMMARY:" + strings.Repeat("a", 66) + "€uro",
+			"SUMMARY:" + strings.Repeat("a", 66) + "\r\n €uro\r\n"},
+	}
+
+	for i, tc := range tests {
+		var b strings.Builder
+
+		writeLine(&b, tc.line)
+
+		assert.Equalf(t, tc.expected, b.String(), "TEST[%d], failed.\n%s", i, tc.description)
+	}
+}
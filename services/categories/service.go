@@ -0,0 +1,388 @@
+package categories
+
+import (
+	"errors"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/datasource/sql"
+	"moneyManagement/filters"
+	"moneyManagement/models"
+	"moneyManagement/services"
+	"moneyManagement/stores"
+	"regexp"
+	"strings"
+)
+
+var colorPattern = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+type categorySvc struct {
+	categoryStore stores.Categories
+}
+
+func New(categoryStore stores.Categories) services.Categories {
+	return &categorySvc{
+		categoryStore: categoryStore,
+	}
+}
+
+func (s *categorySvc) Create(ctx *gofr.Context, category *models.Category) (*models.Category, error) {
+	userID, _ := ctx.Value("userID").(int)
+
+	category.UserID = userID
+	category.Name = strings.TrimSpace(category.Name)
+
+	err := validate(category)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.checkDuplicate(ctx, category)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.checkParent(ctx, category)
+	if err != nil {
+		return nil, err
+	}
+
+	if category.Color == "" {
+		used, err := s.usedColors(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+
+		category.Color = models.CategoryColor(category.Name, used)
+	}
+
+	err = s.categoryStore.Create(ctx, category)
+	if err != nil {
+		return nil, err
+	}
+
+	newCategory, err := s.GetByID(ctx, category.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return newCategory, nil
+}
+
+func (s *categorySvc) GetByID(ctx *gofr.Context, id int) (*models.Category, error) {
+	userID, _ := ctx.Value("userID").(int)
+
+	category, err := s.categoryStore.GetByID(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return category, nil
+}
+
+func (s *categorySvc) GetAll(ctx *gofr.Context, f *filters.Category) ([]*models.Category, error) {
+	userID, _ := ctx.Value("userID").(int)
+
+	f.UserID = userID
+
+	categories, err := s.categoryStore.GetAll(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+
+	return categories, nil
+}
+
+// Update changes the name, icon, color or archived flag of a category. The type of a category is fixed; a rename
+// re-points every transaction, split, recurring transaction and savings record using the old name.
+func (s *categorySvc) Update(ctx *gofr.Context, category *models.Category) (*models.Category, error) {
+	userID, _ := ctx.Value("userID").(int)
+
+	original, err := s.GetByID(ctx, category.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if original == nil || original.DeletedAt != "" {
+		return nil, errors.New("category not found")
+	}
+
+	category.UserID = userID
+	category.Type = original.Type
+	category.Name = strings.TrimSpace(category.Name)
+
+	// A category keeps its color unless a new one is given
+	if category.Color == "" {
+		category.Color = original.Color
+	}
+
+	err = validate(category)
+	if err != nil {
+		return nil, err
+	}
+
+	if category.Name != original.Name {
+		err = s.checkDuplicate(ctx, category)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = s.checkParent(ctx, category)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := ctx.SQL.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	err = s.categoryStore.Update(ctx, category, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if category.Name != original.Name {
+		err = s.categoryStore.Repoint(ctx, userID, original.Type, original.Name, category.Name, tx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	updatedCategory, err := s.GetByID(ctx, category.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return updatedCategory, nil
+}
+
+func (s *categorySvc) Delete(ctx *gofr.Context, id int) error {
+	category, err := s.GetByID(ctx, id)
+	if err != nil || category == nil || category.DeletedAt != "" {
+		return errors.New("unauthorised")
+	}
+
+	tx, err := ctx.SQL.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	// Sub-categories move up a level instead of being left under a deleted parent
+	err = s.categoryStore.Reparent(ctx, category.UserID, id, category.ParentID, tx)
+	if err != nil {
+		return err
+	}
+
+	err = s.categoryStore.Delete(ctx, id, tx)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Merge re-points everything using the category onto the target category of the same type and deletes it.
+func (s *categorySvc) Merge(ctx *gofr.Context, id, targetID int) (*models.Category, error) {
+	userID, _ := ctx.Value("userID").(int)
+
+	if id == targetID {
+		return nil, errors.New("cannot merge a category into itself")
+	}
+
+	source, err := s.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := s.GetByID(ctx, targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	if source == nil || source.DeletedAt != "" || target == nil || target.DeletedAt != "" {
+		return nil, errors.New("category not found")
+	}
+
+	if source.Type != target.Type {
+		return nil, errors.New("categories of different types cannot be merged")
+	}
+
+	all, err := s.GetAll(ctx, &filters.Category{IncludeArchived: true})
+	if err != nil {
+		return nil, err
+	}
+
+	if isDescendant(all, target.ID, source.ID) {
+		return nil, errors.New("cannot merge a category into one of its sub-categories")
+	}
+
+	tx, err := ctx.SQL.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	err = s.categoryStore.Repoint(ctx, userID, source.Type, source.Name, target.Name, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.categoryStore.Reparent(ctx, userID, source.ID, &target.ID, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.categoryStore.Delete(ctx, source.ID, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return target, nil
+}
+
+// WithDescendants returns the given category names together with the names of all their sub-categories, so that
+// filtering by a parent category also matches transactions of its children.
+func (s *categorySvc) WithDescendants(ctx *gofr.Context, names []string) ([]string, error) {
+	if len(names) == 0 {
+		return names, nil
+	}
+
+	all, err := s.GetAll(ctx, &filters.Category{IncludeArchived: true})
+	if err != nil {
+		return nil, err
+	}
+
+	return models.WithDescendants(all, names), nil
+}
+
+func (s *categorySvc) checkDuplicate(ctx *gofr.Context, category *models.Category) error {
+	existing, err := s.categoryStore.GetAll(ctx, &filters.Category{UserID: category.UserID,
+		Type: []string{string(category.Type)}, Name: category.Name, IncludeArchived: true})
+	if err != nil {
+		return err
+	}
+
+	if len(existing) != 0 {
+		return errors.New("category already exists")
+	}
+
+	return nil
+}
+
+// checkParent ensures the parent of a category exists, has the same type and is not the category itself or one
+// of its sub-categories.
+func (s *categorySvc) checkParent(ctx *gofr.Context, category *models.Category) error {
+	if category.ParentID == nil {
+		return nil
+	}
+
+	parent, err := s.GetByID(ctx, *category.ParentID)
+	if err != nil {
+		return err
+	}
+
+	if parent == nil || parent.DeletedAt != "" {
+		return errors.New("parent category not found")
+	}
+
+	if parent.Type != category.Type {
+		return errors.New("parent category must have the same type")
+	}
+
+	if category.ID == 0 {
+		return nil
+	}
+
+	all, err := s.GetAll(ctx, &filters.Category{IncludeArchived: true})
+	if err != nil {
+		return err
+	}
+
+	if parent.ID == category.ID || isDescendant(all, parent.ID, category.ID) {
+		return errors.New("a category cannot be moved under itself or one of its sub-categories")
+	}
+
+	return nil
+}
+
+// SeedDefaults gives a new user the built-in categories, in the transaction that creates the user.
+func (s *categorySvc) SeedDefaults(ctx *gofr.Context, userID int, tx *sql.Tx) error {
+	return s.categoryStore.Seed(ctx, models.DefaultCategories(userID), tx)
+}
+
+// usedColors returns the colors of the categories of the user, as upper-case hex codes.
+func (s *categorySvc) usedColors(ctx *gofr.Context, userID int) (map[string]bool, error) {
+	categories, err := s.categoryStore.GetAll(ctx, &filters.Category{UserID: userID, IncludeArchived: true})
+	if err != nil {
+		return nil, err
+	}
+
+	used := make(map[string]bool, len(categories))
+	for _, category := range categories {
+		used[strings.ToUpper(category.Color)] = true
+	}
+
+	return used, nil
+}
+
+func validate(category *models.Category) error {
+	if category.Name == "" {
+		return errors.New("category name is required")
+	}
+
+	if category.Type != models.INCOME && category.Type != models.EXPENSE && category.Type != models.SAVINGS {
+		return errors.New("category type must be INCOME, EXPENSE or SAVINGS")
+	}
+
+	if category.Color != "" && !colorPattern.MatchString(category.Color) {
+		return errors.New("color must be a hex code like #a1b2c3")
+	}
+
+	return nil
+}
+
+// isDescendant reports whether the category with id is below the category with ancestorID.
+func isDescendant(categories []*models.Category, id, ancestorID int) bool {
+	parents := make(map[int]*int, len(categories))
+	for _, category := range categories {
+		parents[category.ID] = category.ParentID
+	}
+
+	visited := make(map[int]bool)
+
+	for parent := parents[id]; parent != nil && !visited[*parent]; parent = parents[*parent] {
+		if *parent == ancestorID {
+			return true
+		}
+
+		visited[*parent] = true
+	}
+
+	return false
+}
@@ -0,0 +1,15 @@
+package services
+
+import (
+	"context"
+	"gofr.dev/pkg/gofr"
+)
+
+// WithUser scopes ctx to userID the same way the authorization middleware does for API requests, for work done on
+// behalf of a user outside of one, like background jobs and calendar feeds.
+func WithUser(ctx *gofr.Context, userID int) *gofr.Context {
+	userCtx := *ctx
+	userCtx.Context = context.WithValue(ctx.Context, "userID", userID)
+
+	return &userCtx
+}
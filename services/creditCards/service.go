@@ -0,0 +1,176 @@
+package creditCards
+
+import (
+	"errors"
+	"gofr.dev/pkg/gofr"
+	"math"
+	"moneyManagement/filters"
+	"moneyManagement/models"
+	"moneyManagement/services"
+	"time"
+)
+
+const (
+	defaultStatements = 6
+	maxStatements     = 24
+)
+
+type creditCardSvc struct {
+	accountSvc     services.Account
+	transactionSvc services.Transactions
+}
+
+func New(accountSvc services.Account, transactionSvc services.Transactions) services.CreditCards {
+	return &creditCardSvc{
+		accountSvc:     accountSvc,
+		transactionSvc: transactionSvc,
+	}
+}
+
+// billingCycle is the first and last day of a statement and the day its balance is due
+type billingCycle struct {
+	start, close, due time.Time
+}
+
+// Statements returns the current state of a credit card account and its last count billing cycles, 6 by default. A
+// credit card balance is negative while money is owed on it.
+func (s *creditCardSvc) Statements(ctx *gofr.Context, accountID, count int) (*models.CreditCardStatements, error) {
+	if count == 0 {
+		count = defaultStatements
+	}
+
+	if count < 0 || count > maxStatements {
+		return nil, errors.New("count must be between 1 and 24")
+	}
+
+	account, err := s.accountSvc.GetByID(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if account == nil {
+		return nil, errors.New("account not found")
+	}
+
+	if account.Type != models.CreditCardAccount {
+		return nil, errors.New("account is not a credit card")
+	}
+
+	if account.CreditCard == nil {
+		return nil, errors.New("credit card terms are not set, update the account with a creditCard")
+	}
+
+	cycles := billingCycles(account.CreditCard, account.OpeningDate, time.Now().UTC(), count)
+
+	// Transactions after the oldest cycle are needed to walk back from the current balance to each close
+	f := &filters.Transactions{AccountID: account.ID}
+	if len(cycles) != 0 {
+		f.StartDate = cycles[len(cycles)-1].start.Format("2006-01-02") + " 00:00:00"
+	}
+
+	transactions, err := s.transactionSvc.GetAll(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+
+	return summarise(account, transactions, cycles), nil
+}
+
+// billingCycles returns up to count cycles closed by today, newest first, leaving out those that closed before the
+// account was opened.
+func billingCycles(card *models.CreditCard, openingDate string, today time.Time, count int) []billingCycle {
+	today = time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, time.UTC)
+
+	year, month := today.Year(), today.Month()
+	if card.CloseDate(year, month).After(today) {
+		month--
+	}
+
+	cycles := make([]billingCycle, 0, count)
+
+	for i := 0; i < count; i++ {
+		closeDate := card.CloseDate(year, month-time.Month(i))
+		if closeDate.Format("2006-01-02") < openingDate {
+			break
+		}
+
+		cycles = append(cycles, billingCycle{
+			start: card.CloseDate(year, month-time.Month(i)-1).AddDate(0, 0, 1),
+			close: closeDate,
+			due:   card.DueDate(closeDate),
+		})
+	}
+
+	return cycles
+}
+
+// summarise works out every statement from the transactions of the account, walking back from its current balance.
+// transactions must hold all of them from the start of the oldest cycle.
+func summarise(account *models.Account, transactions []*models.Transaction, cycles []billingCycle) *models.CreditCardStatements {
+	limit := account.CreditCard.Limit
+
+	summary := &models.CreditCardStatements{
+		Account:     models.AccountDetails{ID: account.ID, Name: account.Name},
+		Currency:    account.Currency,
+		Limit:       limit,
+		Outstanding: owed(account.Balance),
+		Statements:  make([]models.CreditCardStatement, 0, len(cycles)),
+	}
+
+	summary.AvailableCredit = limit - summary.Outstanding
+	summary.Utilisation = math.Round(summary.Outstanding.Ratio(limit)*10000) / 100
+
+	for _, cycle := range cycles {
+		start, closeDate, due := cycle.start.Format("2006-01-02"), cycle.close.Format("2006-01-02"), cycle.due.Format("2006-01-02")
+
+		statement := models.CreditCardStatement{StartDate: start, CloseDate: closeDate, DueDate: due}
+
+		balance := account.Balance
+
+		for _, transaction := range transactions {
+			date := transaction.TransactionDate[:10]
+			change := transaction.BalanceEffects()[account.ID]
+
+			if date > closeDate {
+				balance -= change
+			}
+
+			switch {
+			case date >= start && date <= closeDate && change < 0:
+				statement.Charges -= change
+			case date >= start && date <= closeDate:
+				statement.Credits += change
+			case date > closeDate && date <= due && transaction.Type == models.TRANSFER && transaction.ToAccount != nil &&
+				transaction.ToAccount.ID == account.ID:
+				statement.Paid += change
+			}
+		}
+
+		statement.Balance = owed(balance)
+		statement.MinimumDue = models.MinimumDue(statement.Balance)
+		statement.PaidInFull = statement.Paid >= statement.Balance
+		statement.MinimumPaid = statement.Paid >= statement.MinimumDue
+
+		summary.Statements = append(summary.Statements, statement)
+	}
+
+	if len(summary.Statements) != 0 {
+		last := summary.Statements[0]
+
+		// What is paid towards the last statement is no longer due on it
+		summary.StatementBalance = max(last.Balance-last.Paid, 0)
+		summary.MinimumDue = max(last.MinimumDue-last.Paid, 0)
+		summary.DueDate = last.DueDate
+	}
+
+	return summary
+}
+
+// owed turns a credit card balance into the amount owed on it, zero when the card is in credit.
+func owed(balance models.Money) models.Money {
+	if balance >= 0 {
+		return 0
+	}
+
+	return -balance
+}
@@ -0,0 +1,78 @@
+package creditCards
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"moneyManagement/models"
+)
+
+func Test_BillingCycles(t *testing.T) {
+	card := &models.CreditCard{Limit: models.NewMoney(50000), StatementDay: 31, DueDay: 20}
+	today := time.Date(2025, 3, 15, 10, 0, 0, 0, time.UTC)
+
+	date := func(value string) time.Time {
+		d, _ := time.Parse("2006-01-02", value)
+		return d
+	}
+
+	expected := []billingCycle{
+		{start: date("2025-02-01"), close: date("2025-02-28"), due: date("2025-03-20")},
+		{start: date("2025-01-01"), close: date("2025-01-31"), due: date("2025-02-20")},
+	}
+
+	assert.Equal(t, expected, billingCycles(card, "2025-01-10", today, 6))
+
+	// A cycle closing today is complete, the due day before the statement day falls in the next month
+	card = &models.CreditCard{Limit: models.NewMoney(50000), StatementDay: 15, DueDay: 5}
+
+	assert.Equal(t, []billingCycle{{start: date("2025-02-16"), close: date("2025-03-15"), due: date("2025-04-05")}},
+		billingCycles(card, "2024-01-01", today, 1))
+}
+
+func Test_Summarise(t *testing.T) {
+	account := &models.Account{ID: 3, Name: "Visa", Type: models.CreditCardAccount, Currency: "INR",
+		Balance:    models.NewMoney(-1200),
+		CreditCard: &models.CreditCard{Limit: models.NewMoney(10000), StatementDay: 15, DueDay: 5}}
+
+	transaction := func(id int, date string, amount float64, kind models.Type, toCard bool) *models.Transaction {
+		t := &models.Transaction{ID: id, Account: models.AccountDetails{ID: 3}, Amount: models.NewMoney(amount),
+			Type: kind, TransactionDate: date + "T00:00:00.000Z"}
+
+		if toCard {
+			t.Account = models.AccountDetails{ID: 1}
+			t.ToAccount = &models.AccountDetails{ID: 3}
+		}
+
+		return t
+	}
+
+	transactions := []*models.Transaction{
+		transaction(1, "2025-05-20", 800, models.EXPENSE, false),
+		transaction(2, "2025-06-01", 100, models.INCOME, false),
+		transaction(3, "2025-06-10", 500, models.EXPENSE, false),
+		transaction(4, "2025-06-25", 1200, models.TRANSFER, true),
+		transaction(5, "2025-06-28", 1200, models.EXPENSE, false),
+	}
+
+	cycles := billingCycles(account.CreditCard, "2025-01-01", time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC), 2)
+
+	summary := summarise(account, transactions, cycles)
+
+	// The card owed nothing on May 15, 1200 on June 15, paid off by the transfer on June 25
+	assert.Equal(t, []models.CreditCardStatement{
+		{StartDate: "2025-05-16", CloseDate: "2025-06-15", DueDate: "2025-07-05", Charges: models.NewMoney(1300),
+			Credits: models.NewMoney(100), Balance: models.NewMoney(1200), MinimumDue: models.NewMoney(60),
+			Paid: models.NewMoney(1200), PaidInFull: true, MinimumPaid: true},
+		{StartDate: "2025-04-16", CloseDate: "2025-05-15", DueDate: "2025-06-05", PaidInFull: true, MinimumPaid: true},
+	}, summary.Statements)
+
+	assert.Equal(t, models.NewMoney(1200), summary.Outstanding)
+	assert.Equal(t, models.NewMoney(8800), summary.AvailableCredit)
+	assert.Equal(t, float64(12), summary.Utilisation)
+	assert.Equal(t, models.Money(0), summary.StatementBalance)
+	assert.Equal(t, models.Money(0), summary.MinimumDue)
+	assert.Equal(t, "2025-07-05", summary.DueDate)
+}
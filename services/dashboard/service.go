@@ -1,25 +1,41 @@
 package dashboard
 
 import (
+	"errors"
 	"fmt"
 	"gofr.dev/pkg/gofr"
-	"math/rand"
 	"moneyManagement/filters"
 	"moneyManagement/models"
 	"moneyManagement/services"
+	"sort"
+	"strings"
 	"time"
 )
 
 type dashboardService struct {
 	accountSvc      services.Account
 	transactionsSvc services.Transactions
+	categorySvc     services.Categories
+	budgetSvc       services.Budgets
+	exchangeRateSvc services.ExchangeRates
 	userSvc         services.User
 }
 
-func New(accountSvc services.Account, transactionsSvc services.Transactions, userSvc services.User) services.Dashboard {
-	return &dashboardService{accountSvc: accountSvc, transactionsSvc: transactionsSvc, userSvc: userSvc}
+func New(accountSvc services.Account, transactionsSvc services.Transactions, categorySvc services.Categories,
+	budgetSvc services.Budgets, exchangeRateSvc services.ExchangeRates, userSvc services.User) services.Dashboard {
+	return &dashboardService{accountSvc: accountSvc, transactionsSvc: transactionsSvc, categorySvc: categorySvc,
+		budgetSvc: budgetSvc, exchangeRateSvc: exchangeRateSvc, userSvc: userSvc}
 }
 
+// periodTotals are the totals of a dashboard period and the amounts of each category, in the home currency.
+type periodTotals struct {
+	income, expense, savings                models.Money
+	incomeMap, expenseMap, savingsMap       map[string]models.Money
+	incomeChart, expenseChart, savingsChart []models.ChartData
+}
+
+// Get returns the dashboard of one account, of the accounts in f.AccountIDs, or of all the accounts of the user when
+// neither is set. With f.CompareTo it also holds the same figures for the period it is compared with.
 func (s *dashboardService) Get(ctx *gofr.Context, f *filters.Transactions) (models.Dashboard, error) {
 	var dashboard models.Dashboard
 
@@ -27,113 +43,497 @@ func (s *dashboardService) Get(ctx *gofr.Context, f *filters.Transactions) (mode
 
 	f.UserID = userID
 
-	transactions, err := s.transactionsSvc.GetAll(ctx, f)
+	var start, end string
+
+	if f.CompareTo != "" {
+		var err error
+
+		start, end, err = comparisonWindow(f.StartDate, f.EndDate, f.CompareTo)
+		if err != nil {
+			return models.Dashboard{}, err
+		}
+	}
+
+	// Every amount is converted to the home currency of the user at the rate of its transaction date
+	from, to := rateRange(f, start, time.Now().UTC().Format("2006-01-02"))
+
+	converter, err := s.exchangeRateSvc.Converter(ctx, from, to)
 	if err != nil {
 		return models.Dashboard{}, err
 	}
 
-	account, err := s.accountSvc.GetByID(ctx, f.AccountID)
+	dashboard.Currency = converter.HomeCurrency
+
+	dashboard.RemainingBalance, err = s.remainingBalance(ctx, converter, f)
 	if err != nil {
 		return models.Dashboard{}, err
 	}
 
-	expenseMap := make(map[string]float64)
-	incomeMap := make(map[string]float64)
-	savingsMap := make(map[string]float64)
+	categories, err := s.categorySvc.GetAll(ctx, &filters.Category{IncludeArchived: true})
+	if err != nil {
+		return models.Dashboard{}, err
+	}
+
+	paths, colors := categoryPaths(categories), categoryColors(categories)
+
+	current, err := s.periodTotals(ctx, converter, f, paths, colors)
+	if err != nil {
+		return models.Dashboard{}, err
+	}
+
+	dashboard.TotalIncome, dashboard.TotalExpense, dashboard.TotalSavings = current.income, current.expense, current.savings
+	dashboard.IncomeBreakdown, dashboard.ExpenseBreakdown, dashboard.SavingsBreakdown = current.incomeChart,
+		current.expenseChart, current.savingsChart
+
+	dashboard.Envelopes, err = s.envelopes(ctx, f)
+	if err != nil {
+		return models.Dashboard{}, err
+	}
+
+	if f.CompareTo == "" {
+		return dashboard, nil
+	}
+
+	previous, err := s.periodTotals(ctx, converter, &filters.Transactions{UserID: f.UserID, AccountID: f.AccountID,
+		AccountIDs: f.AccountIDs, StartDate: start, EndDate: end, Depth: f.Depth}, paths, colors)
+	if err != nil {
+		return models.Dashboard{}, err
+	}
+
+	dashboard.Comparison = &models.DashboardComparison{
+		CompareTo:        f.CompareTo,
+		StartDate:        start,
+		EndDate:          end,
+		TotalIncome:      previous.income,
+		TotalExpense:     previous.expense,
+		TotalSavings:     previous.savings,
+		ExpenseBreakdown: previous.expenseChart,
+		SavingsBreakdown: previous.savingsChart,
+		IncomeBreakdown:  previous.incomeChart,
+		Income:           models.NewDelta("", current.income, previous.income),
+		Expense:          models.NewDelta("", current.expense, previous.expense),
+		Savings:          models.NewDelta("", current.savings, previous.savings),
+		ExpenseDeltas:    breakdownDeltas(current.expenseChart, previous.expenseChart),
+		SavingsDeltas:    breakdownDeltas(current.savingsChart, previous.savingsChart),
+		IncomeDeltas:     breakdownDeltas(current.incomeChart, previous.incomeChart),
+	}
+
+	return dashboard, nil
+}
+
+// periodTotals adds up the transactions matching f by type and category. Breakdowns are nested along paths when the
+// filter has a depth, and colored with the colors of their categories.
+func (s *dashboardService) periodTotals(ctx *gofr.Context, converter *models.Converter, f *filters.Transactions,
+	paths map[models.Type]map[string][]string, colors map[models.Type]map[string]string) (*periodTotals, error) {
+	transactions, err := s.transactionsSvc.GetAll(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+
+	totals := &periodTotals{incomeMap: make(map[string]models.Money), expenseMap: make(map[string]models.Money),
+		savingsMap: make(map[string]models.Money)}
+
+	for _, original := range transactions {
+		txn, err := toHome(converter, original)
+		if err != nil {
+			return nil, err
+		}
 
-	for _, txn := range transactions {
 		switch txn.Type {
 		case models.EXPENSE:
-			dashboard.TotalExpense += txn.Amount
-			expenseMap[txn.Category] += txn.Amount
+			totals.expense += txn.Amount
+			addToBreakdown(totals.expenseMap, txn)
 		case models.INCOME:
-			dashboard.TotalIncome += txn.Amount
-			incomeMap[txn.Category] += txn.Amount
+			totals.income += txn.Amount
+			addToBreakdown(totals.incomeMap, txn)
 		case models.SAVINGS:
-			dashboard.TotalSavings += txn.Amount
-			savingsMap[txn.Category] += txn.Amount
+			totals.savings += txn.Amount
+			totals.savingsMap[txn.Category] += txn.Amount
+		case models.TRANSFER:
+			// Transfers only move money between the user's own accounts, they are neither income nor expense
 		}
 	}
 
-	dashboard.RemainingBalance = account.Balance
+	if f.Depth == 0 {
+		totals.expenseChart = mapToChartData(totals.expenseMap)
+		totals.incomeChart = mapToChartData(totals.incomeMap)
+		totals.savingsChart = mapToChartData(totals.savingsMap)
+	} else {
+		totals.expenseChart = nestedChartData(totals.expenseMap, paths[models.EXPENSE], f.Depth)
+		totals.incomeChart = nestedChartData(totals.incomeMap, paths[models.INCOME], f.Depth)
+		totals.savingsChart = nestedChartData(totals.savingsMap, paths[models.SAVINGS], f.Depth)
+	}
 
-	dashboard.ExpenseBreakdown = mapToChartData(expenseMap)
-	dashboard.IncomeBreakdown = mapToChartData(incomeMap)
-	dashboard.SavingsBreakdown = mapToChartData(savingsMap)
+	colorChart(totals.expenseChart, colors[models.EXPENSE], make(map[string]bool))
+	colorChart(totals.incomeChart, colors[models.INCOME], make(map[string]bool))
+	colorChart(totals.savingsChart, colors[models.SAVINGS], make(map[string]bool))
 
-	return dashboard, nil
+	return totals, nil
 }
 
-func mapToChartData(data map[string]float64) []models.ChartData {
-	var chartData []models.ChartData
+// remainingBalance is the current balance of the dashboard account, or the total balance of the dashboard accounts in
+// the home currency.
+func (s *dashboardService) remainingBalance(ctx *gofr.Context, converter *models.Converter,
+	f *filters.Transactions) (models.Money, error) {
+	today := time.Now().UTC().Format("2006-01-02")
+
+	if f.AccountID != 0 {
+		account, err := s.accountSvc.GetByID(ctx, f.AccountID)
+		if err != nil {
+			return 0, err
+		}
+
+		if account == nil {
+			return 0, errors.New("account not found")
+		}
+
+		return converter.ToHome(account.Balance, account.Currency, today)
+	}
+
+	accounts, err := s.accountSvc.GetAll(ctx, &filters.Account{UserID: f.UserID})
+	if err != nil {
+		return 0, err
+	}
+
+	byID := make(map[int]*models.Account, len(accounts))
+	for _, account := range accounts {
+		byID[account.ID] = account
+	}
+
+	// Without a list of accounts the dashboard covers every active account
+	ids := f.AccountIDs
+	if len(ids) == 0 {
+		for _, account := range accounts {
+			if account.Status == "ACTIVE" {
+				ids = append(ids, account.ID)
+			}
+		}
+	}
+
+	var total models.Money
+
+	for _, id := range ids {
+		account, ok := byID[id]
+		if !ok {
+			return 0, fmt.Errorf("account %d not found", id)
+		}
+
+		balance, err := converter.ToHome(account.Balance, account.Currency, today)
+		if err != nil {
+			return 0, err
+		}
+
+		total += balance
+	}
+
+	return total, nil
+}
+
+// rateRange returns the days the amounts of a dashboard are converted on: from the start of its period, or of the
+// period it is compared with, to the later of the end of its period and today, the day balances are converted on.
+func rateRange(f *filters.Transactions, comparisonStart, today string) (string, string) {
+	from, to := f.StartDate, f.EndDate
+
+	if comparisonStart != "" {
+		from = min(from, comparisonStart)
+	}
+
+	if to != "" && to < today {
+		to = today
+	}
+
+	return from, to
+}
+
+// comparisonWindow returns the start and end, as "YYYY-MM-DD hh:mm:ss", of the period a dashboard from start to end
+// is compared with. A range of whole calendar months is compared with as many whole months, any other range with the
+// same number of days.
+func comparisonWindow(startDate, endDate, compareTo string) (string, string, error) {
+	const layout = "2006-01-02 15:04:05"
+
+	start, err := time.Parse(layout, startDate)
+	if err != nil {
+		return "", "", errors.New("invalid startDate, use YYYY-MM-DD")
+	}
+
+	end, err := time.Parse(layout, endDate)
+	if err != nil {
+		return "", "", errors.New("invalid endDate, use YYYY-MM-DD")
+	}
+
+	if end.Before(start) {
+		return "", "", errors.New("startDate must not be after endDate")
+	}
+
+	wholeMonths := start.Day() == 1 && isMonthEnd(end)
+
+	switch compareTo {
+	case models.ComparePreviousYear:
+		start = start.AddDate(-1, 0, 0)
+
+		if isMonthEnd(end) {
+			end = lastDayOfMonth(end.Year()-1, end.Month(), end)
+		} else {
+			end = end.AddDate(-1, 0, 0)
+		}
+	case models.ComparePreviousPeriod:
+		if wholeMonths {
+			months := (end.Year()-start.Year())*12 + int(end.Month()-start.Month()) + 1
+			end = lastDayOfMonth(start.Year(), start.Month()-1, end)
+			start = start.AddDate(0, -months, 0)
+
+			break
+		}
+
+		days := int(dateOf(end).Sub(dateOf(start)).Hours()/24) + 1
+		start, end = start.AddDate(0, 0, -days), end.AddDate(0, 0, -days)
+	default:
+		return "", "", errors.New("invalid compareTo, use previousPeriod or previousYear")
+	}
+
+	return start.Format(layout), end.Format(layout), nil
+}
+
+func isMonthEnd(t time.Time) bool {
+	return t.AddDate(0, 0, 1).Day() == 1
+}
+
+// lastDayOfMonth returns the last day of the month at the time of day of clock.
+func lastDayOfMonth(year int, month time.Month, clock time.Time) time.Time {
+	return time.Date(year, month+1, 0, clock.Hour(), clock.Minute(), clock.Second(), 0, time.UTC)
+}
+
+func dateOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// breakdownDeltas compares the top-level categories of two breakdowns, the biggest changes first. A category missing
+// from one of them counts as zero there.
+func breakdownDeltas(current, previous []models.ChartData) []models.Delta {
+	previousValues := make(map[string]models.Money, len(previous))
+	for _, data := range previous {
+		previousValues[data.Name] = data.Value
+	}
+
+	deltas := make([]models.Delta, 0, len(current))
+
+	for _, data := range current {
+		deltas = append(deltas, models.NewDelta(data.Name, data.Value, previousValues[data.Name]))
+		delete(previousValues, data.Name)
+	}
+
+	for name, value := range previousValues {
+		deltas = append(deltas, models.NewDelta(name, 0, value))
+	}
+
+	sort.Slice(deltas, func(i, j int) bool {
+		if deltas[i].Change.Abs() != deltas[j].Change.Abs() {
+			return deltas[i].Change.Abs() > deltas[j].Change.Abs()
+		}
+
+		return deltas[i].Name < deltas[j].Name
+	})
+
+	return deltas
+}
+
+// envelopes returns the budget envelope figures of the month the dashboard covers, or nil when the dashboard does
+// not cover exactly one calendar month.
+func (s *dashboardService) envelopes(ctx *gofr.Context, f *filters.Transactions) (*models.EnvelopeSummary, error) {
+	start, err := time.Parse("2006-01-02 15:04:05", f.StartDate)
+	if err != nil {
+		return nil, nil
+	}
+
+	end, err := time.Parse("2006-01-02 15:04:05", f.EndDate)
+	if err != nil || start.Format("2006-01") != end.Format("2006-01") {
+		return nil, nil
+	}
+
+	status, err := s.budgetSvc.Status(ctx, start.Format("2006-01"))
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.EnvelopeSummary{
+		Month:             status.Month,
+		Income:            status.Income,
+		Assigned:          status.TotalLimit,
+		CarryOver:         status.TotalCarryOver,
+		AvailableToAssign: status.AvailableToAssign,
+	}, nil
+}
+
+// categoryPaths maps every category name of each type to the names from its top-level parent down to itself.
+func categoryPaths(categories []*models.Category) map[models.Type]map[string][]string {
+	byID := make(map[int]*models.Category, len(categories))
+	for _, category := range categories {
+		byID[category.ID] = category
+	}
+
+	paths := make(map[models.Type]map[string][]string)
+
+	for _, category := range categories {
+		path := []string{category.Name}
+		visited := map[int]bool{category.ID: true}
+
+		for parentID := category.ParentID; parentID != nil && !visited[*parentID]; {
+			parent, ok := byID[*parentID]
+			if !ok {
+				break
+			}
+
+			visited[parent.ID] = true
+			path = append([]string{parent.Name}, path...)
+			parentID = parent.ParentID
+		}
+
+		if paths[category.Type] == nil {
+			paths[category.Type] = make(map[string][]string)
+		}
+
+		paths[category.Type][category.Name] = path
+	}
+
+	return paths
+}
 
-	var assignedColors = make(map[string]string)
-	var usedColors = make(map[string]bool)
+type breakdownNode struct {
+	value    models.Money
+	children map[string]*breakdownNode
+}
+
+// nestedChartData rolls amounts up the category tree to the given depth, nesting sub-categories under their
+// parents. Categories that are not in paths are treated as top-level ones.
+func nestedChartData(data map[string]models.Money, paths map[string][]string, depth int) []models.ChartData {
+	root := &breakdownNode{children: make(map[string]*breakdownNode)}
 
 	for category, value := range data {
-		color := getCategoryColor(category, assignedColors, usedColors)
+		path, ok := paths[category]
+		if !ok {
+			path = []string{category}
+		}
+
+		if len(path) > depth {
+			path = path[:depth]
+		}
+
+		node := root
+		for _, name := range path {
+			child, ok := node.children[name]
+			if !ok {
+				child = &breakdownNode{children: make(map[string]*breakdownNode)}
+				node.children[name] = child
+			}
+
+			child.value += value
+			node = child
+		}
+	}
+
+	return nodeToChartData(root)
+}
+
+func nodeToChartData(node *breakdownNode) []models.ChartData {
+	var chartData []models.ChartData
+
+	for category, child := range node.children {
 		chartData = append(chartData, models.ChartData{
-			Name:  category,
-			Value: value,
-			Color: color,
+			Name:     category,
+			Value:    child.value,
+			Children: nodeToChartData(child),
 		})
 	}
 
 	return chartData
 }
 
-// Generates a unique pastel color in HEX
-func getUniquePastelColor(usedColors map[string]bool) string {
-	rand.Seed(time.Now().UnixNano())
+// toHome returns a copy of a transaction with its amount and split amounts converted to the home currency.
+func toHome(converter *models.Converter, txn *models.Transaction) (*models.Transaction, error) {
+	converted := *txn
 
-	for {
-		h := rand.Intn(360)
-		s, v := 0.5, 0.9
-		r, g, b := hsvToRgb(float64(h), s, v)
-		hex := fmt.Sprintf("#%02X%02X%02X", r, g, b)
+	var err error
 
-		if !usedColors[hex] {
-			usedColors[hex] = true
-			return hex
+	converted.Amount, err = converter.ToHome(txn.Amount, txn.Currency, txn.TransactionDate)
+	if err != nil {
+		return nil, err
+	}
+
+	converted.Splits = make([]models.TransactionSplit, len(txn.Splits))
+
+	for i, split := range txn.Splits {
+		converted.Splits[i] = split
+
+		converted.Splits[i].Amount, err = converter.ToHome(split.Amount, txn.Currency, txn.TransactionDate)
+		if err != nil {
+			return nil, err
 		}
 	}
+
+	return &converted, nil
 }
 
-// Converts HSV to RGB
-func hsvToRgb(h, s, v float64) (uint8, uint8, uint8) {
-	c := v * s
-	x := c * (1 - absMod(h/60.0, 2) - 1)
-	m := v - c
+// addToBreakdown attributes a transaction to its category, or to each split's category when it is split.
+func addToBreakdown(breakdown map[string]models.Money, txn *models.Transaction) {
+	if len(txn.Splits) == 0 {
+		breakdown[txn.Category] += txn.Amount
+		return
+	}
 
-	var r, g, b float64
+	for _, split := range txn.Splits {
+		breakdown[split.Category] += split.Amount
+	}
+}
 
-	switch {
-	case h < 60:
-		r, g, b = c, x, 0
-	case h < 120:
-		r, g, b = x, c, 0
-	case h < 180:
-		r, g, b = 0, c, x
-	case h < 240:
-		r, g, b = 0, x, c
-	case h < 300:
-		r, g, b = x, 0, c
-	default:
-		r, g, b = c, 0, x
+func mapToChartData(data map[string]models.Money) []models.ChartData {
+	var chartData []models.ChartData
+
+	for category, value := range data {
+		chartData = append(chartData, models.ChartData{
+			Name:  category,
+			Value: value,
+		})
 	}
 
-	return uint8((r + m) * 255), uint8((g + m) * 255), uint8((b + m) * 255)
+	return chartData
 }
 
-func absMod(a, b float64) float64 {
-	return a - float64(int(a/b))*b
+// categoryColors maps every category name of each type to its color.
+func categoryColors(categories []*models.Category) map[models.Type]map[string]string {
+	colors := make(map[models.Type]map[string]string)
+
+	for _, category := range categories {
+		if colors[category.Type] == nil {
+			colors[category.Type] = make(map[string]string)
+		}
+
+		colors[category.Type][category.Name] = strings.ToUpper(category.Color)
+	}
+
+	return colors
 }
 
-func getCategoryColor(category string, assignedColors map[string]string, usedColors map[string]bool) string {
-	if color, exists := assignedColors[category]; exists {
-		return color
+// colorChart orders the slices of a chart and their children by value, largest first, and colors each with the color
+// of its category. A slice whose category has no color, or whose color is already used in the chart, gets the next
+// free color derived from its name, so no two slices of a chart share a color.
+func colorChart(chart []models.ChartData, colors map[string]string, used map[string]bool) {
+	sort.Slice(chart, func(i, j int) bool {
+		if chart[i].Value != chart[j].Value {
+			return chart[i].Value > chart[j].Value
+		}
+
+		return chart[i].Name < chart[j].Name
+	})
+
+	for i := range chart {
+		color := colors[chart[i].Name]
+		if color == "" || used[color] {
+			color = models.CategoryColor(chart[i].Name, used)
+		}
+
+		used[color] = true
+		chart[i].Color = color
+
+		colorChart(chart[i].Children, colors, used)
 	}
-	color := getUniquePastelColor(usedColors)
-	assignedColors[category] = color
-	return color
 }
@@ -0,0 +1,123 @@
+package dashboard
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"moneyManagement/filters"
+	"moneyManagement/models"
+)
+
+func Test_ComparisonWindow(t *testing.T) {
+	tests := []struct {
+		description   string
+		start, end    string
+		compareTo     string
+		expectedStart string
+		expectedEnd   string
+		expectedErr   error
+	}{
+		{"a month with the month before", "2025-03-01 00:00:00", "2025-03-31 23:59:59", models.ComparePreviousPeriod,
+			"2025-02-01 00:00:00", "2025-02-28 23:59:59", nil},
+		{"a quarter with the quarter before", "2025-04-01 00:00:00", "2025-06-30 23:59:59", models.ComparePreviousPeriod,
+			"2025-01-01 00:00:00", "2025-03-31 23:59:59", nil},
+		{"days with as many days before", "2025-03-10 00:00:00", "2025-03-16 23:59:59", models.ComparePreviousPeriod,
+			"2025-03-03 00:00:00", "2025-03-09 23:59:59", nil},
+		{"a month with the same month a year earlier", "2024-02-01 00:00:00", "2024-02-29 23:59:59",
+			models.ComparePreviousYear, "2023-02-01 00:00:00", "2023-02-28 23:59:59", nil},
+		{"days with the same days a year earlier", "2025-03-10 00:00:00", "2025-03-16 23:59:59",
+			models.ComparePreviousYear, "2024-03-10 00:00:00", "2024-03-16 23:59:59", nil},
+		{"unknown comparison", "2025-03-01 00:00:00", "2025-03-31 23:59:59", "lastWeek", "", "",
+			errors.New("invalid compareTo, use previousPeriod or previousYear")},
+		{"invalid start date", "01-03-2025 00:00:00", "2025-03-31 23:59:59", models.ComparePreviousPeriod, "", "",
+			errors.New("invalid startDate, use YYYY-MM-DD")},
+	}
+
+	for i, tc := range tests {
+		start, end, err := comparisonWindow(tc.start, tc.end, tc.compareTo)
+
+		assert.Equalf(t, tc.expectedStart, start, "TEST[%d], failed.\n%s", i, tc.description)
+		assert.Equalf(t, tc.expectedEnd, end, "TEST[%d], failed.\n%s", i, tc.description)
+
+		assert.Equalf(t, tc.expectedErr, err, "TEST[%d], failed.\n%s", i, tc.description)
+	}
+}
+
+func Test_RateRange(t *testing.T) {
+	tests := []struct {
+		description     string
+		start, end      string
+		comparisonStart string
+		expectedFrom    string
+		expectedTo      string
+	}{
+		{"a past period runs to today", "2025-03-01 00:00:00", "2025-03-31 23:59:59", "", "2025-03-01 00:00:00",
+			"2025-05-10"},
+		{"a period ending after today", "2025-05-01 00:00:00", "2025-05-31 23:59:59", "", "2025-05-01 00:00:00",
+			"2025-05-31 23:59:59"},
+		{"a compared period starts earlier", "2025-03-01 00:00:00", "2025-03-31 23:59:59", "2025-02-01 00:00:00",
+			"2025-02-01 00:00:00", "2025-05-10"},
+		{"no period leaves the range open", "", "", "", "", ""},
+	}
+
+	for i, tc := range tests {
+		from, to := rateRange(&filters.Transactions{StartDate: tc.start, EndDate: tc.end}, tc.comparisonStart,
+			"2025-05-10")
+
+		assert.Equalf(t, tc.expectedFrom, from, "TEST[%d], failed.\n%s", i, tc.description)
+		assert.Equalf(t, tc.expectedTo, to, "TEST[%d], failed.\n%s", i, tc.description)
+	}
+}
+
+func Test_BreakdownDeltas(t *testing.T) {
+	current := []models.ChartData{{Name: "Food", Value: models.NewMoney(300)}, {Name: "Rent", Value: models.NewMoney(1000)}}
+	previous := []models.ChartData{{Name: "Rent", Value: models.NewMoney(1000)}, {Name: "Food", Value: models.NewMoney(200)},
+		{Name: "Travel", Value: models.NewMoney(500)}}
+
+	fifty, minusHundred := 50.0, -100.0
+
+	expected := []models.Delta{
+		{Name: "Travel", Current: 0, Previous: models.NewMoney(500), Change: models.NewMoney(-500), Percent: &minusHundred},
+		{Name: "Food", Current: models.NewMoney(300), Previous: models.NewMoney(200), Change: models.NewMoney(100),
+			Percent: &fifty},
+		{Name: "Rent", Current: models.NewMoney(1000), Previous: models.NewMoney(1000), Change: 0, Percent: new(float64)},
+	}
+
+	assert.Equal(t, expected, breakdownDeltas(current, previous))
+
+	// A category that is new this period has no percentage change
+	assert.Equal(t, []models.Delta{{Name: "Gifts", Current: models.NewMoney(40), Change: models.NewMoney(40)}},
+		breakdownDeltas([]models.ChartData{{Name: "Gifts", Value: models.NewMoney(40)}}, nil))
+}
+
+func Test_ColorChart(t *testing.T) {
+	chart := []models.ChartData{
+		{Name: "Rent", Value: models.NewMoney(1000)},
+		{Name: "Food", Value: models.NewMoney(300), Children: []models.ChartData{
+			{Name: "Groceries", Value: models.NewMoney(100)},
+			{Name: "Restaurants", Value: models.NewMoney(200)},
+		}},
+		{Name: "Travel", Value: models.NewMoney(3000)},
+	}
+
+	colors := map[string]string{"Rent": "#AABBCC", "Food": "#AABBCC", "Restaurants": "#112233"}
+
+	colorChart(chart, colors, make(map[string]bool))
+
+	// Ordered by value with each category in its own color, a repeated color is replaced by a free one
+	assert.Equal(t, []string{"Travel", "Rent", "Food"}, []string{chart[0].Name, chart[1].Name, chart[2].Name})
+	assert.Equal(t, models.CategoryColor("Travel", map[string]bool{}), chart[0].Color)
+	assert.Equal(t, "#AABBCC", chart[1].Color)
+	assert.NotEqual(t, "#AABBCC", chart[2].Color)
+	assert.Equal(t, "Restaurants", chart[2].Children[0].Name)
+	assert.Equal(t, "#112233", chart[2].Children[0].Color)
+
+	seen := make(map[string]bool)
+	for _, color := range []string{chart[0].Color, chart[1].Color, chart[2].Color, chart[2].Children[0].Color,
+		chart[2].Children[1].Color} {
+		assert.False(t, seen[color], "colors are distinct within a chart")
+		seen[color] = true
+	}
+}
@@ -0,0 +1,180 @@
+package exchangeRates
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"gofr.dev/pkg/gofr"
+	"io"
+	"moneyManagement/filters"
+	"moneyManagement/models"
+	"moneyManagement/services"
+	"moneyManagement/stores"
+	"strconv"
+	"strings"
+)
+
+type exchangeRateSvc struct {
+	exchangeRateStore stores.ExchangeRates
+	userSvc           services.User
+}
+
+func New(exchangeRateStore stores.ExchangeRates, userSvc services.User) services.ExchangeRates {
+	return &exchangeRateSvc{
+		exchangeRateStore: exchangeRateStore,
+		userSvc:           userSvc,
+	}
+}
+
+// Create validates every rate and loads them all in a single SQL transaction, replacing rates that already exist for
+// the same pair and date.
+func (s *exchangeRateSvc) Create(ctx *gofr.Context, rates []*models.ExchangeRate) ([]*models.ExchangeRate, error) {
+	if len(rates) == 0 {
+		return nil, errors.New("no exchange rates")
+	}
+
+	for i, rate := range rates {
+		err := rate.Validate()
+		if err != nil {
+			return nil, fmt.Errorf("rate %d: %w", i+1, err)
+		}
+	}
+
+	tx, err := ctx.SQL.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	for _, rate := range rates {
+		err = s.exchangeRateStore.Upsert(ctx, rate, tx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return rates, nil
+}
+
+// Import loads exchange rates from a CSV file with from, to, rate and date columns or a JSON array of rates.
+func (s *exchangeRateSvc) Import(ctx *gofr.Context, file io.Reader, format string) ([]*models.ExchangeRate, error) {
+	var (
+		rates []*models.ExchangeRate
+		err   error
+	)
+
+	switch strings.ToLower(format) {
+	case "csv":
+		rates, err = parseCSV(file)
+	case "json":
+		err = json.NewDecoder(file).Decode(&rates)
+		if err != nil {
+			err = errors.New("invalid json file")
+		}
+	default:
+		err = errors.New("unsupported file format, use csv or json")
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Create(ctx, rates)
+}
+
+func (s *exchangeRateSvc) GetAll(ctx *gofr.Context, f *filters.ExchangeRate) ([]*models.ExchangeRate, error) {
+	rates, err := s.exchangeRateStore.GetAll(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+
+	return rates, nil
+}
+
+// Converter returns a converter to the home currency of the user in ctx for amounts dated from from to to, dates or
+// timestamps, either of which may be empty to leave the range open. It is loaded with the rates between the currencies
+// of the user's accounts in effect on those days only, so it must not be used for amounts dated outside the range.
+func (s *exchangeRateSvc) Converter(ctx *gofr.Context, from, to string) (*models.Converter, error) {
+	userID, _ := ctx.Value("userID").(int)
+
+	homeCurrency := models.DefaultCurrency
+
+	user, err := s.userSvc.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user != nil && user.HomeCurrency != "" {
+		homeCurrency = user.HomeCurrency
+	}
+
+	rates, err := s.exchangeRateStore.GetInEffect(ctx, userID, homeCurrency, dayOf(from, "1000-01-01"),
+		dayOf(to, "9999-12-31"))
+	if err != nil {
+		return nil, err
+	}
+
+	return models.NewConverter(homeCurrency, rates), nil
+}
+
+// dayOf returns the day of a date or timestamp, or open, the furthest day MySQL dates reach, when it is empty.
+func dayOf(date, open string) string {
+	if date == "" {
+		return open
+	}
+
+	return date[:min(len(date), len("2006-01-02"))]
+}
+
+func parseCSV(file io.Reader) ([]*models.ExchangeRate, error) {
+	reader := csv.NewReader(file)
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(records) == 0 {
+		return nil, errors.New("empty csv file")
+	}
+
+	columns := make(map[string]int)
+	for i, name := range records[0] {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+
+	for _, name := range []string{"from", "to", "rate", "date"} {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("missing column %q", name)
+		}
+	}
+
+	rates := make([]*models.ExchangeRate, 0, len(records)-1)
+
+	for i, record := range records[1:] {
+		rate, err := strconv.ParseFloat(strings.TrimSpace(record[columns["rate"]]), 64)
+		if err != nil {
+			// Row numbers are 1-based and the header is row 1
+			return nil, fmt.Errorf("row %d: invalid rate", i+2)
+		}
+
+		rates = append(rates, &models.ExchangeRate{
+			From: record[columns["from"]],
+			To:   record[columns["to"]],
+			Rate: rate,
+			Date: strings.TrimSpace(record[columns["date"]]),
+		})
+	}
+
+	return rates, nil
+}
@@ -0,0 +1,231 @@
+package forecast
+
+import (
+	"errors"
+	"gofr.dev/pkg/gofr"
+	"moneyManagement/filters"
+	"moneyManagement/models"
+	"moneyManagement/services"
+	"sort"
+	"time"
+)
+
+const (
+	defaultDays = 90
+	maxDays     = 365
+
+	// historyDays of spending outside recurring transactions make up the daily discretionary spending
+	historyDays = 90
+)
+
+type forecastSvc struct {
+	accountSvc              services.Account
+	transactionSvc          services.Transactions
+	recurringTransactionSvc services.RecurringTransactions
+}
+
+func New(accountSvc services.Account, transactionSvc services.Transactions,
+	recurringTransactionSvc services.RecurringTransactions) services.Forecast {
+	return &forecastSvc{
+		accountSvc:              accountSvc,
+		transactionSvc:          transactionSvc,
+		recurringTransactionSvc: recurringTransactionSvc,
+	}
+}
+
+// Get projects the daily balance of an account over the next days days, 90 by default, from its recurring
+// transactions and its average spending outside them over the last 90 days. It alerts on the days the balance is
+// projected to go negative or under threshold.
+func (s *forecastSvc) Get(ctx *gofr.Context, accountID, days int, threshold models.Money) (*models.Forecast, error) {
+	if days == 0 {
+		days = defaultDays
+	}
+
+	if days < 0 || days > maxDays {
+		return nil, errors.New("days must be between 1 and 365")
+	}
+
+	account, err := s.accountSvc.GetByID(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if account == nil {
+		return nil, errors.New("account not found")
+	}
+
+	rules, err := s.recurringTransactionSvc.GetAll(ctx, &filters.RecurringTransactions{AccountID: accountID})
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	until := today.AddDate(0, 0, days)
+
+	due, err := dueItems(rules, accountID, today, until)
+	if err != nil {
+		return nil, err
+	}
+
+	discretionary, err := s.dailyDiscretionary(ctx, account, rules, today)
+	if err != nil {
+		return nil, err
+	}
+
+	forecast := &models.Forecast{
+		Account:            models.AccountDetails{ID: account.ID, Name: account.Name},
+		Currency:           account.Currency,
+		From:               today.AddDate(0, 0, 1).Format("2006-01-02"),
+		To:                 until.Format("2006-01-02"),
+		StartingBalance:    account.Balance,
+		Threshold:          threshold,
+		DailyDiscretionary: discretionary,
+	}
+
+	project(forecast, due, today, days)
+
+	return forecast, nil
+}
+
+// dailyDiscretionary is the average daily spending from the account over the last 90 days, or since it was opened
+// when that is later, leaving out the spending that matches one of its recurring transactions.
+func (s *forecastSvc) dailyDiscretionary(ctx *gofr.Context, account *models.Account,
+	rules []*models.RecurringTransaction, today time.Time) (models.Money, error) {
+	start := today.AddDate(0, 0, -historyDays)
+
+	if opened, err := time.Parse("2006-01-02", account.OpeningDate); err == nil && opened.After(start) {
+		start = opened
+	}
+
+	lookback := int64(today.Sub(start).Hours() / 24)
+	if lookback <= 0 {
+		return 0, nil
+	}
+
+	transactions, err := s.transactionSvc.GetAll(ctx, &filters.Transactions{AccountID: account.ID,
+		Type: []string{string(models.EXPENSE)}, StartDate: start.Format("2006-01-02") + " 00:00:00",
+		EndDate: today.AddDate(0, 0, -1).Format("2006-01-02") + " 23:59:59"})
+	if err != nil {
+		return 0, err
+	}
+
+	return discretionarySpending(transactions, rules, account.ID).MulDiv(1, lookback), nil
+}
+
+// discretionarySpending adds up the expenses from the account that no recurring transaction of the same category and
+// amount accounts for.
+func discretionarySpending(transactions []*models.Transaction, rules []*models.RecurringTransaction,
+	accountID int) models.Money {
+	type ruleKey struct {
+		category string
+		amount   models.Money
+	}
+
+	recurring := make(map[ruleKey]bool, len(rules))
+	for _, rule := range rules {
+		if rule.Type == models.EXPENSE {
+			recurring[ruleKey{category: rule.Category, amount: rule.Amount}] = true
+		}
+	}
+
+	var total models.Money
+
+	for _, transaction := range transactions {
+		if transaction.Type != models.EXPENSE || transaction.Account.ID != accountID ||
+			recurring[ruleKey{category: transaction.Category, amount: transaction.Amount}] {
+			continue
+		}
+
+		total += transaction.Amount
+	}
+
+	return total
+}
+
+// dueItems groups the occurrences of the recurring transactions of the account up to until by date. Occurrences
+// that are overdue are due tomorrow, the first forecast day, as they will be posted by then.
+func dueItems(rules []*models.RecurringTransaction, accountID int,
+	today, until time.Time) (map[string][]models.ForecastItem, error) {
+	first := today.AddDate(0, 0, 1).Format("2006-01-02")
+	due := make(map[string][]models.ForecastItem)
+
+	// The end of the last day, so that occurrences at any time of that day are included
+	end := until.AddDate(0, 0, 1).Add(-time.Second)
+
+	for _, rule := range rules {
+		if rule.Account.ID != accountID {
+			continue
+		}
+
+		occurrences, err := rule.Occurrences(end)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, occurrence := range occurrences {
+			transaction := models.Transaction{Account: rule.Account, Amount: occurrence.Amount, Type: rule.Type}
+			item := models.ForecastItem{RecurringTransactionID: rule.ID, Type: rule.Type, Category: rule.Category,
+				Description: rule.Description, Amount: transaction.BalanceEffects()[accountID]}
+
+			date := max(occurrence.Date[:len("2006-01-02")], first)
+			if date > until.Format("2006-01-02") {
+				continue
+			}
+
+			due[date] = append(due[date], item)
+		}
+	}
+
+	for _, items := range due {
+		sort.SliceStable(items, func(i, j int) bool {
+			return items[i].RecurringTransactionID < items[j].RecurringTransactionID
+		})
+	}
+
+	return due, nil
+}
+
+// project fills in the balance of every forecast day after today, its lowest point and the alerts.
+func project(forecast *models.Forecast, due map[string][]models.ForecastItem, today time.Time, days int) {
+	balance := forecast.StartingBalance
+	forecast.LowestBalance, forecast.LowestDate = balance, today.Format("2006-01-02")
+	forecast.Points = make([]models.ForecastPoint, 0, days)
+	forecast.Alerts = []models.ForecastAlert{}
+
+	var wasNegative, wasBelow bool
+
+	for day := 1; day <= days; day++ {
+		date := today.AddDate(0, 0, day).Format("2006-01-02")
+		point := models.ForecastPoint{Date: date, Change: -forecast.DailyDiscretionary, Items: due[date]}
+
+		for _, item := range point.Items {
+			point.Change += item.Amount
+		}
+
+		balance += point.Change
+
+		point.Balance = balance
+		point.Negative = balance < 0
+		point.BelowThreshold = balance < forecast.Threshold
+
+		if point.Negative && !wasNegative {
+			forecast.Alerts = append(forecast.Alerts, models.ForecastAlert{Date: date, Reason: models.ForecastNegative,
+				Balance: balance, Items: point.Items})
+		}
+
+		// Under a zero threshold is the same as negative
+		if point.BelowThreshold && !wasBelow && forecast.Threshold != 0 {
+			forecast.Alerts = append(forecast.Alerts, models.ForecastAlert{Date: date,
+				Reason: models.ForecastBelowThreshold, Balance: balance, Items: point.Items})
+		}
+
+		wasNegative, wasBelow = point.Negative, point.BelowThreshold
+
+		if balance < forecast.LowestBalance {
+			forecast.LowestBalance, forecast.LowestDate = balance, date
+		}
+
+		forecast.Points = append(forecast.Points, point)
+	}
+}
@@ -0,0 +1,84 @@
+package forecast
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"moneyManagement/models"
+)
+
+func Test_DueItems(t *testing.T) {
+	today := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	account := models.AccountDetails{ID: 1, Name: "HDFC"}
+
+	rules := []*models.RecurringTransaction{
+		{ID: 2, Account: account, Amount: models.NewMoney(1000), Type: models.INCOME, Category: "Salary",
+			Frequency: models.WEEKLY, NextRun: "2025-06-03T09:00:00.000Z"},
+		// Overdue, it is posted by the first forecast day
+		{ID: 3, Account: account, Amount: models.NewMoney(300), Type: models.EXPENSE, Category: "Rent",
+			Frequency: models.MONTHLY, NextRun: "2025-05-30T00:00:00.000Z"},
+		{ID: 4, Account: models.AccountDetails{ID: 7}, Amount: models.NewMoney(50), Type: models.EXPENSE,
+			Category: "Fuel", Frequency: models.DAILY, NextRun: "2025-06-02T00:00:00.000Z"},
+		{ID: 5, Account: account, Amount: models.NewMoney(20), Type: models.EXPENSE, Category: "Music",
+			Frequency: models.DAILY, NextRun: "2025-06-02T00:00:00.000Z", EndDate: "2025-06-03T00:00:00.000Z"},
+	}
+
+	due, err := dueItems(rules, 1, today, today.AddDate(0, 0, 10))
+
+	salary := models.ForecastItem{RecurringTransactionID: 2, Type: models.INCOME, Category: "Salary",
+		Amount: models.NewMoney(1000)}
+	rent := models.ForecastItem{RecurringTransactionID: 3, Type: models.EXPENSE, Category: "Rent",
+		Amount: models.NewMoney(-300)}
+	music := models.ForecastItem{RecurringTransactionID: 5, Type: models.EXPENSE, Category: "Music",
+		Amount: models.NewMoney(-20)}
+
+	assert.Nil(t, err)
+	assert.Equal(t, map[string][]models.ForecastItem{
+		"2025-06-02": {rent, music},
+		"2025-06-03": {salary, music},
+		"2025-06-10": {salary},
+	}, due)
+}
+
+func Test_DiscretionarySpending(t *testing.T) {
+	account := models.AccountDetails{ID: 1}
+
+	rules := []*models.RecurringTransaction{{Type: models.EXPENSE, Category: "Rent", Amount: models.NewMoney(300)}}
+	transactions := []*models.Transaction{
+		{Account: account, Type: models.EXPENSE, Category: "Rent", Amount: models.NewMoney(300)},
+		{Account: account, Type: models.EXPENSE, Category: "Rent", Amount: models.NewMoney(40)},
+		{Account: account, Type: models.EXPENSE, Category: "Food", Amount: models.NewMoney(60)},
+		{Account: models.AccountDetails{ID: 2}, ToAccount: &account, Type: models.EXPENSE, Category: "Food",
+			Amount: models.NewMoney(500)},
+	}
+
+	assert.Equal(t, models.NewMoney(100), discretionarySpending(transactions, rules, 1))
+}
+
+func Test_Project(t *testing.T) {
+	today := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	rent := models.ForecastItem{RecurringTransactionID: 3, Type: models.EXPENSE, Category: "Rent",
+		Amount: models.NewMoney(-300)}
+	salary := models.ForecastItem{RecurringTransactionID: 2, Type: models.INCOME, Category: "Salary",
+		Amount: models.NewMoney(1000)}
+
+	forecast := &models.Forecast{StartingBalance: models.NewMoney(400), Threshold: models.NewMoney(200),
+		DailyDiscretionary: models.NewMoney(50)}
+
+	project(forecast, map[string][]models.ForecastItem{"2025-06-03": {rent}, "2025-06-04": {salary}}, today, 4)
+
+	assert.Equal(t, []models.ForecastPoint{
+		{Date: "2025-06-02", Balance: models.NewMoney(350), Change: models.NewMoney(-50)},
+		{Date: "2025-06-03", Balance: 0, Change: models.NewMoney(-350), Items: []models.ForecastItem{rent},
+			BelowThreshold: true},
+		{Date: "2025-06-04", Balance: models.NewMoney(950), Change: models.NewMoney(950),
+			Items: []models.ForecastItem{salary}},
+		{Date: "2025-06-05", Balance: models.NewMoney(900), Change: models.NewMoney(-50)},
+	}, forecast.Points)
+	assert.Equal(t, []models.ForecastAlert{{Date: "2025-06-03", Reason: models.ForecastBelowThreshold, Balance: 0,
+		Items: []models.ForecastItem{rent}}}, forecast.Alerts)
+	assert.Equal(t, models.Money(0), forecast.LowestBalance)
+	assert.Equal(t, "2025-06-03", forecast.LowestDate)
+}
@@ -0,0 +1,88 @@
+package holidays
+
+import (
+	"errors"
+	"gofr.dev/pkg/gofr"
+	"moneyManagement/models"
+	"moneyManagement/services"
+	"moneyManagement/stores"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const maxNameLength = 100
+
+type holidaySvc struct {
+	holidayStore stores.Holidays
+}
+
+func New(holidayStore stores.Holidays) services.Holidays {
+	return &holidaySvc{holidayStore: holidayStore}
+}
+
+// Create adds a holiday of the user, one a day at most.
+func (s *holidaySvc) Create(ctx *gofr.Context, holiday *models.Holiday) (*models.Holiday, error) {
+	userID, _ := ctx.Value("userID").(int)
+
+	date, err := time.Parse("2006-01-02", strings.TrimSpace(holiday.Date))
+	if err != nil {
+		return nil, errors.New("invalid date, use YYYY-MM-DD")
+	}
+
+	holiday.Name = strings.TrimSpace(holiday.Name)
+	if utf8.RuneCountInString(holiday.Name) > maxNameLength {
+		return nil, errors.New("holiday name must be at most 100 characters")
+	}
+
+	holidays, err := s.holidayStore.GetAll(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	holiday.UserID = userID
+	holiday.Date = date.Format("2006-01-02")
+
+	for _, existing := range holidays {
+		if existing.Date == holiday.Date {
+			return nil, errors.New("holiday already exists")
+		}
+	}
+
+	err = s.holidayStore.Create(ctx, holiday)
+	if err != nil {
+		return nil, err
+	}
+
+	return holiday, nil
+}
+
+func (s *holidaySvc) GetAll(ctx *gofr.Context) ([]*models.Holiday, error) {
+	userID, _ := ctx.Value("userID").(int)
+
+	holidays, err := s.holidayStore.GetAll(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if holidays == nil {
+		holidays = []*models.Holiday{}
+	}
+
+	return holidays, nil
+}
+
+func (s *holidaySvc) Delete(ctx *gofr.Context, id int) error {
+	userID, _ := ctx.Value("userID").(int)
+
+	deleted, err := s.holidayStore.Delete(ctx, id, userID)
+	if err != nil {
+		return err
+	}
+
+	if !deleted {
+		return errors.New("holiday not found")
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package holidays
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+
+	"moneyManagement/models"
+	"moneyManagement/stores"
+)
+
+func Test_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	holidayStore := stores.NewMockHolidays(ctrl)
+
+	mockContainer, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{Context: context.WithValue(context.Background(), "userID", 1), Container: mockContainer}
+
+	existing := []*models.Holiday{{ID: 1, UserID: 1, Date: "2025-12-25", Name: "Christmas"}}
+
+	tests := []struct {
+		description    string
+		input          *models.Holiday
+		expectedOutput *models.Holiday
+		expectedErr    error
+		execMocks      func()
+	}{
+		{"Success Case", &models.Holiday{Date: " 2026-01-01 ", Name: " New Year "},
+			&models.Holiday{UserID: 1, Date: "2026-01-01", Name: "New Year"}, nil, func() {
+				holidayStore.EXPECT().GetAll(ctx, 1).Return(existing, nil)
+				holidayStore.EXPECT().Create(ctx, &models.Holiday{UserID: 1, Date: "2026-01-01", Name: "New Year"}).
+					Return(nil)
+			}},
+		{"Failure Case: holiday already exists", &models.Holiday{Date: "2025-12-25"}, nil,
+			errors.New("holiday already exists"), func() {
+				holidayStore.EXPECT().GetAll(ctx, 1).Return(existing, nil)
+			}},
+		{"Failure Case: invalid date", &models.Holiday{Date: "25-12-2025"}, nil,
+			errors.New("invalid date, use YYYY-MM-DD"), func() {}},
+		{"Failure Case: error from store layer", &models.Holiday{Date: "2026-01-01"}, nil, errors.New("DB error"),
+			func() {
+				holidayStore.EXPECT().GetAll(ctx, 1).Return(nil, errors.New("DB error"))
+			}},
+	}
+
+	for i, tc := range tests {
+		tc.execMocks()
+
+		output, err := New(holidayStore).Create(ctx, tc.input)
+
+		assert.Equalf(t, tc.expectedOutput, output, "TEST[%d], failed.\n%s", i, tc.description)
+		assert.Equalf(t, tc.expectedErr, err, "TEST[%d], failed.\n%s", i, tc.description)
+	}
+}
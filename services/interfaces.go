@@ -5,8 +5,10 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 	"gofr.dev/pkg/gofr"
 	"gofr.dev/pkg/gofr/datasource/sql"
+	"io"
 	"moneyManagement/filters"
 	"moneyManagement/models"
+	"time"
 )
 
 type User interface {
@@ -26,14 +28,21 @@ type Account interface {
 	UpdateWithTx(ctx *gofr.Context, account *models.Account, tx *sql.Tx) (*models.Account, error)
 	Delete(ctx *gofr.Context, id int) error
 	GetByIDForUpdate(ctx *gofr.Context, id, userID int, tx *sql.Tx) (*models.Account, error)
+	RecordBalanceChanges(ctx *gofr.Context, entries []*models.BalanceLedgerEntry, tx *sql.Tx) error
+	BalanceHistory(ctx *gofr.Context, id int, from, to, interval string) (*models.BalanceHistory, error)
+	CheckBalances(ctx *gofr.Context, repair bool) (*models.BalanceCheckReport, error)
 }
 
 type Transactions interface {
 	Create(ctx *gofr.Context, transaction *models.Transaction) (*models.Transaction, error)
+	CreateWithTx(ctx *gofr.Context, transaction *models.Transaction, tx *sql.Tx) error
 	GetAll(ctx *gofr.Context, f *filters.Transactions) ([]*models.Transaction, error)
 	GetByID(ctx *gofr.Context, id int) (*models.Transaction, error)
 	Update(ctx *gofr.Context, transaction *models.Transaction) (*models.Transaction, error)
 	Delete(ctx *gofr.Context, id int) error
+	Import(ctx *gofr.Context, file io.Reader, mapping *models.ImportColumnMapping, dryRun bool) (*models.ImportResult, error)
+	SetStatusWithTx(ctx *gofr.Context, ids []int, accountID int, status models.TransactionStatus, tx *sql.Tx) error
+	BucketTotals(ctx *gofr.Context, f *filters.Transactions, interval string) ([]*models.BucketTotal, error)
 }
 
 type Savings interface {
@@ -45,6 +54,9 @@ type Savings interface {
 	UpdateWithTx(ctx *gofr.Context, savings *models.Savings, IsTransactionID bool, tx *sql.Tx) error
 	Delete(ctx *gofr.Context, id int) error
 	GetByTransactionID(ctx *gofr.Context, id int) (*models.Savings, error)
+	GetOpen(ctx *gofr.Context) ([]*models.Savings, error)
+	GetHistory(ctx *gofr.Context) ([]*models.Savings, error)
+	GetValuations(ctx *gofr.Context) ([]*models.SavingsValuation, error)
 }
 
 type Dashboard interface {
@@ -69,4 +81,83 @@ type RecurringTransactions interface {
 	GetByID(ctx *gofr.Context, id int) (*models.RecurringTransaction, error)
 	Update(ctx *gofr.Context, recurringTransaction *models.RecurringTransaction) (*models.RecurringTransaction, error)
 	Delete(ctx *gofr.Context, id int) error
+	PostDue(ctx *gofr.Context) error
+	Occurrences(ctx *gofr.Context, id, count int) ([]models.RecurringOccurrence, error)
+	Calendar(ctx *gofr.Context, from, to time.Time) (*models.RecurringCalendar, error)
+	Pause(ctx *gofr.Context, id int, pause *models.RecurringPause) (*models.RecurringTransaction, error)
+	Resume(ctx *gofr.Context, id int) (*models.RecurringTransaction, error)
+	CreateException(ctx *gofr.Context, id int, exception *models.RecurringException) (*models.RecurringException, error)
+	DeleteException(ctx *gofr.Context, id, exceptionID int) error
+	Pending(ctx *gofr.Context) ([]models.RecurringOccurrence, error)
+	ConfirmPending(ctx *gofr.Context, confirmation *models.RecurringConfirmation) (*models.Transaction, error)
+	RejectPending(ctx *gofr.Context, confirmation *models.RecurringConfirmation) error
+}
+
+type Statements interface {
+	Parse(ctx *gofr.Context, file io.ReadSeeker, layout string, accountID int) (*models.StatementDraft, error)
+	Confirm(ctx *gofr.Context, confirmation *models.StatementConfirmation) ([]*models.Transaction, error)
+}
+
+type Categories interface {
+	Create(ctx *gofr.Context, category *models.Category) (*models.Category, error)
+	GetByID(ctx *gofr.Context, id int) (*models.Category, error)
+	GetAll(ctx *gofr.Context, f *filters.Category) ([]*models.Category, error)
+	Update(ctx *gofr.Context, category *models.Category) (*models.Category, error)
+	Delete(ctx *gofr.Context, id int) error
+	Merge(ctx *gofr.Context, id, targetID int) (*models.Category, error)
+	WithDescendants(ctx *gofr.Context, names []string) ([]string, error)
+	SeedDefaults(ctx *gofr.Context, userID int, tx *sql.Tx) error
+}
+
+type Budgets interface {
+	Create(ctx *gofr.Context, budget *models.Budget) (*models.Budget, error)
+	GetByID(ctx *gofr.Context, id int) (*models.Budget, error)
+	GetAll(ctx *gofr.Context, f *filters.Budget) ([]*models.Budget, error)
+	Update(ctx *gofr.Context, budget *models.Budget) (*models.Budget, error)
+	Delete(ctx *gofr.Context, id int) error
+	Status(ctx *gofr.Context, month string) (*models.BudgetStatus, error)
+}
+
+type ExchangeRates interface {
+	Create(ctx *gofr.Context, rates []*models.ExchangeRate) ([]*models.ExchangeRate, error)
+	Import(ctx *gofr.Context, file io.Reader, format string) ([]*models.ExchangeRate, error)
+	GetAll(ctx *gofr.Context, f *filters.ExchangeRate) ([]*models.ExchangeRate, error)
+	Converter(ctx *gofr.Context, from, to string) (*models.Converter, error)
+}
+
+type Reconciliations interface {
+	Create(ctx *gofr.Context, reconciliation *models.Reconciliation) (*models.Reconciliation, error)
+	GetByID(ctx *gofr.Context, id int) (*models.Reconciliation, error)
+	GetAll(ctx *gofr.Context, f *filters.Reconciliation) ([]*models.Reconciliation, error)
+	Clear(ctx *gofr.Context, id int, clearing *models.ReconciliationClearing) (*models.Reconciliation, error)
+	Lock(ctx *gofr.Context, id int) (*models.Reconciliation, error)
+}
+
+type CreditCards interface {
+	Statements(ctx *gofr.Context, accountID, count int) (*models.CreditCardStatements, error)
+}
+
+type NetWorth interface {
+	Get(ctx *gofr.Context, months int) (*models.NetWorth, error)
+}
+
+type Reports interface {
+	Trends(ctx *gofr.Context, f *filters.Transactions, interval string, window int) (*models.TrendReport, error)
+}
+
+type Forecast interface {
+	Get(ctx *gofr.Context, accountID, days int, threshold models.Money) (*models.Forecast, error)
+}
+
+type CalendarFeeds interface {
+	Create(ctx *gofr.Context, feed *models.CalendarFeed) (*models.CalendarFeed, error)
+	GetAll(ctx *gofr.Context) ([]*models.CalendarFeed, error)
+	Revoke(ctx *gofr.Context, id int) error
+	Render(ctx *gofr.Context, token string) ([]byte, error)
+}
+
+type Holidays interface {
+	Create(ctx *gofr.Context, holiday *models.Holiday) (*models.Holiday, error)
+	GetAll(ctx *gofr.Context) ([]*models.Holiday, error)
+	Delete(ctx *gofr.Context, id int) error
 }
@@ -11,9 +11,11 @@ package services
 
 import (
 	context "context"
+	io "io"
 	filters "moneyManagement/filters"
 	models "moneyManagement/models"
 	reflect "reflect"
+	time "time"
 
 	jwt "github.com/golang-jwt/jwt/v5"
 	gomock "go.uber.org/mock/gomock"
@@ -155,6 +157,36 @@ func (m *MockAccount) EXPECT() *MockAccountMockRecorder {
 	return m.recorder
 }
 
+// BalanceHistory mocks base method.
+func (m *MockAccount) BalanceHistory(ctx *gofr.Context, id int, from, to, interval string) (*models.BalanceHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BalanceHistory", ctx, id, from, to, interval)
+	ret0, _ := ret[0].(*models.BalanceHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BalanceHistory indicates an expected call of BalanceHistory.
+func (mr *MockAccountMockRecorder) BalanceHistory(ctx, id, from, to, interval any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BalanceHistory", reflect.TypeOf((*MockAccount)(nil).BalanceHistory), ctx, id, from, to, interval)
+}
+
+// CheckBalances mocks base method.
+func (m *MockAccount) CheckBalances(ctx *gofr.Context, repair bool) (*models.BalanceCheckReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckBalances", ctx, repair)
+	ret0, _ := ret[0].(*models.BalanceCheckReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckBalances indicates an expected call of CheckBalances.
+func (mr *MockAccountMockRecorder) CheckBalances(ctx, repair any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckBalances", reflect.TypeOf((*MockAccount)(nil).CheckBalances), ctx, repair)
+}
+
 // Create mocks base method.
 func (m *MockAccount) Create(ctx *gofr.Context, account *models.Account) (*models.Account, error) {
 	m.ctrl.T.Helper()
@@ -229,6 +261,20 @@ func (mr *MockAccountMockRecorder) GetByIDForUpdate(ctx, id, userID, tx any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDForUpdate", reflect.TypeOf((*MockAccount)(nil).GetByIDForUpdate), ctx, id, userID, tx)
 }
 
+// RecordBalanceChanges mocks base method.
+func (m *MockAccount) RecordBalanceChanges(ctx *gofr.Context, entries []*models.BalanceLedgerEntry, tx *sql.Tx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordBalanceChanges", ctx, entries, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordBalanceChanges indicates an expected call of RecordBalanceChanges.
+func (mr *MockAccountMockRecorder) RecordBalanceChanges(ctx, entries, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordBalanceChanges", reflect.TypeOf((*MockAccount)(nil).RecordBalanceChanges), ctx, entries, tx)
+}
+
 // Update mocks base method.
 func (m *MockAccount) Update(ctx *gofr.Context, account *models.Account) (*models.Account, error) {
 	m.ctrl.T.Helper()
@@ -282,6 +328,21 @@ func (m *MockTransactions) EXPECT() *MockTransactionsMockRecorder {
 	return m.recorder
 }
 
+// BucketTotals mocks base method.
+func (m *MockTransactions) BucketTotals(ctx *gofr.Context, f *filters.Transactions, interval string) ([]*models.BucketTotal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BucketTotals", ctx, f, interval)
+	ret0, _ := ret[0].([]*models.BucketTotal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BucketTotals indicates an expected call of BucketTotals.
+func (mr *MockTransactionsMockRecorder) BucketTotals(ctx, f, interval any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BucketTotals", reflect.TypeOf((*MockTransactions)(nil).BucketTotals), ctx, f, interval)
+}
+
 // Create mocks base method.
 func (m *MockTransactions) Create(ctx *gofr.Context, transaction *models.Transaction) (*models.Transaction, error) {
 	m.ctrl.T.Helper()
@@ -297,6 +358,20 @@ func (mr *MockTransactionsMockRecorder) Create(ctx, transaction any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTransactions)(nil).Create), ctx, transaction)
 }
 
+// CreateWithTx mocks base method.
+func (m *MockTransactions) CreateWithTx(ctx *gofr.Context, transaction *models.Transaction, tx *sql.Tx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWithTx", ctx, transaction, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateWithTx indicates an expected call of CreateWithTx.
+func (mr *MockTransactionsMockRecorder) CreateWithTx(ctx, transaction, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWithTx", reflect.TypeOf((*MockTransactions)(nil).CreateWithTx), ctx, transaction, tx)
+}
+
 // Delete mocks base method.
 func (m *MockTransactions) Delete(ctx *gofr.Context, id int) error {
 	m.ctrl.T.Helper()
@@ -341,6 +416,35 @@ func (mr *MockTransactionsMockRecorder) GetByID(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockTransactions)(nil).GetByID), ctx, id)
 }
 
+// Import mocks base method.
+func (m *MockTransactions) Import(ctx *gofr.Context, file io.Reader, mapping *models.ImportColumnMapping, dryRun bool) (*models.ImportResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Import", ctx, file, mapping, dryRun)
+	ret0, _ := ret[0].(*models.ImportResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Import indicates an expected call of Import.
+func (mr *MockTransactionsMockRecorder) Import(ctx, file, mapping, dryRun any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockTransactions)(nil).Import), ctx, file, mapping, dryRun)
+}
+
+// SetStatusWithTx mocks base method.
+func (m *MockTransactions) SetStatusWithTx(ctx *gofr.Context, ids []int, accountID int, status models.TransactionStatus, tx *sql.Tx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetStatusWithTx", ctx, ids, accountID, status, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetStatusWithTx indicates an expected call of SetStatusWithTx.
+func (mr *MockTransactionsMockRecorder) SetStatusWithTx(ctx, ids, accountID, status, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetStatusWithTx", reflect.TypeOf((*MockTransactions)(nil).SetStatusWithTx), ctx, ids, accountID, status, tx)
+}
+
 // Update mocks base method.
 func (m *MockTransactions) Update(ctx *gofr.Context, transaction *models.Transaction) (*models.Transaction, error) {
 	m.ctrl.T.Helper()
@@ -467,6 +571,51 @@ func (mr *MockSavingsMockRecorder) GetByTransactionID(ctx, id any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByTransactionID", reflect.TypeOf((*MockSavings)(nil).GetByTransactionID), ctx, id)
 }
 
+// GetHistory mocks base method.
+func (m *MockSavings) GetHistory(ctx *gofr.Context) ([]*models.Savings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHistory", ctx)
+	ret0, _ := ret[0].([]*models.Savings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHistory indicates an expected call of GetHistory.
+func (mr *MockSavingsMockRecorder) GetHistory(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistory", reflect.TypeOf((*MockSavings)(nil).GetHistory), ctx)
+}
+
+// GetOpen mocks base method.
+func (m *MockSavings) GetOpen(ctx *gofr.Context) ([]*models.Savings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOpen", ctx)
+	ret0, _ := ret[0].([]*models.Savings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOpen indicates an expected call of GetOpen.
+func (mr *MockSavingsMockRecorder) GetOpen(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpen", reflect.TypeOf((*MockSavings)(nil).GetOpen), ctx)
+}
+
+// GetValuations mocks base method.
+func (m *MockSavings) GetValuations(ctx *gofr.Context) ([]*models.SavingsValuation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetValuations", ctx)
+	ret0, _ := ret[0].([]*models.SavingsValuation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetValuations indicates an expected call of GetValuations.
+func (mr *MockSavingsMockRecorder) GetValuations(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValuations", reflect.TypeOf((*MockSavings)(nil).GetValuations), ctx)
+}
+
 // Update mocks base method.
 func (m *MockSavings) Update(ctx *gofr.Context, savings *models.Savings) (*models.Savings, error) {
 	m.ctrl.T.Helper()
@@ -693,6 +842,36 @@ func (m *MockRecurringTransactions) EXPECT() *MockRecurringTransactionsMockRecor
 	return m.recorder
 }
 
+// Calendar mocks base method.
+func (m *MockRecurringTransactions) Calendar(ctx *gofr.Context, from, to time.Time) (*models.RecurringCalendar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Calendar", ctx, from, to)
+	ret0, _ := ret[0].(*models.RecurringCalendar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Calendar indicates an expected call of Calendar.
+func (mr *MockRecurringTransactionsMockRecorder) Calendar(ctx, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Calendar", reflect.TypeOf((*MockRecurringTransactions)(nil).Calendar), ctx, from, to)
+}
+
+// ConfirmPending mocks base method.
+func (m *MockRecurringTransactions) ConfirmPending(ctx *gofr.Context, confirmation *models.RecurringConfirmation) (*models.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmPending", ctx, confirmation)
+	ret0, _ := ret[0].(*models.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmPending indicates an expected call of ConfirmPending.
+func (mr *MockRecurringTransactionsMockRecorder) ConfirmPending(ctx, confirmation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmPending", reflect.TypeOf((*MockRecurringTransactions)(nil).ConfirmPending), ctx, confirmation)
+}
+
 // Create mocks base method.
 func (m *MockRecurringTransactions) Create(ctx *gofr.Context, recurringTransaction *models.RecurringTransaction) (*models.RecurringTransaction, error) {
 	m.ctrl.T.Helper()
@@ -708,6 +887,21 @@ func (mr *MockRecurringTransactionsMockRecorder) Create(ctx, recurringTransactio
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRecurringTransactions)(nil).Create), ctx, recurringTransaction)
 }
 
+// CreateException mocks base method.
+func (m *MockRecurringTransactions) CreateException(ctx *gofr.Context, id int, exception *models.RecurringException) (*models.RecurringException, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateException", ctx, id, exception)
+	ret0, _ := ret[0].(*models.RecurringException)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateException indicates an expected call of CreateException.
+func (mr *MockRecurringTransactionsMockRecorder) CreateException(ctx, id, exception any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateException", reflect.TypeOf((*MockRecurringTransactions)(nil).CreateException), ctx, id, exception)
+}
+
 // Delete mocks base method.
 func (m *MockRecurringTransactions) Delete(ctx *gofr.Context, id int) error {
 	m.ctrl.T.Helper()
@@ -722,6 +916,20 @@ func (mr *MockRecurringTransactionsMockRecorder) Delete(ctx, id any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRecurringTransactions)(nil).Delete), ctx, id)
 }
 
+// DeleteException mocks base method.
+func (m *MockRecurringTransactions) DeleteException(ctx *gofr.Context, id, exceptionID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteException", ctx, id, exceptionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteException indicates an expected call of DeleteException.
+func (mr *MockRecurringTransactionsMockRecorder) DeleteException(ctx, id, exceptionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteException", reflect.TypeOf((*MockRecurringTransactions)(nil).DeleteException), ctx, id, exceptionID)
+}
+
 // GetAll mocks base method.
 func (m *MockRecurringTransactions) GetAll(ctx *gofr.Context, f *filters.RecurringTransactions) ([]*models.RecurringTransaction, error) {
 	m.ctrl.T.Helper()
@@ -752,6 +960,94 @@ func (mr *MockRecurringTransactionsMockRecorder) GetByID(ctx, id any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockRecurringTransactions)(nil).GetByID), ctx, id)
 }
 
+// Occurrences mocks base method.
+func (m *MockRecurringTransactions) Occurrences(ctx *gofr.Context, id, count int) ([]models.RecurringOccurrence, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Occurrences", ctx, id, count)
+	ret0, _ := ret[0].([]models.RecurringOccurrence)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Occurrences indicates an expected call of Occurrences.
+func (mr *MockRecurringTransactionsMockRecorder) Occurrences(ctx, id, count any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Occurrences", reflect.TypeOf((*MockRecurringTransactions)(nil).Occurrences), ctx, id, count)
+}
+
+// Pause mocks base method.
+func (m *MockRecurringTransactions) Pause(ctx *gofr.Context, id int, pause *models.RecurringPause) (*models.RecurringTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pause", ctx, id, pause)
+	ret0, _ := ret[0].(*models.RecurringTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Pause indicates an expected call of Pause.
+func (mr *MockRecurringTransactionsMockRecorder) Pause(ctx, id, pause any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pause", reflect.TypeOf((*MockRecurringTransactions)(nil).Pause), ctx, id, pause)
+}
+
+// Pending mocks base method.
+func (m *MockRecurringTransactions) Pending(ctx *gofr.Context) ([]models.RecurringOccurrence, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pending", ctx)
+	ret0, _ := ret[0].([]models.RecurringOccurrence)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Pending indicates an expected call of Pending.
+func (mr *MockRecurringTransactionsMockRecorder) Pending(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pending", reflect.TypeOf((*MockRecurringTransactions)(nil).Pending), ctx)
+}
+
+// PostDue mocks base method.
+func (m *MockRecurringTransactions) PostDue(ctx *gofr.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostDue", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PostDue indicates an expected call of PostDue.
+func (mr *MockRecurringTransactionsMockRecorder) PostDue(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostDue", reflect.TypeOf((*MockRecurringTransactions)(nil).PostDue), ctx)
+}
+
+// RejectPending mocks base method.
+func (m *MockRecurringTransactions) RejectPending(ctx *gofr.Context, confirmation *models.RecurringConfirmation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RejectPending", ctx, confirmation)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RejectPending indicates an expected call of RejectPending.
+func (mr *MockRecurringTransactionsMockRecorder) RejectPending(ctx, confirmation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectPending", reflect.TypeOf((*MockRecurringTransactions)(nil).RejectPending), ctx, confirmation)
+}
+
+// Resume mocks base method.
+func (m *MockRecurringTransactions) Resume(ctx *gofr.Context, id int) (*models.RecurringTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resume", ctx, id)
+	ret0, _ := ret[0].(*models.RecurringTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Resume indicates an expected call of Resume.
+func (mr *MockRecurringTransactionsMockRecorder) Resume(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resume", reflect.TypeOf((*MockRecurringTransactions)(nil).Resume), ctx, id)
+}
+
 // Update mocks base method.
 func (m *MockRecurringTransactions) Update(ctx *gofr.Context, recurringTransaction *models.RecurringTransaction) (*models.RecurringTransaction, error) {
 	m.ctrl.T.Helper()
@@ -766,3 +1062,791 @@ func (mr *MockRecurringTransactionsMockRecorder) Update(ctx, recurringTransactio
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRecurringTransactions)(nil).Update), ctx, recurringTransaction)
 }
+
+// MockStatements is a mock of Statements interface.
+type MockStatements struct {
+	ctrl     *gomock.Controller
+	recorder *MockStatementsMockRecorder
+}
+
+// MockStatementsMockRecorder is the mock recorder for MockStatements.
+type MockStatementsMockRecorder struct {
+	mock *MockStatements
+}
+
+// NewMockStatements creates a new mock instance.
+func NewMockStatements(ctrl *gomock.Controller) *MockStatements {
+	mock := &MockStatements{ctrl: ctrl}
+	mock.recorder = &MockStatementsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStatements) EXPECT() *MockStatementsMockRecorder {
+	return m.recorder
+}
+
+// Confirm mocks base method.
+func (m *MockStatements) Confirm(ctx *gofr.Context, confirmation *models.StatementConfirmation) ([]*models.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Confirm", ctx, confirmation)
+	ret0, _ := ret[0].([]*models.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Confirm indicates an expected call of Confirm.
+func (mr *MockStatementsMockRecorder) Confirm(ctx, confirmation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Confirm", reflect.TypeOf((*MockStatements)(nil).Confirm), ctx, confirmation)
+}
+
+// Parse mocks base method.
+func (m *MockStatements) Parse(ctx *gofr.Context, file io.ReadSeeker, layout string, accountID int) (*models.StatementDraft, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Parse", ctx, file, layout, accountID)
+	ret0, _ := ret[0].(*models.StatementDraft)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Parse indicates an expected call of Parse.
+func (mr *MockStatementsMockRecorder) Parse(ctx, file, layout, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Parse", reflect.TypeOf((*MockStatements)(nil).Parse), ctx, file, layout, accountID)
+}
+
+// MockCategories is a mock of Categories interface.
+type MockCategories struct {
+	ctrl     *gomock.Controller
+	recorder *MockCategoriesMockRecorder
+}
+
+// MockCategoriesMockRecorder is the mock recorder for MockCategories.
+type MockCategoriesMockRecorder struct {
+	mock *MockCategories
+}
+
+// NewMockCategories creates a new mock instance.
+func NewMockCategories(ctrl *gomock.Controller) *MockCategories {
+	mock := &MockCategories{ctrl: ctrl}
+	mock.recorder = &MockCategoriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCategories) EXPECT() *MockCategoriesMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockCategories) Create(ctx *gofr.Context, category *models.Category) (*models.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, category)
+	ret0, _ := ret[0].(*models.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCategoriesMockRecorder) Create(ctx, category any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCategories)(nil).Create), ctx, category)
+}
+
+// Delete mocks base method.
+func (m *MockCategories) Delete(ctx *gofr.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockCategoriesMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCategories)(nil).Delete), ctx, id)
+}
+
+// GetAll mocks base method.
+func (m *MockCategories) GetAll(ctx *gofr.Context, f *filters.Category) ([]*models.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx, f)
+	ret0, _ := ret[0].([]*models.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockCategoriesMockRecorder) GetAll(ctx, f any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockCategories)(nil).GetAll), ctx, f)
+}
+
+// GetByID mocks base method.
+func (m *MockCategories) GetByID(ctx *gofr.Context, id int) (*models.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*models.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockCategoriesMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockCategories)(nil).GetByID), ctx, id)
+}
+
+// Merge mocks base method.
+func (m *MockCategories) Merge(ctx *gofr.Context, id, targetID int) (*models.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Merge", ctx, id, targetID)
+	ret0, _ := ret[0].(*models.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Merge indicates an expected call of Merge.
+func (mr *MockCategoriesMockRecorder) Merge(ctx, id, targetID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Merge", reflect.TypeOf((*MockCategories)(nil).Merge), ctx, id, targetID)
+}
+
+// SeedDefaults mocks base method.
+func (m *MockCategories) SeedDefaults(ctx *gofr.Context, userID int, tx *sql.Tx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeedDefaults", ctx, userID, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SeedDefaults indicates an expected call of SeedDefaults.
+func (mr *MockCategoriesMockRecorder) SeedDefaults(ctx, userID, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeedDefaults", reflect.TypeOf((*MockCategories)(nil).SeedDefaults), ctx, userID, tx)
+}
+
+// Update mocks base method.
+func (m *MockCategories) Update(ctx *gofr.Context, category *models.Category) (*models.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, category)
+	ret0, _ := ret[0].(*models.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockCategoriesMockRecorder) Update(ctx, category any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockCategories)(nil).Update), ctx, category)
+}
+
+// WithDescendants mocks base method.
+func (m *MockCategories) WithDescendants(ctx *gofr.Context, names []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithDescendants", ctx, names)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WithDescendants indicates an expected call of WithDescendants.
+func (mr *MockCategoriesMockRecorder) WithDescendants(ctx, names any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithDescendants", reflect.TypeOf((*MockCategories)(nil).WithDescendants), ctx, names)
+}
+
+// MockBudgets is a mock of Budgets interface.
+type MockBudgets struct {
+	ctrl     *gomock.Controller
+	recorder *MockBudgetsMockRecorder
+}
+
+// MockBudgetsMockRecorder is the mock recorder for MockBudgets.
+type MockBudgetsMockRecorder struct {
+	mock *MockBudgets
+}
+
+// NewMockBudgets creates a new mock instance.
+func NewMockBudgets(ctrl *gomock.Controller) *MockBudgets {
+	mock := &MockBudgets{ctrl: ctrl}
+	mock.recorder = &MockBudgetsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBudgets) EXPECT() *MockBudgetsMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockBudgets) Create(ctx *gofr.Context, budget *models.Budget) (*models.Budget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, budget)
+	ret0, _ := ret[0].(*models.Budget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockBudgetsMockRecorder) Create(ctx, budget any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockBudgets)(nil).Create), ctx, budget)
+}
+
+// Delete mocks base method.
+func (m *MockBudgets) Delete(ctx *gofr.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockBudgetsMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockBudgets)(nil).Delete), ctx, id)
+}
+
+// GetAll mocks base method.
+func (m *MockBudgets) GetAll(ctx *gofr.Context, f *filters.Budget) ([]*models.Budget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx, f)
+	ret0, _ := ret[0].([]*models.Budget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockBudgetsMockRecorder) GetAll(ctx, f any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockBudgets)(nil).GetAll), ctx, f)
+}
+
+// GetByID mocks base method.
+func (m *MockBudgets) GetByID(ctx *gofr.Context, id int) (*models.Budget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*models.Budget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockBudgetsMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockBudgets)(nil).GetByID), ctx, id)
+}
+
+// Status mocks base method.
+func (m *MockBudgets) Status(ctx *gofr.Context, month string) (*models.BudgetStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Status", ctx, month)
+	ret0, _ := ret[0].(*models.BudgetStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Status indicates an expected call of Status.
+func (mr *MockBudgetsMockRecorder) Status(ctx, month any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Status", reflect.TypeOf((*MockBudgets)(nil).Status), ctx, month)
+}
+
+// Update mocks base method.
+func (m *MockBudgets) Update(ctx *gofr.Context, budget *models.Budget) (*models.Budget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, budget)
+	ret0, _ := ret[0].(*models.Budget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockBudgetsMockRecorder) Update(ctx, budget any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockBudgets)(nil).Update), ctx, budget)
+}
+
+// MockExchangeRates is a mock of ExchangeRates interface.
+type MockExchangeRates struct {
+	ctrl     *gomock.Controller
+	recorder *MockExchangeRatesMockRecorder
+}
+
+// MockExchangeRatesMockRecorder is the mock recorder for MockExchangeRates.
+type MockExchangeRatesMockRecorder struct {
+	mock *MockExchangeRates
+}
+
+// NewMockExchangeRates creates a new mock instance.
+func NewMockExchangeRates(ctrl *gomock.Controller) *MockExchangeRates {
+	mock := &MockExchangeRates{ctrl: ctrl}
+	mock.recorder = &MockExchangeRatesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExchangeRates) EXPECT() *MockExchangeRatesMockRecorder {
+	return m.recorder
+}
+
+// Converter mocks base method.
+func (m *MockExchangeRates) Converter(ctx *gofr.Context, from, to string) (*models.Converter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Converter", ctx, from, to)
+	ret0, _ := ret[0].(*models.Converter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Converter indicates an expected call of Converter.
+func (mr *MockExchangeRatesMockRecorder) Converter(ctx, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Converter", reflect.TypeOf((*MockExchangeRates)(nil).Converter), ctx, from, to)
+}
+
+// Create mocks base method.
+func (m *MockExchangeRates) Create(ctx *gofr.Context, rates []*models.ExchangeRate) ([]*models.ExchangeRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, rates)
+	ret0, _ := ret[0].([]*models.ExchangeRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockExchangeRatesMockRecorder) Create(ctx, rates any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockExchangeRates)(nil).Create), ctx, rates)
+}
+
+// GetAll mocks base method.
+func (m *MockExchangeRates) GetAll(ctx *gofr.Context, f *filters.ExchangeRate) ([]*models.ExchangeRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx, f)
+	ret0, _ := ret[0].([]*models.ExchangeRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockExchangeRatesMockRecorder) GetAll(ctx, f any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockExchangeRates)(nil).GetAll), ctx, f)
+}
+
+// Import mocks base method.
+func (m *MockExchangeRates) Import(ctx *gofr.Context, file io.Reader, format string) ([]*models.ExchangeRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Import", ctx, file, format)
+	ret0, _ := ret[0].([]*models.ExchangeRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Import indicates an expected call of Import.
+func (mr *MockExchangeRatesMockRecorder) Import(ctx, file, format any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockExchangeRates)(nil).Import), ctx, file, format)
+}
+
+// MockReconciliations is a mock of Reconciliations interface.
+type MockReconciliations struct {
+	ctrl     *gomock.Controller
+	recorder *MockReconciliationsMockRecorder
+}
+
+// MockReconciliationsMockRecorder is the mock recorder for MockReconciliations.
+type MockReconciliationsMockRecorder struct {
+	mock *MockReconciliations
+}
+
+// NewMockReconciliations creates a new mock instance.
+func NewMockReconciliations(ctrl *gomock.Controller) *MockReconciliations {
+	mock := &MockReconciliations{ctrl: ctrl}
+	mock.recorder = &MockReconciliationsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReconciliations) EXPECT() *MockReconciliationsMockRecorder {
+	return m.recorder
+}
+
+// Clear mocks base method.
+func (m *MockReconciliations) Clear(ctx *gofr.Context, id int, clearing *models.ReconciliationClearing) (*models.Reconciliation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Clear", ctx, id, clearing)
+	ret0, _ := ret[0].(*models.Reconciliation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Clear indicates an expected call of Clear.
+func (mr *MockReconciliationsMockRecorder) Clear(ctx, id, clearing any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Clear", reflect.TypeOf((*MockReconciliations)(nil).Clear), ctx, id, clearing)
+}
+
+// Create mocks base method.
+func (m *MockReconciliations) Create(ctx *gofr.Context, reconciliation *models.Reconciliation) (*models.Reconciliation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, reconciliation)
+	ret0, _ := ret[0].(*models.Reconciliation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockReconciliationsMockRecorder) Create(ctx, reconciliation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockReconciliations)(nil).Create), ctx, reconciliation)
+}
+
+// GetAll mocks base method.
+func (m *MockReconciliations) GetAll(ctx *gofr.Context, f *filters.Reconciliation) ([]*models.Reconciliation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx, f)
+	ret0, _ := ret[0].([]*models.Reconciliation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockReconciliationsMockRecorder) GetAll(ctx, f any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockReconciliations)(nil).GetAll), ctx, f)
+}
+
+// GetByID mocks base method.
+func (m *MockReconciliations) GetByID(ctx *gofr.Context, id int) (*models.Reconciliation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*models.Reconciliation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockReconciliationsMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockReconciliations)(nil).GetByID), ctx, id)
+}
+
+// Lock mocks base method.
+func (m *MockReconciliations) Lock(ctx *gofr.Context, id int) (*models.Reconciliation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Lock", ctx, id)
+	ret0, _ := ret[0].(*models.Reconciliation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Lock indicates an expected call of Lock.
+func (mr *MockReconciliationsMockRecorder) Lock(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lock", reflect.TypeOf((*MockReconciliations)(nil).Lock), ctx, id)
+}
+
+// MockCreditCards is a mock of CreditCards interface.
+type MockCreditCards struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreditCardsMockRecorder
+}
+
+// MockCreditCardsMockRecorder is the mock recorder for MockCreditCards.
+type MockCreditCardsMockRecorder struct {
+	mock *MockCreditCards
+}
+
+// NewMockCreditCards creates a new mock instance.
+func NewMockCreditCards(ctrl *gomock.Controller) *MockCreditCards {
+	mock := &MockCreditCards{ctrl: ctrl}
+	mock.recorder = &MockCreditCardsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreditCards) EXPECT() *MockCreditCardsMockRecorder {
+	return m.recorder
+}
+
+// Statements mocks base method.
+func (m *MockCreditCards) Statements(ctx *gofr.Context, accountID, count int) (*models.CreditCardStatements, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Statements", ctx, accountID, count)
+	ret0, _ := ret[0].(*models.CreditCardStatements)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Statements indicates an expected call of Statements.
+func (mr *MockCreditCardsMockRecorder) Statements(ctx, accountID, count any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Statements", reflect.TypeOf((*MockCreditCards)(nil).Statements), ctx, accountID, count)
+}
+
+// MockNetWorth is a mock of NetWorth interface.
+type MockNetWorth struct {
+	ctrl     *gomock.Controller
+	recorder *MockNetWorthMockRecorder
+}
+
+// MockNetWorthMockRecorder is the mock recorder for MockNetWorth.
+type MockNetWorthMockRecorder struct {
+	mock *MockNetWorth
+}
+
+// NewMockNetWorth creates a new mock instance.
+func NewMockNetWorth(ctrl *gomock.Controller) *MockNetWorth {
+	mock := &MockNetWorth{ctrl: ctrl}
+	mock.recorder = &MockNetWorthMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNetWorth) EXPECT() *MockNetWorthMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockNetWorth) Get(ctx *gofr.Context, months int) (*models.NetWorth, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, months)
+	ret0, _ := ret[0].(*models.NetWorth)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockNetWorthMockRecorder) Get(ctx, months any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockNetWorth)(nil).Get), ctx, months)
+}
+
+// MockReports is a mock of Reports interface.
+type MockReports struct {
+	ctrl     *gomock.Controller
+	recorder *MockReportsMockRecorder
+}
+
+// MockReportsMockRecorder is the mock recorder for MockReports.
+type MockReportsMockRecorder struct {
+	mock *MockReports
+}
+
+// NewMockReports creates a new mock instance.
+func NewMockReports(ctrl *gomock.Controller) *MockReports {
+	mock := &MockReports{ctrl: ctrl}
+	mock.recorder = &MockReportsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReports) EXPECT() *MockReportsMockRecorder {
+	return m.recorder
+}
+
+// Trends mocks base method.
+func (m *MockReports) Trends(ctx *gofr.Context, f *filters.Transactions, interval string, window int) (*models.TrendReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trends", ctx, f, interval, window)
+	ret0, _ := ret[0].(*models.TrendReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Trends indicates an expected call of Trends.
+func (mr *MockReportsMockRecorder) Trends(ctx, f, interval, window any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trends", reflect.TypeOf((*MockReports)(nil).Trends), ctx, f, interval, window)
+}
+
+// MockForecast is a mock of Forecast interface.
+type MockForecast struct {
+	ctrl     *gomock.Controller
+	recorder *MockForecastMockRecorder
+}
+
+// MockForecastMockRecorder is the mock recorder for MockForecast.
+type MockForecastMockRecorder struct {
+	mock *MockForecast
+}
+
+// NewMockForecast creates a new mock instance.
+func NewMockForecast(ctrl *gomock.Controller) *MockForecast {
+	mock := &MockForecast{ctrl: ctrl}
+	mock.recorder = &MockForecastMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockForecast) EXPECT() *MockForecastMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockForecast) Get(ctx *gofr.Context, accountID, days int, threshold models.Money) (*models.Forecast, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, accountID, days, threshold)
+	ret0, _ := ret[0].(*models.Forecast)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockForecastMockRecorder) Get(ctx, accountID, days, threshold any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockForecast)(nil).Get), ctx, accountID, days, threshold)
+}
+
+// MockCalendarFeeds is a mock of CalendarFeeds interface.
+type MockCalendarFeeds struct {
+	ctrl     *gomock.Controller
+	recorder *MockCalendarFeedsMockRecorder
+}
+
+// MockCalendarFeedsMockRecorder is the mock recorder for MockCalendarFeeds.
+type MockCalendarFeedsMockRecorder struct {
+	mock *MockCalendarFeeds
+}
+
+// NewMockCalendarFeeds creates a new mock instance.
+func NewMockCalendarFeeds(ctrl *gomock.Controller) *MockCalendarFeeds {
+	mock := &MockCalendarFeeds{ctrl: ctrl}
+	mock.recorder = &MockCalendarFeedsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCalendarFeeds) EXPECT() *MockCalendarFeedsMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockCalendarFeeds) Create(ctx *gofr.Context, feed *models.CalendarFeed) (*models.CalendarFeed, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, feed)
+	ret0, _ := ret[0].(*models.CalendarFeed)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCalendarFeedsMockRecorder) Create(ctx, feed any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCalendarFeeds)(nil).Create), ctx, feed)
+}
+
+// GetAll mocks base method.
+func (m *MockCalendarFeeds) GetAll(ctx *gofr.Context) ([]*models.CalendarFeed, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx)
+	ret0, _ := ret[0].([]*models.CalendarFeed)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockCalendarFeedsMockRecorder) GetAll(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockCalendarFeeds)(nil).GetAll), ctx)
+}
+
+// Render mocks base method.
+func (m *MockCalendarFeeds) Render(ctx *gofr.Context, token string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Render", ctx, token)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Render indicates an expected call of Render.
+func (mr *MockCalendarFeedsMockRecorder) Render(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Render", reflect.TypeOf((*MockCalendarFeeds)(nil).Render), ctx, token)
+}
+
+// Revoke mocks base method.
+func (m *MockCalendarFeeds) Revoke(ctx *gofr.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockCalendarFeedsMockRecorder) Revoke(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockCalendarFeeds)(nil).Revoke), ctx, id)
+}
+
+// MockHolidays is a mock of Holidays interface.
+type MockHolidays struct {
+	ctrl     *gomock.Controller
+	recorder *MockHolidaysMockRecorder
+}
+
+// MockHolidaysMockRecorder is the mock recorder for MockHolidays.
+type MockHolidaysMockRecorder struct {
+	mock *MockHolidays
+}
+
+// NewMockHolidays creates a new mock instance.
+func NewMockHolidays(ctrl *gomock.Controller) *MockHolidays {
+	mock := &MockHolidays{ctrl: ctrl}
+	mock.recorder = &MockHolidaysMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHolidays) EXPECT() *MockHolidaysMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockHolidays) Create(ctx *gofr.Context, holiday *models.Holiday) (*models.Holiday, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, holiday)
+	ret0, _ := ret[0].(*models.Holiday)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockHolidaysMockRecorder) Create(ctx, holiday any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockHolidays)(nil).Create), ctx, holiday)
+}
+
+// Delete mocks base method.
+func (m *MockHolidays) Delete(ctx *gofr.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockHolidaysMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockHolidays)(nil).Delete), ctx, id)
+}
+
+// GetAll mocks base method.
+func (m *MockHolidays) GetAll(ctx *gofr.Context) ([]*models.Holiday, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx)
+	ret0, _ := ret[0].([]*models.Holiday)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockHolidaysMockRecorder) GetAll(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockHolidays)(nil).GetAll), ctx)
+}
@@ -0,0 +1,218 @@
+package netWorth
+
+import (
+	"errors"
+	"gofr.dev/pkg/gofr"
+	"moneyManagement/filters"
+	"moneyManagement/models"
+	"moneyManagement/services"
+	"time"
+)
+
+const (
+	defaultMonths = 12
+	maxMonths     = 120
+)
+
+type netWorthSvc struct {
+	accountSvc      services.Account
+	savingsSvc      services.Savings
+	exchangeRateSvc services.ExchangeRates
+}
+
+func New(accountSvc services.Account, savingsSvc services.Savings, exchangeRateSvc services.ExchangeRates) services.NetWorth {
+	return &netWorthSvc{
+		accountSvc:      accountSvc,
+		savingsSvc:      savingsSvc,
+		exchangeRateSvc: exchangeRateSvc,
+	}
+}
+
+// Get returns the net worth of the user today and at the end of each of the last months months, 12 by default, the
+// current month included. Every balance is converted to the home currency at the rate in effect on its date. An
+// account counts from its opening date until it is deleted, and savings from their start date until they are closed,
+// at the value of their latest valuation.
+func (s *netWorthSvc) Get(ctx *gofr.Context, months int) (*models.NetWorth, error) {
+	if months == 0 {
+		months = defaultMonths
+	}
+
+	if months < 0 || months > maxMonths {
+		return nil, errors.New("months must be between 1 and 120")
+	}
+
+	userID, _ := ctx.Value("userID").(int)
+
+	accounts, err := s.accountSvc.GetAll(ctx, &filters.Account{UserID: userID, IncludeDeleted: true})
+	if err != nil {
+		return nil, err
+	}
+
+	allSavings, err := s.savingsSvc.GetHistory(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	valuations, err := s.savingsSvc.GetValuations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	today := now.Format("2006-01-02")
+	from := time.Date(now.Year(), now.Month()-time.Month(months-1), 1, 0, 0, 0, 0, time.UTC).Format("2006-01-02")
+
+	converter, err := s.exchangeRateSvc.Converter(ctx, from, today)
+	if err != nil {
+		return nil, err
+	}
+
+	var current models.NetWorthPoint
+
+	history := historyPoints(from, now)
+
+	pointIndex := make(map[string]int, len(history))
+	for i, point := range history {
+		pointIndex[point.Date] = i
+	}
+
+	netWorth := &models.NetWorth{Currency: converter.HomeCurrency, Accounts: []models.NetWorthAccount{}}
+
+	for _, account := range accounts {
+		deletedOn := dateOf(account.DeletedAt)
+
+		// An account deleted before the first month ends has no part in the history either
+		if deletedOn != "" && deletedOn <= history[0].Date {
+			continue
+		}
+
+		if deletedOn == "" && account.Status == "ACTIVE" {
+			balance, err := converter.ToHome(account.Balance, account.Currency, today)
+			if err != nil {
+				return nil, err
+			}
+
+			current.Add(balance, account.IsLiability())
+
+			netWorth.Accounts = append(netWorth.Accounts, models.NetWorthAccount{ID: account.ID, Name: account.Name,
+				Type: account.Type, Currency: account.Currency, Balance: balance, Liability: account.IsLiability()})
+		}
+
+		balanceHistory, err := s.accountSvc.BalanceHistory(ctx, account.ID, from, today, "month")
+		if err != nil {
+			return nil, err
+		}
+
+		for _, point := range balanceHistory.Points {
+			i, ok := pointIndex[point.Date]
+
+			// Before it was opened and once it is deleted the account holds nothing, whatever the ledger walks back to
+			if !ok || point.Date < account.OpeningDate || (deletedOn != "" && point.Date >= deletedOn) {
+				continue
+			}
+
+			balance, err := converter.ToHome(point.Balance, account.Currency, point.Date)
+			if err != nil {
+				return nil, err
+			}
+
+			history[i].Add(balance, account.IsLiability())
+		}
+	}
+
+	byID := valuationsBySavings(valuations)
+
+	for _, savings := range allSavings {
+		if savings.DeletedAt == "" {
+			value, err := converter.ToHome(savings.Value(), savings.Currency, today)
+			if err != nil {
+				return nil, err
+			}
+
+			netWorth.Savings += value
+			current.Add(value, false)
+		}
+
+		for i := range history {
+			value, err := converter.ToHome(savingsValueOn(savings, byID[savings.ID], history[i].Date, today),
+				savings.Currency, history[i].Date)
+			if err != nil {
+				return nil, err
+			}
+
+			history[i].Add(value, false)
+		}
+	}
+
+	netWorth.Assets, netWorth.Liabilities, netWorth.NetWorth = current.Assets, current.Liabilities, current.NetWorth
+	netWorth.History = history
+
+	return netWorth, nil
+}
+
+// historyPoints returns an empty point for the end of every month from from, YYYY-MM-DD, the current month ending
+// today. They fall on the same dates as the monthly balance history of an account over the same range.
+func historyPoints(from string, now time.Time) []models.NetWorthPoint {
+	start, _ := time.Parse("2006-01-02", from)
+
+	var points []models.NetWorthPoint
+
+	for t := start; !t.After(now); t = t.AddDate(0, 1, 0) {
+		end := t.AddDate(0, 1, -1)
+		if end.After(now) {
+			end = now
+		}
+
+		points = append(points, models.NetWorthPoint{Month: t.Format("2006-01"), Date: end.Format("2006-01-02")})
+	}
+
+	return points
+}
+
+func valuationsBySavings(valuations []*models.SavingsValuation) map[int][]*models.SavingsValuation {
+	byID := make(map[int][]*models.SavingsValuation)
+
+	for _, valuation := range valuations {
+		byID[valuation.SavingsID] = append(byID[valuation.SavingsID], valuation)
+	}
+
+	return byID
+}
+
+// savingsValueOn is the value of savings on date: nothing before they started or from the day they were closed, then
+// the latest of their valuations, ordered by date, on or before date, or the amount put in when there is none. From
+// today it is their current value.
+func savingsValueOn(savings *models.Savings, valuations []*models.SavingsValuation, date, today string) models.Money {
+	if savings.DeletedAt != "" && date >= savings.DeletedAt {
+		return 0
+	}
+
+	if date >= today {
+		return savings.Value()
+	}
+
+	if savings.StartDate != "" && date < savings.StartDate {
+		return 0
+	}
+
+	value := savings.Amount
+
+	for _, valuation := range valuations {
+		if valuation.ValuedOn > date {
+			break
+		}
+
+		value = valuation.Value
+	}
+
+	return value
+}
+
+// dateOf returns the YYYY-MM-DD date of a timestamp, empty when there is none.
+func dateOf(timestamp string) string {
+	if len(timestamp) < len("2006-01-02") {
+		return ""
+	}
+
+	return timestamp[:len("2006-01-02")]
+}
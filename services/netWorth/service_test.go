@@ -0,0 +1,128 @@
+package netWorth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+
+	"moneyManagement/filters"
+	"moneyManagement/models"
+	"moneyManagement/services"
+)
+
+func Test_HistoryPoints(t *testing.T) {
+	now := time.Date(2025, 3, 15, 10, 0, 0, 0, time.UTC)
+
+	expected := []models.NetWorthPoint{
+		{Month: "2025-01", Date: "2025-01-31"},
+		{Month: "2025-02", Date: "2025-02-28"},
+		{Month: "2025-03", Date: "2025-03-15"},
+	}
+
+	assert.Equal(t, expected, historyPoints("2025-01-01", now))
+}
+
+func Test_SavingsValueOn(t *testing.T) {
+	savings := &models.Savings{ID: 4, Amount: models.NewMoney(10000), CurrentValue: models.NewMoney(12500),
+		StartDate: "2025-01-10"}
+	valuations := []*models.SavingsValuation{
+		{SavingsID: 4, Value: models.NewMoney(11000), ValuedOn: "2025-02-20"},
+		{SavingsID: 4, Value: models.NewMoney(12500), ValuedOn: "2025-04-05"},
+	}
+
+	tests := []struct {
+		description string
+		date        string
+		expected    models.Money
+	}{
+		{"before the start date", "2024-12-31", 0},
+		{"before the first valuation", "2025-01-31", models.NewMoney(10000)},
+		{"on a valuation", "2025-02-20", models.NewMoney(11000)},
+		{"between valuations", "2025-03-31", models.NewMoney(11000)},
+		{"today", "2025-04-10", models.NewMoney(12500)},
+	}
+
+	for i, tc := range tests {
+		value := savingsValueOn(savings, valuations, tc.date, "2025-04-10")
+
+		assert.Equalf(t, tc.expected, value, "TEST[%d], failed.\n%s", i, tc.description)
+	}
+
+	closed := *savings
+	closed.DeletedAt = "2025-03-15"
+
+	assert.Equal(t, models.NewMoney(11000), savingsValueOn(&closed, valuations, "2025-02-28", "2025-04-10"),
+		"closed savings count until they are closed")
+	assert.Equal(t, models.Money(0), savingsValueOn(&closed, valuations, "2025-03-31", "2025-04-10"),
+		"closed savings are worth nothing from the day they are closed")
+}
+
+func Test_Get(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	accountSvc := services.NewMockAccount(ctrl)
+	savingsSvc := services.NewMockSavings(ctrl)
+	exchangeRateSvc := services.NewMockExchangeRates(ctrl)
+
+	mockContainer, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{Context: context.WithValue(context.Background(), "userID", 1), Container: mockContainer}
+
+	now := time.Now().UTC()
+	today := now.Format("2006-01-02")
+	from := time.Date(now.Year(), now.Month()-1, 1, 0, 0, 0, 0, time.UTC).Format("2006-01-02")
+	lastMonth := historyPoints(from, now)[0].Date
+
+	account := func(id int, balance float64, status, deletedAt string) *models.Account {
+		return &models.Account{ID: id, Name: "Account", Type: "BANK", Balance: models.NewMoney(balance),
+			OpeningDate: "2000-01-01", Currency: "INR", Status: status, DeletedAt: deletedAt}
+	}
+
+	points := func(lastMonthBalance, balance float64) *models.BalanceHistory {
+		return &models.BalanceHistory{Points: []models.BalancePoint{
+			{Date: lastMonth, Balance: models.NewMoney(lastMonthBalance)}, {Date: today, Balance: models.NewMoney(balance)}}}
+	}
+
+	savings := []*models.Savings{
+		{ID: 1, Amount: models.NewMoney(10), StartDate: "2000-01-01", Currency: "INR"},
+		// Closed today, so it still counts at the end of last month
+		{ID: 2, Amount: models.NewMoney(20), StartDate: "2000-01-01", Currency: "INR", DeletedAt: today},
+	}
+
+	accountSvc.EXPECT().GetAll(ctx, &filters.Account{UserID: 1, IncludeDeleted: true}).Return([]*models.Account{
+		account(1, 100, "ACTIVE", ""),
+		// Deleted today, so it still counts at the end of last month
+		account(2, 0, "INACTIVE", today+"T08:00:00Z"),
+		// Deleted before the history starts, so its balance is not even looked up
+		account(3, 0, "INACTIVE", "2000-06-01T08:00:00Z"),
+	}, nil)
+	savingsSvc.EXPECT().GetHistory(ctx).Return(savings, nil)
+	savingsSvc.EXPECT().GetValuations(ctx).Return(nil, nil)
+	exchangeRateSvc.EXPECT().Converter(ctx, from, today).Return(models.NewConverter("INR", nil), nil)
+	accountSvc.EXPECT().BalanceHistory(ctx, 1, from, today, "month").Return(points(80, 100), nil)
+	accountSvc.EXPECT().BalanceHistory(ctx, 2, from, today, "month").Return(points(50, 0), nil)
+
+	netWorth, err := New(accountSvc, savingsSvc, exchangeRateSvc).Get(ctx, 2)
+
+	assert.Equal(t, nil, err)
+	assert.Equal(t, models.NewMoney(110), netWorth.NetWorth)
+	assert.Equal(t, models.NewMoney(10), netWorth.Savings)
+	assert.Equal(t, []models.NetWorthAccount{{ID: 1, Name: "Account", Type: "BANK", Currency: "INR",
+		Balance: models.NewMoney(100)}}, netWorth.Accounts)
+	assert.Equal(t, []models.Money{models.NewMoney(160), models.NewMoney(110)},
+		[]models.Money{netWorth.History[0].NetWorth, netWorth.History[1].NetWorth})
+}
+
+func Test_NetWorthPointAdd(t *testing.T) {
+	var point models.NetWorthPoint
+
+	point.Add(models.NewMoney(1000), false)
+	point.Add(models.NewMoney(-300), true)
+	point.Add(models.NewMoney(200), false)
+
+	assert.Equal(t, models.NetWorthPoint{Assets: models.NewMoney(1200), Liabilities: models.NewMoney(300),
+		NetWorth: models.NewMoney(900)}, point)
+}
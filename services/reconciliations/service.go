@@ -0,0 +1,285 @@
+package reconciliations
+
+import (
+	"errors"
+	"fmt"
+	"gofr.dev/pkg/gofr"
+	"moneyManagement/filters"
+	"moneyManagement/models"
+	"moneyManagement/services"
+	"moneyManagement/stores"
+)
+
+type reconciliationSvc struct {
+	reconciliationStore stores.Reconciliations
+	accountSvc          services.Account
+	transactionSvc      services.Transactions
+}
+
+func New(reconciliationStore stores.Reconciliations, accountSvc services.Account,
+	transactionSvc services.Transactions) services.Reconciliations {
+	return &reconciliationSvc{
+		reconciliationStore: reconciliationStore,
+		accountSvc:          accountSvc,
+		transactionSvc:      transactionSvc,
+	}
+}
+
+// Create starts reconciling an account against a statement. An account has at most one open reconciliation, and each
+// statement closes after the last reconciled one.
+func (s *reconciliationSvc) Create(ctx *gofr.Context, reconciliation *models.Reconciliation) (*models.Reconciliation, error) {
+	userID, _ := ctx.Value("userID").(int)
+
+	err := reconciliation.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := s.accountSvc.GetByID(ctx, reconciliation.Account.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if account == nil || account.DeletedAt != "" {
+		return nil, errors.New("account not found")
+	}
+
+	if reconciliation.StatementDate < account.OpeningDate {
+		return nil, errors.New("statement date must not be before the opening date of the account")
+	}
+
+	existing, err := s.reconciliationStore.GetAll(ctx, &filters.Reconciliation{UserID: userID, AccountID: account.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, r := range existing {
+		if r.Status == models.OPEN {
+			return nil, errors.New("account already has an open reconciliation")
+		}
+
+		if r.StatementDate >= reconciliation.StatementDate {
+			return nil, fmt.Errorf("statement date must be after the last reconciled statement on %s", r.StatementDate)
+		}
+	}
+
+	reconciliation.UserID = userID
+	reconciliation.Status = models.OPEN
+
+	err = s.reconciliationStore.Create(ctx, reconciliation)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetByID(ctx, reconciliation.ID)
+}
+
+func (s *reconciliationSvc) GetByID(ctx *gofr.Context, id int) (*models.Reconciliation, error) {
+	userID, _ := ctx.Value("userID").(int)
+
+	reconciliation, err := s.reconciliationStore.GetByID(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if reconciliation == nil {
+		return nil, nil
+	}
+
+	err = s.settle(ctx, reconciliation)
+	if err != nil {
+		return nil, err
+	}
+
+	return reconciliation, nil
+}
+
+func (s *reconciliationSvc) GetAll(ctx *gofr.Context, f *filters.Reconciliation) ([]*models.Reconciliation, error) {
+	userID, _ := ctx.Value("userID").(int)
+
+	f.UserID = userID
+
+	allReconciliations, err := s.reconciliationStore.GetAll(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+
+	// The list only shows the balances, the transactions of a reconciliation come with it by id
+	for _, reconciliation := range allReconciliations {
+		err = s.settle(ctx, reconciliation)
+		if err != nil {
+			return nil, err
+		}
+
+		reconciliation.Transactions = nil
+	}
+
+	return allReconciliations, nil
+}
+
+// Clear marks transactions of an open reconciliation as cleared, or back as uncleared. Only the transactions the
+// reconciliation lists can be marked.
+func (s *reconciliationSvc) Clear(ctx *gofr.Context, id int, clearing *models.ReconciliationClearing) (*models.Reconciliation, error) {
+	reconciliation, err := s.getOpen(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	listed := make(map[int]bool, len(reconciliation.Transactions))
+	for _, transaction := range reconciliation.Transactions {
+		listed[transaction.ID] = true
+	}
+
+	marked := make(map[int]bool)
+
+	for _, transactionID := range append(append([]int{}, clearing.Cleared...), clearing.Uncleared...) {
+		if !listed[transactionID] {
+			return nil, fmt.Errorf("transaction %d is not part of the reconciliation", transactionID)
+		}
+
+		if marked[transactionID] {
+			return nil, fmt.Errorf("transaction %d is marked more than once", transactionID)
+		}
+
+		marked[transactionID] = true
+	}
+
+	tx, err := ctx.SQL.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	err = s.transactionSvc.SetStatusWithTx(ctx, clearing.Cleared, reconciliation.Account.ID, models.CLEARED, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.transactionSvc.SetStatusWithTx(ctx, clearing.Uncleared, reconciliation.Account.ID, models.UNCLEARED, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetByID(ctx, id)
+}
+
+// Lock closes a reconciliation whose cleared balance matches the statement. Its cleared transactions become
+// RECONCILED and can no longer be deleted or change the balance.
+func (s *reconciliationSvc) Lock(ctx *gofr.Context, id int) (*models.Reconciliation, error) {
+	reconciliation, err := s.getOpen(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if reconciliation.Difference != 0 {
+		return nil, fmt.Errorf("reconciliation cannot be locked while the difference is %s", reconciliation.Difference)
+	}
+
+	var cleared []int
+
+	for _, transaction := range reconciliation.Transactions {
+		if transaction.StatusOn(reconciliation.Account.ID) == models.CLEARED {
+			cleared = append(cleared, transaction.ID)
+		}
+	}
+
+	tx, err := ctx.SQL.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	err = s.transactionSvc.SetStatusWithTx(ctx, cleared, reconciliation.Account.ID, models.RECONCILED, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.reconciliationStore.Lock(ctx, reconciliation, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetByID(ctx, id)
+}
+
+func (s *reconciliationSvc) getOpen(ctx *gofr.Context, id int) (*models.Reconciliation, error) {
+	reconciliation, err := s.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if reconciliation == nil {
+		return nil, errors.New("reconciliation not found")
+	}
+
+	if reconciliation.Status == models.LOCKED {
+		return nil, errors.New("reconciliation is locked")
+	}
+
+	return reconciliation, nil
+}
+
+// settle works out the cleared balance of an open reconciliation from the transactions since the account opened, and
+// lists the transactions left to reconcile. A locked reconciliation keeps the cleared balance it was locked with.
+func (s *reconciliationSvc) settle(ctx *gofr.Context, reconciliation *models.Reconciliation) error {
+	if reconciliation.Status == models.OPEN {
+		account, err := s.accountSvc.GetByID(ctx, reconciliation.Account.ID)
+		if err != nil {
+			return err
+		}
+
+		if account == nil {
+			return errors.New("account not found")
+		}
+
+		// The opening balance already holds what happened before the account opened
+		transactions, err := s.transactionSvc.GetAll(ctx, &filters.Transactions{AccountID: account.ID,
+			StartDate: account.OpeningDate, EndDate: reconciliation.StatementDate + " 23:59:59"})
+		if err != nil {
+			return err
+		}
+
+		reconciliation.ClearedBalance, reconciliation.Transactions = clearedBalance(account.OpeningBalance, account.ID, transactions)
+	}
+
+	reconciliation.Difference = reconciliation.StatementBalance - reconciliation.ClearedBalance
+
+	return nil
+}
+
+// clearedBalance adds the effect on the account of every transaction cleared or reconciled on it to the opening balance
+// and returns the transactions that are not reconciled on it yet. A transfer counts by its leg on the account.
+func clearedBalance(openingBalance models.Money, accountID int, transactions []*models.Transaction) (models.Money, []*models.Transaction) {
+	balance := openingBalance
+	pending := make([]*models.Transaction, 0, len(transactions))
+
+	for _, transaction := range transactions {
+		status := transaction.StatusOn(accountID)
+
+		if status != models.UNCLEARED {
+			balance += transaction.BalanceEffects()[accountID]
+		}
+
+		if status != models.RECONCILED {
+			pending = append(pending, transaction)
+		}
+	}
+
+	return balance, pending
+}
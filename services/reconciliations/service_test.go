@@ -0,0 +1,239 @@
+package reconciliations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+
+	"moneyManagement/filters"
+	"moneyManagement/models"
+	"moneyManagement/services"
+	"moneyManagement/stores"
+)
+
+func Test_ClearedBalance(t *testing.T) {
+	salary := &models.Transaction{ID: 1, Account: models.AccountDetails{ID: 2}, Amount: models.NewMoney(1000),
+		Type: models.INCOME, Status: models.RECONCILED}
+	rent := &models.Transaction{ID: 2, Account: models.AccountDetails{ID: 2}, Amount: models.NewMoney(400),
+		Type: models.EXPENSE, Status: models.CLEARED}
+	// A transfer counts by its leg on the account, whatever the other account did with it
+	transferIn := &models.Transaction{ID: 3, Account: models.AccountDetails{ID: 5}, ToAccount: &models.AccountDetails{ID: 2},
+		Amount: models.NewMoney(250), Type: models.TRANSFER, Status: models.UNCLEARED, ToStatus: models.CLEARED}
+	transferOut := &models.Transaction{ID: 5, Account: models.AccountDetails{ID: 2}, ToAccount: &models.AccountDetails{ID: 7},
+		Amount: models.NewMoney(60), Type: models.TRANSFER, Status: models.UNCLEARED, ToStatus: models.RECONCILED}
+	groceries := &models.Transaction{ID: 4, Account: models.AccountDetails{ID: 2}, Amount: models.NewMoney(75),
+		Type: models.EXPENSE, Status: models.UNCLEARED}
+
+	balance, pending := clearedBalance(models.NewMoney(100), 2,
+		[]*models.Transaction{salary, rent, transferIn, transferOut, groceries})
+
+	assert.Equal(t, models.NewMoney(950), balance)
+	assert.Equal(t, []*models.Transaction{rent, transferIn, transferOut, groceries}, pending)
+}
+
+func Test_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	reconciliationStore := stores.NewMockReconciliations(ctrl)
+	accountSvc := services.NewMockAccount(ctrl)
+	transactionSvc := services.NewMockTransactions(ctrl)
+
+	account := &models.Account{ID: 2, Name: "HDFC", OpeningBalance: models.NewMoney(100), OpeningDate: "2025-01-01"}
+	locked := &models.Reconciliation{ID: 1, UserID: 1, Account: models.AccountDetails{ID: 2}, StatementDate: "2025-02-28",
+		Status: models.LOCKED}
+	open := &models.Reconciliation{ID: 2, UserID: 1, Account: models.AccountDetails{ID: 2}, StatementDate: "2025-02-28",
+		Status: models.OPEN}
+
+	reconciliation := func(statementDate string) *models.Reconciliation {
+		return &models.Reconciliation{Account: models.AccountDetails{ID: 2}, StatementDate: statementDate,
+			StatementBalance: models.NewMoney(100)}
+	}
+
+	tests := []struct {
+		description string
+		input       *models.Reconciliation
+		expectedErr error
+		execMocks   func()
+	}{
+		{"statement after the last reconciled one", reconciliation("2025-03-31"), nil, func() {
+			accountSvc.EXPECT().GetByID(gomock.Any(), 2).Return(account, nil).Times(2)
+			reconciliationStore.EXPECT().GetAll(gomock.Any(), &filters.Reconciliation{UserID: 1, AccountID: 2}).
+				Return([]*models.Reconciliation{locked}, nil)
+			reconciliationStore.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+			reconciliationStore.EXPECT().GetByID(gomock.Any(), 0, 1).Return(&models.Reconciliation{UserID: 1,
+				Account: models.AccountDetails{ID: 2}, StatementDate: "2025-03-31", Status: models.OPEN}, nil)
+			transactionSvc.EXPECT().GetAll(gomock.Any(), &filters.Transactions{AccountID: 2, StartDate: "2025-01-01",
+				EndDate: "2025-03-31 23:59:59"}).Return(nil, nil)
+		}},
+		{"Failure Case: invalid statement date", reconciliation("31/03/2025"),
+			errors.New("invalid statement date format, use YYYY-MM-DD"), func() {}},
+		{"Failure Case: account not found", reconciliation("2025-03-31"), errors.New("account not found"), func() {
+			accountSvc.EXPECT().GetByID(gomock.Any(), 2).Return(nil, nil)
+		}},
+		{"Failure Case: statement before the account opened", reconciliation("2024-12-31"),
+			errors.New("statement date must not be before the opening date of the account"), func() {
+				accountSvc.EXPECT().GetByID(gomock.Any(), 2).Return(account, nil)
+			}},
+		{"Failure Case: account already has an open reconciliation", reconciliation("2025-03-31"),
+			errors.New("account already has an open reconciliation"), func() {
+				accountSvc.EXPECT().GetByID(gomock.Any(), 2).Return(account, nil)
+				reconciliationStore.EXPECT().GetAll(gomock.Any(), &filters.Reconciliation{UserID: 1, AccountID: 2}).
+					Return([]*models.Reconciliation{locked, open}, nil)
+			}},
+		{"Failure Case: statement not after the last reconciled one", reconciliation("2025-02-28"),
+			errors.New("statement date must be after the last reconciled statement on 2025-02-28"), func() {
+				accountSvc.EXPECT().GetByID(gomock.Any(), 2).Return(account, nil)
+				reconciliationStore.EXPECT().GetAll(gomock.Any(), &filters.Reconciliation{UserID: 1, AccountID: 2}).
+					Return([]*models.Reconciliation{locked}, nil)
+			}},
+	}
+
+	for i, tc := range tests {
+		mockContainer, _ := container.NewMockContainer(t)
+		ctx := &gofr.Context{Context: context.WithValue(context.Background(), "userID", 1), Container: mockContainer}
+
+		tc.execMocks()
+
+		_, err := New(reconciliationStore, accountSvc, transactionSvc).Create(ctx, tc.input)
+
+		assert.Equalf(t, tc.expectedErr, err, "TEST[%d], failed.\n%s", i, tc.description)
+	}
+}
+
+func Test_Clear(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	reconciliationStore := stores.NewMockReconciliations(ctrl)
+	accountSvc := services.NewMockAccount(ctrl)
+	transactionSvc := services.NewMockTransactions(ctrl)
+
+	account := &models.Account{ID: 2, OpeningBalance: models.NewMoney(100), OpeningDate: "2025-01-01"}
+	transactions := []*models.Transaction{
+		{ID: 2, Account: models.AccountDetails{ID: 2}, Amount: models.NewMoney(400), Type: models.EXPENSE,
+			Status: models.UNCLEARED},
+		{ID: 4, Account: models.AccountDetails{ID: 2}, Amount: models.NewMoney(75), Type: models.EXPENSE,
+			Status: models.CLEARED},
+	}
+
+	// Every read settles the open reconciliation again from the transactions of the account
+	load := func() {
+		reconciliationStore.EXPECT().GetByID(gomock.Any(), 7, 1).Return(&models.Reconciliation{ID: 7, UserID: 1,
+			Account: models.AccountDetails{ID: 2}, StatementDate: "2025-03-31", Status: models.OPEN}, nil)
+		accountSvc.EXPECT().GetByID(gomock.Any(), 2).Return(account, nil)
+		transactionSvc.EXPECT().GetAll(gomock.Any(), &filters.Transactions{AccountID: 2, StartDate: "2025-01-01",
+			EndDate: "2025-03-31 23:59:59"}).Return(transactions, nil)
+	}
+
+	tests := []struct {
+		description string
+		input       *models.ReconciliationClearing
+		expectedErr error
+		execMocks   func(mocks *container.Mocks)
+	}{
+		{"cleared and uncleared transactions are marked on the account", &models.ReconciliationClearing{
+			Cleared: []int{2}, Uncleared: []int{4}}, nil, func(mocks *container.Mocks) {
+			load()
+			mocks.SQL.ExpectBegin()
+			transactionSvc.EXPECT().SetStatusWithTx(gomock.Any(), []int{2}, 2, models.CLEARED, gomock.Any()).Return(nil)
+			transactionSvc.EXPECT().SetStatusWithTx(gomock.Any(), []int{4}, 2, models.UNCLEARED, gomock.Any()).Return(nil)
+			mocks.SQL.ExpectCommit()
+			load()
+		}},
+		{"Failure Case: transaction not listed", &models.ReconciliationClearing{Cleared: []int{2, 9}},
+			errors.New("transaction 9 is not part of the reconciliation"), func(*container.Mocks) { load() }},
+		{"Failure Case: transaction marked twice", &models.ReconciliationClearing{Cleared: []int{2}, Uncleared: []int{2}},
+			errors.New("transaction 2 is marked more than once"), func(*container.Mocks) { load() }},
+		{"Failure Case: error from transaction service", &models.ReconciliationClearing{Cleared: []int{2}},
+			errors.New("DB error"), func(mocks *container.Mocks) {
+				load()
+				mocks.SQL.ExpectBegin()
+				transactionSvc.EXPECT().SetStatusWithTx(gomock.Any(), []int{2}, 2, models.CLEARED, gomock.Any()).
+					Return(errors.New("DB error"))
+				mocks.SQL.ExpectRollback()
+			}},
+	}
+
+	for i, tc := range tests {
+		mockContainer, mocks := container.NewMockContainer(t)
+		ctx := &gofr.Context{Context: context.WithValue(context.Background(), "userID", 1), Container: mockContainer}
+
+		tc.execMocks(mocks)
+
+		_, err := New(reconciliationStore, accountSvc, transactionSvc).Clear(ctx, 7, tc.input)
+
+		assert.Equalf(t, tc.expectedErr, err, "TEST[%d], failed.\n%s", i, tc.description)
+	}
+}
+
+func Test_Lock(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	reconciliationStore := stores.NewMockReconciliations(ctrl)
+	accountSvc := services.NewMockAccount(ctrl)
+	transactionSvc := services.NewMockTransactions(ctrl)
+
+	account := &models.Account{ID: 2, OpeningBalance: models.NewMoney(100), OpeningDate: "2025-01-01"}
+	transactions := []*models.Transaction{
+		{ID: 2, Account: models.AccountDetails{ID: 2}, Amount: models.NewMoney(400), Type: models.EXPENSE,
+			Status: models.CLEARED},
+		// Transfers are reconciled by their leg on the account, whatever the other leg is
+		{ID: 3, Account: models.AccountDetails{ID: 5}, ToAccount: &models.AccountDetails{ID: 2},
+			Amount: models.NewMoney(250), Type: models.TRANSFER, Status: models.UNCLEARED, ToStatus: models.CLEARED},
+		{ID: 5, Account: models.AccountDetails{ID: 2}, ToAccount: &models.AccountDetails{ID: 7},
+			Amount: models.NewMoney(60), Type: models.TRANSFER, Status: models.CLEARED, ToStatus: models.UNCLEARED},
+		{ID: 6, Account: models.AccountDetails{ID: 5}, ToAccount: &models.AccountDetails{ID: 2},
+			Amount: models.NewMoney(30), Type: models.TRANSFER, Status: models.CLEARED, ToStatus: models.UNCLEARED},
+		{ID: 4, Account: models.AccountDetails{ID: 2}, Amount: models.NewMoney(75), Type: models.EXPENSE,
+			Status: models.UNCLEARED},
+	}
+
+	load := func(statementBalance models.Money) {
+		reconciliationStore.EXPECT().GetByID(gomock.Any(), 7, 1).Return(&models.Reconciliation{ID: 7, UserID: 1,
+			Account: models.AccountDetails{ID: 2}, StatementDate: "2025-03-31", StatementBalance: statementBalance,
+			Status: models.OPEN}, nil)
+		accountSvc.EXPECT().GetByID(gomock.Any(), 2).Return(account, nil)
+		transactionSvc.EXPECT().GetAll(gomock.Any(), &filters.Transactions{AccountID: 2, StartDate: "2025-01-01",
+			EndDate: "2025-03-31 23:59:59"}).Return(transactions, nil)
+	}
+
+	tests := []struct {
+		description string
+		expectedErr error
+		execMocks   func(mocks *container.Mocks)
+	}{
+		{"transactions cleared on the account are reconciled", nil, func(mocks *container.Mocks) {
+			load(models.NewMoney(-110))
+			mocks.SQL.ExpectBegin()
+			transactionSvc.EXPECT().SetStatusWithTx(gomock.Any(), []int{2, 3, 5}, 2, models.RECONCILED, gomock.Any()).
+				Return(nil)
+			reconciliationStore.EXPECT().Lock(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			mocks.SQL.ExpectCommit()
+			reconciliationStore.EXPECT().GetByID(gomock.Any(), 7, 1).Return(&models.Reconciliation{ID: 7, UserID: 1,
+				Account: models.AccountDetails{ID: 2}, StatementDate: "2025-03-31", Status: models.LOCKED}, nil)
+		}},
+		{"Failure Case: difference is not zero",
+			fmt.Errorf("reconciliation cannot be locked while the difference is %s", models.NewMoney(50)),
+			func(*container.Mocks) { load(models.NewMoney(-60)) }},
+		{"Failure Case: reconciliation is locked", errors.New("reconciliation is locked"), func(*container.Mocks) {
+			reconciliationStore.EXPECT().GetByID(gomock.Any(), 7, 1).Return(&models.Reconciliation{ID: 7, UserID: 1,
+				Account: models.AccountDetails{ID: 2}, StatementDate: "2025-03-31", Status: models.LOCKED}, nil)
+		}},
+		{"Failure Case: reconciliation not found", errors.New("reconciliation not found"), func(*container.Mocks) {
+			reconciliationStore.EXPECT().GetByID(gomock.Any(), 7, 1).Return(nil, nil)
+		}},
+	}
+
+	for i, tc := range tests {
+		mockContainer, mocks := container.NewMockContainer(t)
+		ctx := &gofr.Context{Context: context.WithValue(context.Background(), "userID", 1), Container: mockContainer}
+
+		tc.execMocks(mocks)
+
+		_, err := New(reconciliationStore, accountSvc, transactionSvc).Lock(ctx, 7)
+
+		assert.Equalf(t, tc.expectedErr, err, "TEST[%d], failed.\n%s", i, tc.description)
+	}
+}
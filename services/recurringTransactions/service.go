@@ -7,17 +7,34 @@ import (
 	"moneyManagement/models"
 	"moneyManagement/services"
 	"moneyManagement/stores"
+	"slices"
+	"sort"
 	"time"
 )
 
+const (
+	defaultOccurrences = 10
+	maxOccurrences     = 100
+
+	// maxCalendarDays is the longest range a calendar lays out
+	maxCalendarDays = 366
+)
+
 type recurringTransactionSvc struct {
 	recurringTransactionStore stores.RecurringTransactions
+	exceptionStore            stores.RecurringExceptions
+	holidayStore              stores.Holidays
+	transactionSvc            services.Transactions
 	userSvc                   services.User
 }
 
-func New(recurringTransactionStore stores.RecurringTransactions, userSvc services.User) services.RecurringTransactions {
+func New(recurringTransactionStore stores.RecurringTransactions, exceptionStore stores.RecurringExceptions,
+	holidayStore stores.Holidays, transactionSvc services.Transactions, userSvc services.User) services.RecurringTransactions {
 	return &recurringTransactionSvc{
 		recurringTransactionStore: recurringTransactionStore,
+		exceptionStore:            exceptionStore,
+		holidayStore:              holidayStore,
+		transactionSvc:            transactionSvc,
 		userSvc:                   userSvc,
 	}
 }
@@ -26,13 +43,26 @@ func (s *recurringTransactionSvc) Create(ctx *gofr.Context, recurringTransaction
 	userID, _ := ctx.Value("userID").(int)
 
 	recurringTransaction.UserID = userID
-	recurringTransaction.StartDate, _ = convertToMySQLDate(recurringTransaction.StartDate)
-	recurringTransaction.EndDate, _ = convertToMySQLDate(recurringTransaction.EndDate)
-	nextRun, err := calculateNextRun(recurringTransaction.StartDate, "", recurringTransaction.Frequency, recurringTransaction.CustomDays)
+
+	if recurringTransaction.RRule != "" {
+		err := recurringTransaction.ApplyRRule()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err := s.attachHolidays(ctx, userID, []*models.RecurringTransaction{recurringTransaction})
 	if err != nil {
 		return nil, err
 	}
 
+	nextRun, err := calculateNextRun(recurringTransaction, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	recurringTransaction.StartDate, _ = convertToMySQLDate(recurringTransaction.StartDate)
+	recurringTransaction.EndDate, _ = convertToMySQLDate(recurringTransaction.EndDate)
 	recurringTransaction.NextRun = nextRun
 
 	err = s.recurringTransactionStore.Create(ctx, recurringTransaction)
@@ -52,6 +82,16 @@ func (s *recurringTransactionSvc) GetByID(ctx *gofr.Context, id int) (*models.Re
 	userID, _ := ctx.Value("userID").(int)
 
 	transaction, err := s.recurringTransactionStore.GetByID(ctx, id, userID)
+	if err != nil || transaction == nil {
+		return transaction, err
+	}
+
+	err = s.attachExceptions(ctx, userID, []*models.RecurringTransaction{transaction})
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.attachHolidays(ctx, userID, []*models.RecurringTransaction{transaction})
 	if err != nil {
 		return nil, err
 	}
@@ -69,34 +109,131 @@ func (s *recurringTransactionSvc) GetAll(ctx *gofr.Context, f *filters.Recurring
 		return nil, err
 	}
 
+	err = s.attachExceptions(ctx, userID, accounts)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.attachHolidays(ctx, userID, accounts)
+	if err != nil {
+		return nil, err
+	}
+
 	return accounts, nil
 }
 
+// attachExceptions gives each recurring transaction the exceptions of its occurrences.
+func (s *recurringTransactionSvc) attachExceptions(ctx *gofr.Context, userID int, recurringTransactions []*models.RecurringTransaction) error {
+	if len(recurringTransactions) == 0 {
+		return nil
+	}
+
+	exceptions, err := s.exceptionStore.GetAll(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	byRule := make(map[int][]models.RecurringException)
+
+	for _, exception := range exceptions {
+		byRule[exception.RecurringTransactionID] = append(byRule[exception.RecurringTransactionID], *exception)
+	}
+
+	for _, recurringTransaction := range recurringTransactions {
+		recurringTransaction.Exceptions = byRule[recurringTransaction.ID]
+	}
+
+	return nil
+}
+
+// attachHolidays gives the recurring transactions that shift off weekends the holidays of the user to shift off too.
+func (s *recurringTransactionSvc) attachHolidays(ctx *gofr.Context, userID int, recurringTransactions []*models.RecurringTransaction) error {
+	shifting := slices.ContainsFunc(recurringTransactions, func(r *models.RecurringTransaction) bool {
+		return r.ShiftPolicy() != models.ShiftNone
+	})
+	if !shifting {
+		return nil
+	}
+
+	holidays, err := s.holidayStore.GetAll(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	dates := make(map[string]bool, len(holidays))
+
+	for _, holiday := range holidays {
+		dates[holiday.Date] = true
+	}
+
+	for _, recurringTransaction := range recurringTransactions {
+		recurringTransaction.Holidays = dates
+	}
+
+	return nil
+}
+
 func (s *recurringTransactionSvc) Update(ctx *gofr.Context, recurringTransaction *models.RecurringTransaction) (*models.RecurringTransaction, error) {
 	userID, _ := ctx.Value("userID").(int)
 
-	oldTxn, err := s.GetByID(ctx, recurringTransaction.ID)
+	tx, err := ctx.SQL.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	// The rule is locked so that a run posting meanwhile cannot have its markers overwritten
+	oldTxn, err := s.recurringTransactionStore.GetByIDForUpdate(ctx, recurringTransaction.ID, tx)
 	if err != nil {
 		return nil, err
 	}
 
+	if oldTxn == nil || oldTxn.UserID != userID || oldTxn.DeletedAt != "" {
+		return nil, errors.New("recurring transaction not found")
+	}
+
 	recurringTransaction.UserID = userID
-	recurringTransaction.StartDate, _ = convertToMySQLDate(recurringTransaction.StartDate)
-	recurringTransaction.EndDate, _ = convertToMySQLDate(recurringTransaction.EndDate)
-	recurringTransaction.NextRun, _ = convertToMySQLDate(recurringTransaction.NextRun)
-	recurringTransaction.LastRun, _ = convertToMySQLDate(recurringTransaction.LastRun)
 
-	if recurringTransaction.Frequency != oldTxn.Frequency ||
-		(recurringTransaction.Frequency == models.CUSTOM && recurringTransaction.Frequency == oldTxn.Frequency && recurringTransaction.CustomDays != oldTxn.CustomDays) {
-		nextRun, err := calculateNextRun(recurringTransaction.StartDate, "", recurringTransaction.Frequency, recurringTransaction.CustomDays)
+	if recurringTransaction.RRule != "" {
+		err = recurringTransaction.ApplyRRule()
 		if err != nil {
 			return nil, err
 		}
+	}
 
-		recurringTransaction.NextRun = nextRun
+	_, err = recurringTransaction.Schedule()
+	if err != nil {
+		return nil, err
 	}
 
-	err = s.recurringTransactionStore.Update(ctx, recurringTransaction)
+	err = s.attachHolidays(ctx, userID, []*models.RecurringTransaction{recurringTransaction})
+	if err != nil {
+		return nil, err
+	}
+
+	// The run markers belong to the server: the next run only moves when the schedule changes
+	recurringTransaction.NextRun, _ = convertToMySQLDate(oldTxn.NextRun)
+	recurringTransaction.LastRun, _ = convertToMySQLDate(oldTxn.LastRun)
+
+	if !sameSchedule(recurringTransaction, oldTxn) {
+		recurringTransaction.NextRun, err = calculateNextRun(recurringTransaction, time.Now().UTC())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	recurringTransaction.StartDate, _ = convertToMySQLDate(recurringTransaction.StartDate)
+	recurringTransaction.EndDate, _ = convertToMySQLDate(recurringTransaction.EndDate)
+
+	err = s.recurringTransactionStore.Update(ctx, recurringTransaction, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
 	if err != nil {
 		return nil, err
 	}
@@ -125,6 +262,646 @@ func (s *recurringTransactionSvc) Delete(ctx *gofr.Context, id int) error {
 	return nil
 }
 
+// Pause stops a recurring transaction from posting until the given date, or until it is resumed when there is none.
+// Occurrences falling while it is paused are not posted, not even once it is resumed.
+func (s *recurringTransactionSvc) Pause(ctx *gofr.Context, id int, pause *models.RecurringPause) (*models.RecurringTransaction, error) {
+	userID, _ := ctx.Value("userID").(int)
+	now := time.Now().UTC()
+
+	var pausedUntil interface{}
+
+	if pause.Until != "" {
+		until, err := time.Parse("2006-01-02", pause.Until)
+		if err != nil {
+			return nil, errors.New("invalid until date format, use YYYY-MM-DD")
+		}
+
+		if !until.After(now) {
+			return nil, errors.New("until must be in the future")
+		}
+
+		pausedUntil = pause.Until
+	}
+
+	return s.updatePause(ctx, id, userID, now.Format("2006-01-02 15:04:05"), pausedUntil)
+}
+
+// Resume lets a paused recurring transaction post again from its next occurrence.
+func (s *recurringTransactionSvc) Resume(ctx *gofr.Context, id int) (*models.RecurringTransaction, error) {
+	userID, _ := ctx.Value("userID").(int)
+
+	return s.updatePause(ctx, id, userID, nil, nil)
+}
+
+func (s *recurringTransactionSvc) updatePause(ctx *gofr.Context, id, userID int, pausedAt, pausedUntil interface{}) (*models.RecurringTransaction, error) {
+	updated, err := s.recurringTransactionStore.UpdatePause(ctx, id, userID, pausedAt, pausedUntil)
+	if err != nil {
+		return nil, err
+	}
+
+	if !updated {
+		return nil, errors.New("recurring transaction not found")
+	}
+
+	return s.GetByID(ctx, id)
+}
+
+// CreateException skips a single occurrence of a recurring transaction, or posts it with another amount or at another
+// date, replacing the exception it already has. The occurrence is the time the rule schedules it at, and a moved
+// occurrence has to stay between the occurrences before and after it so that the rule still posts in order.
+func (s *recurringTransactionSvc) CreateException(ctx *gofr.Context, id int,
+	exception *models.RecurringException) (*models.RecurringException, error) {
+	recurringTransaction, err := s.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if recurringTransaction == nil {
+		return nil, errors.New("recurring transaction not found")
+	}
+
+	err = validateException(recurringTransaction, exception)
+	if err != nil {
+		return nil, err
+	}
+
+	stored := *exception
+	stored.RecurringTransactionID = recurringTransaction.ID
+	stored.UserID = recurringTransaction.UserID
+	stored.Occurrence, _ = convertToMySQLDate(exception.Occurrence)
+	stored.Date, _ = convertToMySQLDate(exception.Date)
+
+	err = s.exceptionStore.Upsert(ctx, &stored)
+	if err != nil {
+		return nil, err
+	}
+
+	stored.Occurrence, stored.Date = exception.Occurrence, exception.Date
+
+	return &stored, nil
+}
+
+// DeleteException removes an exception, so that its occurrence posts as the rule schedules it again.
+func (s *recurringTransactionSvc) DeleteException(ctx *gofr.Context, id, exceptionID int) error {
+	userID, _ := ctx.Value("userID").(int)
+
+	deleted, err := s.exceptionStore.Delete(ctx, exceptionID, id, userID)
+	if err != nil {
+		return err
+	}
+
+	if !deleted {
+		return errors.New("recurring exception not found")
+	}
+
+	return nil
+}
+
+// validateException checks that an exception changes an occurrence the rule is still to post, and normalizes its
+// times to the layout the rule uses.
+func validateException(recurringTransaction *models.RecurringTransaction, exception *models.RecurringException) error {
+	occurrence, err := time.Parse(time.RFC3339, exception.Occurrence)
+	if err != nil {
+		return errors.New("invalid occurrence format, use an ISO 8601 timestamp")
+	}
+
+	previous, next, err := recurringTransaction.Around(occurrence)
+	if err != nil {
+		return err
+	}
+
+	if recurringTransaction.EndDate != "" {
+		endDate, err := time.Parse(time.RFC3339, recurringTransaction.EndDate)
+		if err == nil && occurrence.After(endDate) {
+			return errors.New("the recurring transaction is not scheduled at that time")
+		}
+	}
+
+	nextRun, err := time.Parse(time.RFC3339, recurringTransaction.NextRun)
+	if err != nil || occurrence.Before(nextRun) {
+		return errors.New("the occurrence has already been posted")
+	}
+
+	exception.Occurrence = occurrence.UTC().Format("2006-01-02T15:04:05.000Z")
+
+	if exception.Skip {
+		if exception.Amount != nil || exception.Date != "" {
+			return errors.New("a skipped occurrence cannot have an amount or date")
+		}
+
+		return nil
+	}
+
+	if exception.Amount == nil && exception.Date == "" {
+		return errors.New("an exception must skip the occurrence or change its amount or date")
+	}
+
+	if exception.Amount != nil && *exception.Amount <= 0 {
+		return errors.New("amount must be positive")
+	}
+
+	if exception.Date != "" {
+		date, err := time.Parse(time.RFC3339, exception.Date)
+		if err != nil {
+			return errors.New("invalid date format, use an ISO 8601 timestamp")
+		}
+
+		if !date.After(previous) || !date.Before(next) {
+			return errors.New("date must be between the previous and the next occurrence")
+		}
+
+		exception.Date = date.UTC().Format("2006-01-02T15:04:05.000Z")
+	}
+
+	return nil
+}
+
+// Occurrences previews the next count occurrences of a recurring transaction, 10 by default.
+func (s *recurringTransactionSvc) Occurrences(ctx *gofr.Context, id, count int) ([]models.RecurringOccurrence, error) {
+	if count == 0 {
+		count = defaultOccurrences
+	}
+
+	if count < 0 || count > maxOccurrences {
+		return nil, errors.New("count must be between 1 and 100")
+	}
+
+	recurringTransaction, err := s.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if recurringTransaction == nil {
+		return nil, errors.New("recurring transaction not found")
+	}
+
+	occurrences, err := recurringTransaction.Upcoming(count)
+	if err != nil {
+		return nil, err
+	}
+
+	if occurrences == nil {
+		occurrences = []models.RecurringOccurrence{}
+	}
+
+	return occurrences, nil
+}
+
+// Calendar expands every recurring transaction of the user into the occurrences still to post from the from date to
+// the to date, both included, grouped by day.
+func (s *recurringTransactionSvc) Calendar(ctx *gofr.Context, from, to time.Time) (*models.RecurringCalendar, error) {
+	if to.Before(from) {
+		return nil, errors.New("to must not be before from")
+	}
+
+	if to.Sub(from) >= maxCalendarDays*24*time.Hour {
+		return nil, errors.New("calendar range must be at most 366 days")
+	}
+
+	recurringTransactions, err := s.GetAll(ctx, &filters.RecurringTransactions{})
+	if err != nil {
+		return nil, err
+	}
+
+	occurrences, err := calendarOccurrences(recurringTransactions, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	calendar := &models.RecurringCalendar{From: from.Format("2006-01-02"), To: to.Format("2006-01-02"),
+		Days: []models.RecurringCalendarDay{}}
+
+	for _, occurrence := range occurrences {
+		date := occurrence.Date[:len("2006-01-02")]
+
+		if n := len(calendar.Days); n == 0 || calendar.Days[n-1].Date != date {
+			calendar.Days = append(calendar.Days, models.RecurringCalendarDay{Date: date})
+		}
+
+		day := &calendar.Days[len(calendar.Days)-1]
+		day.Occurrences = append(day.Occurrences, occurrence)
+	}
+
+	return calendar, nil
+}
+
+// calendarOccurrences lists the occurrences of the recurring transactions on the days from the from date to the to
+// date in the order they post.
+func calendarOccurrences(recurringTransactions []*models.RecurringTransaction,
+	from, to time.Time) ([]models.RecurringOccurrence, error) {
+	var occurrences []models.RecurringOccurrence
+
+	// The end of the last day, so that occurrences at any time of that day are included
+	end := to.AddDate(0, 0, 1).Add(-time.Second)
+
+	for _, recurringTransaction := range recurringTransactions {
+		ruleOccurrences, err := recurringTransaction.Occurrences(end)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, occurrence := range ruleOccurrences {
+			if occurrence.Date < from.Format("2006-01-02") || occurrence.Date > end.Format("2006-01-02T15:04:05.000Z") {
+				continue
+			}
+
+			occurrences = append(occurrences, occurrence)
+		}
+	}
+
+	sort.SliceStable(occurrences, func(i, j int) bool {
+		if occurrences[i].Date != occurrences[j].Date {
+			return occurrences[i].Date < occurrences[j].Date
+		}
+
+		return occurrences[i].RecurringTransactionID < occurrences[j].RecurringTransactionID
+	})
+
+	return occurrences, nil
+}
+
+// PostDue turns every due occurrence of every recurring transaction into a real transaction, catching up on
+// periods missed while the job was not running. Skipped occurrences and those falling while the rule is paused are
+// passed over, and those of rules that require approval are left pending. Each occurrence is posted in its own SQL transaction together
+// with the advance of the rule's run markers, so a restart mid-batch or a concurrent run never posts twice.
+func (s *recurringTransactionSvc) PostDue(ctx *gofr.Context) error {
+	now := time.Now().UTC()
+
+	due, err := s.recurringTransactionStore.GetDue(ctx, now.Format("2006-01-02 15:04:05"))
+	if err != nil {
+		return err
+	}
+
+	for _, recurringTransaction := range due {
+		userCtx := services.WithUser(ctx, recurringTransaction.UserID)
+
+		for {
+			posted, err := s.postNext(userCtx, recurringTransaction.ID, now)
+			if err != nil {
+				ctx.Logger.Errorf("error posting recurring transaction %d: %v", recurringTransaction.ID, err)
+				break
+			}
+
+			if !posted {
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// postNext posts the pending occurrence of a single rule if it is still due at now. The rule row is locked
+// first, so whichever instance gets the lock posts the occurrence and the others find next_run already moved.
+func (s *recurringTransactionSvc) postNext(ctx *gofr.Context, id int, now time.Time) (bool, error) {
+	tx, err := ctx.SQL.Begin()
+	if err != nil {
+		return false, err
+	}
+
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	recurringTransaction, err := s.recurringTransactionStore.GetByIDForUpdate(ctx, id, tx)
+	if err != nil {
+		return false, err
+	}
+
+	if recurringTransaction == nil || recurringTransaction.DeletedAt != "" || recurringTransaction.NextRun == "" {
+		return false, nil
+	}
+
+	err = s.attachHolidays(ctx, recurringTransaction.UserID, []*models.RecurringTransaction{recurringTransaction})
+	if err != nil {
+		return false, err
+	}
+
+	runAt, err := time.Parse(time.RFC3339, recurringTransaction.NextRun)
+	if err != nil {
+		return false, err
+	}
+
+	var endDate time.Time
+
+	if recurringTransaction.EndDate != "" {
+		endDate, err = time.Parse(time.RFC3339, recurringTransaction.EndDate)
+		if err != nil {
+			return false, err
+		}
+
+		if runAt.After(endDate) {
+			return false, nil
+		}
+	}
+
+	exception, err := s.exceptionStore.GetByOccurrence(ctx, id, runAt.Format("2006-01-02 15:04:05"), tx)
+	if err != nil {
+		return false, err
+	}
+
+	if exception != nil {
+		recurringTransaction.Exceptions = []models.RecurringException{*exception}
+	}
+
+	// A paused or skipped occurrence is passed over once it is due, a moved one once its own date is
+	occurrence, posted := recurringTransaction.Occurrence(runAt)
+
+	postAt, err := time.Parse(time.RFC3339, occurrence.Date)
+	if err != nil {
+		return false, err
+	}
+
+	if postAt.After(now) {
+		return false, nil
+	}
+
+	// An occurrence to post of a rule that requires approval stays pending until the user settles it
+	if posted && recurringTransaction.RequiresApproval {
+		return false, nil
+	}
+
+	var lastRun interface{}
+
+	if posted {
+		transaction := &models.Transaction{
+			UserID:          recurringTransaction.UserID,
+			Account:         recurringTransaction.Account,
+			Amount:          occurrence.Amount,
+			Type:            recurringTransaction.Type,
+			Category:        recurringTransaction.Category,
+			Description:     recurringTransaction.Description,
+			TransactionDate: postAt.Format("2006-01-02 15:04:05"),
+		}
+
+		err = s.transactionSvc.CreateWithTx(ctx, transaction, tx)
+		if err != nil {
+			return false, err
+		}
+
+		lastRun = postAt.Format("2006-01-02 15:04:05")
+	}
+
+	nextRun, err := nextRunAfter(recurringTransaction, runAt)
+	if err != nil {
+		return false, err
+	}
+
+	err = s.recurringTransactionStore.UpdateRun(ctx, id, lastRun, nextRun, tx)
+	if err != nil {
+		return false, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// Pending lists the due occurrences of the recurring transactions that require approval, oldest first.
+func (s *recurringTransactionSvc) Pending(ctx *gofr.Context) ([]models.RecurringOccurrence, error) {
+	recurringTransactions, err := s.GetAll(ctx, &filters.RecurringTransactions{})
+	if err != nil {
+		return nil, err
+	}
+
+	pending, err := pendingOccurrences(recurringTransactions, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	if pending == nil {
+		pending = []models.RecurringOccurrence{}
+	}
+
+	return pending, nil
+}
+
+// ConfirmPending posts the oldest pending occurrence of a recurring transaction, with the confirmed amount and date
+// when they are given, and returns the transaction.
+func (s *recurringTransactionSvc) ConfirmPending(ctx *gofr.Context,
+	confirmation *models.RecurringConfirmation) (*models.Transaction, error) {
+	if confirmation.Amount != nil && *confirmation.Amount <= 0 {
+		return nil, errors.New("amount must be positive")
+	}
+
+	if confirmation.Date != "" {
+		_, err := time.Parse(time.RFC3339, confirmation.Date)
+		if err != nil {
+			return nil, errors.New("invalid date format, use an ISO 8601 timestamp")
+		}
+	}
+
+	transactionID, err := s.settlePending(ctx, confirmation, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.transactionSvc.GetByID(ctx, transactionID)
+}
+
+// RejectPending passes over the oldest pending occurrence of a recurring transaction without posting it.
+func (s *recurringTransactionSvc) RejectPending(ctx *gofr.Context, confirmation *models.RecurringConfirmation) error {
+	_, err := s.settlePending(ctx, confirmation, false)
+
+	return err
+}
+
+// settlePending confirms or rejects the oldest pending occurrence of a rule that requires approval, which the
+// confirmation has to name, so that occurrences are settled in the order they are due. The rule row is locked and
+// moved past the occurrence in the same SQL transaction that posts it, so an occurrence is never settled twice.
+func (s *recurringTransactionSvc) settlePending(ctx *gofr.Context, confirmation *models.RecurringConfirmation,
+	confirm bool) (int, error) {
+	userID, _ := ctx.Value("userID").(int)
+	now := time.Now().UTC()
+
+	scheduled, err := time.Parse(time.RFC3339, confirmation.Occurrence)
+	if err != nil {
+		return 0, errors.New("invalid occurrence format, use an ISO 8601 timestamp")
+	}
+
+	tx, err := ctx.SQL.Begin()
+	if err != nil {
+		return 0, err
+	}
+
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	recurringTransaction, err := s.recurringTransactionStore.GetByIDForUpdate(ctx, confirmation.RecurringTransactionID, tx)
+	if err != nil {
+		return 0, err
+	}
+
+	if recurringTransaction == nil || recurringTransaction.UserID != userID || recurringTransaction.DeletedAt != "" {
+		return 0, errors.New("recurring transaction not found")
+	}
+
+	if !recurringTransaction.RequiresApproval {
+		return 0, errors.New("the recurring transaction does not require approval")
+	}
+
+	err = s.attachExceptions(ctx, userID, []*models.RecurringTransaction{recurringTransaction})
+	if err != nil {
+		return 0, err
+	}
+
+	err = s.attachHolidays(ctx, userID, []*models.RecurringTransaction{recurringTransaction})
+	if err != nil {
+		return 0, err
+	}
+
+	pending, err := pendingOccurrences([]*models.RecurringTransaction{recurringTransaction}, now)
+	if err != nil {
+		return 0, err
+	}
+
+	occurrence, err := oldestPending(pending, scheduled)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		lastRun       interface{}
+		transactionID int
+	)
+
+	if confirm {
+		transaction := &models.Transaction{
+			UserID:      recurringTransaction.UserID,
+			Account:     recurringTransaction.Account,
+			Amount:      occurrence.Amount,
+			Type:        recurringTransaction.Type,
+			Category:    recurringTransaction.Category,
+			Description: recurringTransaction.Description,
+		}
+
+		if confirmation.Amount != nil {
+			transaction.Amount = *confirmation.Amount
+		}
+
+		transaction.TransactionDate, _ = convertToMySQLDate(occurrence.Date)
+		if confirmation.Date != "" {
+			transaction.TransactionDate, _ = convertToMySQLDate(confirmation.Date)
+		}
+
+		err = s.transactionSvc.CreateWithTx(ctx, transaction, tx)
+		if err != nil {
+			return 0, err
+		}
+
+		lastRun, transactionID = transaction.TransactionDate, transaction.ID
+	}
+
+	nextRun, err := nextRunAfter(recurringTransaction, scheduled)
+	if err != nil {
+		return 0, err
+	}
+
+	err = s.recurringTransactionStore.UpdateRun(ctx, recurringTransaction.ID, lastRun, nextRun, tx)
+	if err != nil {
+		return 0, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return 0, err
+	}
+
+	return transactionID, nil
+}
+
+// pendingOccurrences lists the occurrences of the rules that require approval that are due at now, by date. Each one
+// carries the time it is scheduled at, which it is confirmed or rejected by.
+func pendingOccurrences(recurringTransactions []*models.RecurringTransaction,
+	now time.Time) ([]models.RecurringOccurrence, error) {
+	var pending []models.RecurringOccurrence
+
+	for _, recurringTransaction := range recurringTransactions {
+		if !recurringTransaction.RequiresApproval {
+			continue
+		}
+
+		occurrences, err := recurringTransaction.Occurrences(now)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, occurrence := range occurrences {
+			// Moved to a date still to come
+			if occurrence.Date > now.Format("2006-01-02T15:04:05.000Z") {
+				continue
+			}
+
+			if occurrence.Scheduled == "" {
+				occurrence.Scheduled = occurrence.Date
+			}
+
+			pending = append(pending, occurrence)
+		}
+	}
+
+	sort.SliceStable(pending, func(i, j int) bool {
+		if pending[i].Date != pending[j].Date {
+			return pending[i].Date < pending[j].Date
+		}
+
+		return pending[i].RecurringTransactionID < pending[j].RecurringTransactionID
+	})
+
+	return pending, nil
+}
+
+// oldestPending returns the oldest of the pending occurrences of a rule if it is the one scheduled at scheduled.
+func oldestPending(pending []models.RecurringOccurrence, scheduled time.Time) (models.RecurringOccurrence, error) {
+	key := scheduled.UTC().Format("2006-01-02T15:04:05.000Z")
+
+	for i, occurrence := range pending {
+		if occurrence.Scheduled != key {
+			continue
+		}
+
+		if i > 0 {
+			return models.RecurringOccurrence{},
+				errors.New("settle the earlier pending occurrences of the recurring transaction first")
+		}
+
+		return occurrence, nil
+	}
+
+	return models.RecurringOccurrence{}, errors.New("pending occurrence not found")
+}
+
+// nextRunAfter returns the run of the rule following runAt in the MySQL layout, or nil once it falls past the end date
+// and the rule is finished.
+func nextRunAfter(recurringTransaction *models.RecurringTransaction, runAt time.Time) (interface{}, error) {
+	recurrence, err := recurringTransaction.Schedule()
+	if err != nil {
+		return nil, err
+	}
+
+	next, err := recurrence.Following(runAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if recurringTransaction.EndDate != "" {
+		endDate, err := time.Parse(time.RFC3339, recurringTransaction.EndDate)
+		if err != nil {
+			return nil, err
+		}
+
+		if next.After(endDate) {
+			return nil, nil
+		}
+	}
+
+	return next.Format("2006-01-02 15:04:05"), nil
+}
+
 func convertToMySQLDate(isoDate string) (string, error) {
 	if isoDate != "" {
 		t, err := time.Parse(time.RFC3339, isoDate) // Parses "2025-03-20T07:49:00.000Z"
@@ -138,47 +915,37 @@ func convertToMySQLDate(isoDate string) (string, error) {
 	return "", nil
 }
 
-func calculateNextRun(startDateStr, lastRunStr string, freq models.Frequency, customDays int) (string, error) {
-	var base time.Time
-	var err error
-
-	layout := "2006-01-02 15:04:05"
-	now := time.Now()
-
-	// 1. Determine base time
-	if lastRunStr != "" {
-		base, err = time.Parse(layout, lastRunStr)
-	} else if startDateStr != "" {
-		base, err = time.Parse(layout, startDateStr)
-	} else {
-		return "", errors.New("no base date provided")
+// calculateNextRun returns the first occurrence of the rule after now, in the MySQL layout, or an empty string when
+// the rule has ended by then.
+func calculateNextRun(recurringTransaction *models.RecurringTransaction, now time.Time) (string, error) {
+	recurrence, err := recurringTransaction.Schedule()
+	if err != nil {
+		return "", err
 	}
+
+	next, err := recurrence.Next(now)
 	if err != nil {
 		return "", err
 	}
 
-	// 2. Calculate next run
-	for {
-		switch freq {
-		case models.DAILY:
-			base = base.AddDate(0, 0, 1)
-		case models.WEEKLY:
-			base = base.AddDate(0, 0, 7)
-		case models.MONTHLY:
-			base = base.AddDate(0, 1, 0)
-		case models.CUSTOM:
-			if customDays <= 0 {
-				return "", errors.New("invalid customDays: must be > 0")
-			}
-			base = base.AddDate(0, 0, customDays)
-		default:
-			return "", errors.New("unsupported frequency")
+	if recurringTransaction.EndDate != "" {
+		endDate, err := time.Parse(time.RFC3339, recurringTransaction.EndDate)
+		if err != nil {
+			return "", err
 		}
 
-		if base.After(now) {
-			break
+		if next.After(endDate) {
+			return "", nil
 		}
 	}
 
-	return base.Format(layout), nil
+	return next.Format("2006-01-02 15:04:05"), nil
+}
+
+// sameSchedule reports whether an update leaves the recurrence of a rule as it was.
+func sameSchedule(updated, old *models.RecurringTransaction) bool {
+	return updated.Frequency == old.Frequency && updated.CustomDays == old.CustomDays &&
+		max(updated.Interval, 1) == max(old.Interval, 1) && slices.Equal(updated.MonthDays, old.MonthDays) &&
+		updated.Weekday == old.Weekday && updated.WeekdayOrdinal == old.WeekdayOrdinal &&
+		updated.ShiftPolicy() == old.ShiftPolicy()
 }
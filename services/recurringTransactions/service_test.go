@@ -0,0 +1,443 @@
+package recurringTransactions
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	gofrSQL "gofr.dev/pkg/gofr/datasource/sql"
+
+	"moneyManagement/models"
+	"moneyManagement/services"
+	"moneyManagement/stores"
+)
+
+func Test_CalendarOccurrences(t *testing.T) {
+	rent := &models.RecurringTransaction{ID: 2, Account: models.AccountDetails{ID: 1}, Amount: models.NewMoney(300),
+		Type: models.EXPENSE, Category: "Rent", Frequency: models.MONTHLY, NextRun: "2025-06-30T09:00:00.000Z"}
+	gym := &models.RecurringTransaction{ID: 1, Account: models.AccountDetails{ID: 2}, Amount: models.NewMoney(20),
+		Type: models.EXPENSE, Category: "Gym", Frequency: models.CUSTOM, CustomDays: 15, NextRun: "2025-07-16T00:00:00.000Z"}
+	ended := &models.RecurringTransaction{ID: 3, Frequency: models.DAILY}
+
+	from := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 7, 31, 0, 0, 0, 0, time.UTC)
+
+	occurrences, err := calendarOccurrences([]*models.RecurringTransaction{rent, gym, ended}, from, to)
+
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []models.RecurringOccurrence{
+		{RecurringTransactionID: 1, Date: "2025-07-16T00:00:00.000Z", Account: models.AccountDetails{ID: 2},
+			Amount: models.NewMoney(20), Type: models.EXPENSE, Category: "Gym"},
+		{RecurringTransactionID: 2, Date: "2025-07-30T09:00:00.000Z", Account: models.AccountDetails{ID: 1},
+			Amount: models.NewMoney(300), Type: models.EXPENSE, Category: "Rent"},
+		{RecurringTransactionID: 1, Date: "2025-07-31T00:00:00.000Z", Account: models.AccountDetails{ID: 2},
+			Amount: models.NewMoney(20), Type: models.EXPENSE, Category: "Gym"},
+	}, occurrences)
+}
+
+func Test_CalculateNextRun(t *testing.T) {
+	now := time.Date(2025, 2, 10, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		description    string
+		rule           models.RecurringTransaction
+		expectedOutput string
+		expectedErr    error
+	}{
+		{"month end salary", models.RecurringTransaction{Frequency: models.MONTHLY, StartDate: "2025-01-31T09:00:00.000Z"},
+			"2025-02-28 09:00:00", nil},
+		{"start still to come", models.RecurringTransaction{Frequency: models.YEARLY, StartDate: "2025-06-01T00:00:00.000Z"},
+			"2025-06-01 00:00:00", nil},
+		{"ended", models.RecurringTransaction{Frequency: models.WEEKLY, StartDate: "2025-01-01T00:00:00.000Z",
+			EndDate: "2025-02-11T00:00:00.000Z"}, "", nil},
+		{"invalid schedule", models.RecurringTransaction{Frequency: models.WEEKLY, MonthDays: []int{1},
+			StartDate: "2025-01-01T00:00:00.000Z"}, "",
+			errors.New("monthDays need a MONTHLY, QUARTERLY or YEARLY frequency")},
+	}
+
+	for i, tc := range tests {
+		nextRun, err := calculateNextRun(&tc.rule, now)
+
+		assert.Equalf(t, tc.expectedOutput, nextRun, "TEST[%d], failed.\n%s", i, tc.description)
+		assert.Equalf(t, tc.expectedErr, err, "TEST[%d], failed.\n%s", i, tc.description)
+	}
+}
+
+func Test_ValidateException(t *testing.T) {
+	rule := &models.RecurringTransaction{Frequency: models.MONTHLY, StartDate: "2025-01-15T09:00:00.000Z",
+		NextRun: "2025-03-15T09:00:00.000Z", EndDate: "2025-12-31T00:00:00.000Z"}
+	amount := models.NewMoney(80)
+	zero := models.Money(0)
+
+	tests := []struct {
+		description string
+		exception   models.RecurringException
+		expectedErr error
+	}{
+		{"skip", models.RecurringException{Occurrence: "2025-04-15T09:00:00Z", Skip: true}, nil},
+		{"moved with another amount", models.RecurringException{Occurrence: "2025-04-15T09:00:00.000Z", Amount: &amount,
+			Date: "2025-04-20T09:00:00.000Z"}, nil},
+		{"invalid occurrence", models.RecurringException{Occurrence: "2025-04-15", Skip: true},
+			errors.New("invalid occurrence format, use an ISO 8601 timestamp")},
+		{"not scheduled", models.RecurringException{Occurrence: "2025-04-16T09:00:00.000Z", Skip: true},
+			errors.New("the recurring transaction is not scheduled at that time")},
+		{"past the end date", models.RecurringException{Occurrence: "2026-01-15T09:00:00.000Z", Skip: true},
+			errors.New("the recurring transaction is not scheduled at that time")},
+		{"already posted", models.RecurringException{Occurrence: "2025-02-15T09:00:00.000Z", Skip: true},
+			errors.New("the occurrence has already been posted")},
+		{"skip with an amount", models.RecurringException{Occurrence: "2025-04-15T09:00:00.000Z", Skip: true,
+			Amount: &amount}, errors.New("a skipped occurrence cannot have an amount or date")},
+		{"no change", models.RecurringException{Occurrence: "2025-04-15T09:00:00.000Z"},
+			errors.New("an exception must skip the occurrence or change its amount or date")},
+		{"zero amount", models.RecurringException{Occurrence: "2025-04-15T09:00:00.000Z", Amount: &zero},
+			errors.New("amount must be positive")},
+		{"moved past the next occurrence", models.RecurringException{Occurrence: "2025-04-15T09:00:00.000Z",
+			Date: "2025-05-15T09:00:00.000Z"}, errors.New("date must be between the previous and the next occurrence")},
+	}
+
+	for i, tc := range tests {
+		err := validateException(rule, &tc.exception)
+
+		assert.Equalf(t, tc.expectedErr, err, "TEST[%d], failed.\n%s", i, tc.description)
+	}
+}
+
+func Test_PendingOccurrences(t *testing.T) {
+	now := time.Date(2025, 8, 10, 12, 0, 0, 0, time.UTC)
+	bill := models.NewMoney(64)
+
+	electricity := &models.RecurringTransaction{ID: 2, Account: models.AccountDetails{ID: 1}, Amount: models.NewMoney(60),
+		Type: models.EXPENSE, Category: "Electricity", Frequency: models.MONTHLY, NextRun: "2025-06-05T00:00:00.000Z",
+		RequiresApproval: true, Exceptions: []models.RecurringException{
+			{Occurrence: "2025-07-05T00:00:00.000Z", Amount: &bill, Date: "2025-07-08T00:00:00.000Z"},
+			{Occurrence: "2025-08-05T00:00:00.000Z", Date: "2025-08-20T00:00:00.000Z"}}}
+	water := &models.RecurringTransaction{ID: 1, Account: models.AccountDetails{ID: 1}, Amount: models.NewMoney(20),
+		Type: models.EXPENSE, Category: "Water", Frequency: models.MONTHLY, NextRun: "2025-07-08T00:00:00.000Z",
+		RequiresApproval: true}
+	// Posted automatically, never pending
+	rent := &models.RecurringTransaction{ID: 3, Amount: models.NewMoney(900), Type: models.EXPENSE, Category: "Rent",
+		Frequency: models.MONTHLY, NextRun: "2025-08-01T00:00:00.000Z"}
+
+	pending, err := pendingOccurrences([]*models.RecurringTransaction{electricity, water, rent}, now)
+
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []models.RecurringOccurrence{
+		{RecurringTransactionID: 2, Date: "2025-06-05T00:00:00.000Z", Account: models.AccountDetails{ID: 1},
+			Amount: models.NewMoney(60), Type: models.EXPENSE, Category: "Electricity", Scheduled: "2025-06-05T00:00:00.000Z"},
+		{RecurringTransactionID: 1, Date: "2025-07-08T00:00:00.000Z", Account: models.AccountDetails{ID: 1},
+			Amount: models.NewMoney(20), Type: models.EXPENSE, Category: "Water", Scheduled: "2025-07-08T00:00:00.000Z"},
+		{RecurringTransactionID: 2, Date: "2025-07-08T00:00:00.000Z", Account: models.AccountDetails{ID: 1},
+			Amount: models.NewMoney(64), Type: models.EXPENSE, Category: "Electricity", Scheduled: "2025-07-05T00:00:00.000Z",
+			Overridden: true},
+		{RecurringTransactionID: 1, Date: "2025-08-08T00:00:00.000Z", Account: models.AccountDetails{ID: 1},
+			Amount: models.NewMoney(20), Type: models.EXPENSE, Category: "Water", Scheduled: "2025-08-08T00:00:00.000Z"},
+	}, pending)
+}
+
+func Test_OldestPending(t *testing.T) {
+	pending := []models.RecurringOccurrence{
+		{RecurringTransactionID: 2, Date: "2025-06-05T00:00:00.000Z", Scheduled: "2025-06-05T00:00:00.000Z"},
+		{RecurringTransactionID: 2, Date: "2025-07-08T00:00:00.000Z", Scheduled: "2025-07-05T00:00:00.000Z"},
+	}
+
+	tests := []struct {
+		description string
+		scheduled   time.Time
+		expected    models.RecurringOccurrence
+		expectedErr error
+	}{
+		{"oldest", time.Date(2025, 6, 5, 0, 0, 0, 0, time.UTC), pending[0], nil},
+		{"a later one", time.Date(2025, 7, 5, 0, 0, 0, 0, time.UTC), models.RecurringOccurrence{},
+			errors.New("settle the earlier pending occurrences of the recurring transaction first")},
+		{"not pending", time.Date(2025, 7, 8, 0, 0, 0, 0, time.UTC), models.RecurringOccurrence{},
+			errors.New("pending occurrence not found")},
+	}
+
+	for i, tc := range tests {
+		occurrence, err := oldestPending(pending, tc.scheduled)
+
+		assert.Equalf(t, tc.expected, occurrence, "TEST[%d], failed.\n%s", i, tc.description)
+		assert.Equalf(t, tc.expectedErr, err, "TEST[%d], failed.\n%s", i, tc.description)
+	}
+}
+
+func Test_PostNext(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	recurringTransactionStore := stores.NewMockRecurringTransactions(ctrl)
+	exceptionStore := stores.NewMockRecurringExceptions(ctrl)
+	holidayStore := stores.NewMockHolidays(ctrl)
+	transactionSvc := services.NewMockTransactions(ctrl)
+
+	now := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	rule := func(nextRun, endDate string) *models.RecurringTransaction {
+		return &models.RecurringTransaction{ID: 1, UserID: 1, Account: models.AccountDetails{ID: 2},
+			Amount: models.NewMoney(900), Type: models.EXPENSE, Category: "Rent", Description: "rent",
+			Frequency: models.MONTHLY, StartDate: "2025-01-01T09:00:00.000Z", NextRun: nextRun, EndDate: endDate}
+	}
+
+	rent := func(date string) *models.Transaction {
+		return &models.Transaction{UserID: 1, Account: models.AccountDetails{ID: 2}, Amount: models.NewMoney(900),
+			Type: models.EXPENSE, Category: "Rent", Description: "rent", TransactionDate: date}
+	}
+
+	tests := []struct {
+		description    string
+		expectedOutput bool
+		execMocks      func(mocks *container.Mocks)
+	}{
+		{"due occurrence is posted and the rule advanced", true, func(mocks *container.Mocks) {
+			mocks.SQL.ExpectBegin()
+			recurringTransactionStore.EXPECT().GetByIDForUpdate(gomock.Any(), 1, gomock.Any()).
+				Return(rule("2025-03-01T09:00:00.000Z", ""), nil)
+			exceptionStore.EXPECT().GetByOccurrence(gomock.Any(), 1, "2025-03-01 09:00:00", gomock.Any()).Return(nil, nil)
+			transactionSvc.EXPECT().CreateWithTx(gomock.Any(), rent("2025-03-01 09:00:00"), gomock.Any()).Return(nil)
+			recurringTransactionStore.EXPECT().UpdateRun(gomock.Any(), 1, "2025-03-01 09:00:00", "2025-04-01 09:00:00",
+				gomock.Any()).Return(nil)
+			mocks.SQL.ExpectCommit()
+		}},
+		{"rule already advanced by another run is not posted", false, func(mocks *container.Mocks) {
+			mocks.SQL.ExpectBegin()
+			recurringTransactionStore.EXPECT().GetByIDForUpdate(gomock.Any(), 1, gomock.Any()).
+				Return(rule("2025-04-01T09:00:00.000Z", ""), nil)
+			exceptionStore.EXPECT().GetByOccurrence(gomock.Any(), 1, "2025-04-01 09:00:00", gomock.Any()).Return(nil, nil)
+			mocks.SQL.ExpectRollback()
+		}},
+		{"last occurrence before the end date finishes the rule", true, func(mocks *container.Mocks) {
+			mocks.SQL.ExpectBegin()
+			recurringTransactionStore.EXPECT().GetByIDForUpdate(gomock.Any(), 1, gomock.Any()).
+				Return(rule("2025-03-01T09:00:00.000Z", "2025-03-31T00:00:00.000Z"), nil)
+			exceptionStore.EXPECT().GetByOccurrence(gomock.Any(), 1, "2025-03-01 09:00:00", gomock.Any()).Return(nil, nil)
+			transactionSvc.EXPECT().CreateWithTx(gomock.Any(), rent("2025-03-01 09:00:00"), gomock.Any()).Return(nil)
+			recurringTransactionStore.EXPECT().UpdateRun(gomock.Any(), 1, "2025-03-01 09:00:00", nil, gomock.Any()).
+				Return(nil)
+			mocks.SQL.ExpectCommit()
+		}},
+		{"occurrence past the end date is not posted", false, func(mocks *container.Mocks) {
+			mocks.SQL.ExpectBegin()
+			recurringTransactionStore.EXPECT().GetByIDForUpdate(gomock.Any(), 1, gomock.Any()).
+				Return(rule("2025-03-01T09:00:00.000Z", "2025-02-15T00:00:00.000Z"), nil)
+			mocks.SQL.ExpectRollback()
+		}},
+		{"skipped occurrence only advances the rule", true, func(mocks *container.Mocks) {
+			mocks.SQL.ExpectBegin()
+			recurringTransactionStore.EXPECT().GetByIDForUpdate(gomock.Any(), 1, gomock.Any()).
+				Return(rule("2025-03-01T09:00:00.000Z", ""), nil)
+			exceptionStore.EXPECT().GetByOccurrence(gomock.Any(), 1, "2025-03-01 09:00:00", gomock.Any()).
+				Return(&models.RecurringException{Occurrence: "2025-03-01T09:00:00.000Z", Skip: true}, nil)
+			recurringTransactionStore.EXPECT().UpdateRun(gomock.Any(), 1, nil, "2025-04-01 09:00:00", gomock.Any()).
+				Return(nil)
+			mocks.SQL.ExpectCommit()
+		}},
+		{"occurrence moved earlier is posted at its own date before it is scheduled", true, func(mocks *container.Mocks) {
+			mocks.SQL.ExpectBegin()
+			recurringTransactionStore.EXPECT().GetByIDForUpdate(gomock.Any(), 1, gomock.Any()).
+				Return(rule("2025-04-01T09:00:00.000Z", ""), nil)
+			exceptionStore.EXPECT().GetByOccurrence(gomock.Any(), 1, "2025-04-01 09:00:00", gomock.Any()).
+				Return(&models.RecurringException{Occurrence: "2025-04-01T09:00:00.000Z", Date: "2025-03-07T09:00:00.000Z"}, nil)
+			transactionSvc.EXPECT().CreateWithTx(gomock.Any(), rent("2025-03-07 09:00:00"), gomock.Any()).Return(nil)
+			recurringTransactionStore.EXPECT().UpdateRun(gomock.Any(), 1, "2025-03-07 09:00:00", "2025-05-01 09:00:00",
+				gomock.Any()).Return(nil)
+			mocks.SQL.ExpectCommit()
+		}},
+		{"occurrence moved later waits for its own date", false, func(mocks *container.Mocks) {
+			mocks.SQL.ExpectBegin()
+			recurringTransactionStore.EXPECT().GetByIDForUpdate(gomock.Any(), 1, gomock.Any()).
+				Return(rule("2025-03-01T09:00:00.000Z", ""), nil)
+			exceptionStore.EXPECT().GetByOccurrence(gomock.Any(), 1, "2025-03-01 09:00:00", gomock.Any()).
+				Return(&models.RecurringException{Occurrence: "2025-03-01T09:00:00.000Z", Date: "2025-03-20T09:00:00.000Z"}, nil)
+			mocks.SQL.ExpectRollback()
+		}},
+		{"occurrence on a holiday added since it was scheduled is posted the next working day", true,
+			func(mocks *container.Mocks) {
+				shifting := rule("2025-03-03T09:00:00.000Z", "")
+				shifting.StartDate, shifting.Shift = "2025-01-03T09:00:00.000Z", models.ShiftAfter
+
+				mocks.SQL.ExpectBegin()
+				recurringTransactionStore.EXPECT().GetByIDForUpdate(gomock.Any(), 1, gomock.Any()).Return(shifting, nil)
+				holidayStore.EXPECT().GetAll(gomock.Any(), 1).Return([]*models.Holiday{{Date: "2025-03-03"}}, nil)
+				exceptionStore.EXPECT().GetByOccurrence(gomock.Any(), 1, "2025-03-03 09:00:00", gomock.Any()).Return(nil, nil)
+				transactionSvc.EXPECT().CreateWithTx(gomock.Any(), rent("2025-03-04 09:00:00"), gomock.Any()).Return(nil)
+				recurringTransactionStore.EXPECT().UpdateRun(gomock.Any(), 1, "2025-03-04 09:00:00", "2025-04-03 09:00:00",
+					gomock.Any()).Return(nil)
+				mocks.SQL.ExpectCommit()
+			}},
+		{"Failure Case: error from store layer", false, func(mocks *container.Mocks) {
+			mocks.SQL.ExpectBegin()
+			recurringTransactionStore.EXPECT().GetByIDForUpdate(gomock.Any(), 1, gomock.Any()).
+				Return(nil, errors.New("DB error"))
+			mocks.SQL.ExpectRollback()
+		}},
+	}
+
+	for i, tc := range tests {
+		mockContainer, mocks := container.NewMockContainer(t)
+		ctx := &gofr.Context{Context: context.WithValue(context.Background(), "userID", 1), Container: mockContainer}
+
+		tc.execMocks(mocks)
+
+		s := New(recurringTransactionStore, exceptionStore, holidayStore, transactionSvc, nil).(*recurringTransactionSvc)
+
+		posted, _ := s.postNext(ctx, 1, now)
+
+		assert.Equalf(t, tc.expectedOutput, posted, "TEST[%d], failed.\n%s", i, tc.description)
+	}
+}
+
+func Test_Update(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	recurringTransactionStore := stores.NewMockRecurringTransactions(ctrl)
+	exceptionStore := stores.NewMockRecurringExceptions(ctrl)
+
+	stored := func() *models.RecurringTransaction {
+		return &models.RecurringTransaction{ID: 1, UserID: 1, Account: models.AccountDetails{ID: 2},
+			Amount: models.NewMoney(900), Type: models.EXPENSE, Category: "Rent", Frequency: models.MONTHLY,
+			StartDate: "2025-01-01T09:00:00.000Z", LastRun: "2025-03-01T09:00:00.000Z", NextRun: "2025-04-01T09:00:00.000Z"}
+	}
+
+	update := func(frequency models.Frequency, startDate, lastRun, nextRun string) *models.RecurringTransaction {
+		return &models.RecurringTransaction{ID: 1, Account: models.AccountDetails{ID: 2}, Amount: models.NewMoney(950),
+			Type: models.EXPENSE, Category: "Rent", Frequency: frequency, StartDate: startDate, LastRun: lastRun,
+			NextRun: nextRun}
+	}
+
+	written := func(frequency models.Frequency, startDate, nextRun string) *models.RecurringTransaction {
+		return &models.RecurringTransaction{ID: 1, UserID: 1, Account: models.AccountDetails{ID: 2},
+			Amount: models.NewMoney(950), Type: models.EXPENSE, Category: "Rent", Frequency: frequency,
+			StartDate: startDate, LastRun: "2025-03-01 09:00:00", NextRun: nextRun}
+	}
+
+	tests := []struct {
+		description string
+		input       *models.RecurringTransaction
+		expectedErr error
+		execMocks   func(mocks *container.Mocks)
+	}{
+		{"stale run markers from the client are ignored",
+			update(models.MONTHLY, "2025-01-01T09:00:00.000Z", "2025-01-01T09:00:00.000Z", "2025-02-01T09:00:00.000Z"),
+			nil, func(mocks *container.Mocks) {
+				mocks.SQL.ExpectBegin()
+				recurringTransactionStore.EXPECT().GetByIDForUpdate(gomock.Any(), 1, gomock.Any()).Return(stored(), nil)
+				recurringTransactionStore.EXPECT().Update(gomock.Any(),
+					written(models.MONTHLY, "2025-01-01 09:00:00", "2025-04-01 09:00:00"), gomock.Any()).Return(nil)
+				mocks.SQL.ExpectCommit()
+				recurringTransactionStore.EXPECT().GetByID(gomock.Any(), 1, 1).Return(stored(), nil)
+				exceptionStore.EXPECT().GetAll(gomock.Any(), 1).Return(nil, nil)
+			}},
+		{"omitted run markers keep the stored ones", update(models.MONTHLY, "2025-01-01T09:00:00.000Z", "", ""),
+			nil, func(mocks *container.Mocks) {
+				mocks.SQL.ExpectBegin()
+				recurringTransactionStore.EXPECT().GetByIDForUpdate(gomock.Any(), 1, gomock.Any()).Return(stored(), nil)
+				recurringTransactionStore.EXPECT().Update(gomock.Any(),
+					written(models.MONTHLY, "2025-01-01 09:00:00", "2025-04-01 09:00:00"), gomock.Any()).Return(nil)
+				mocks.SQL.ExpectCommit()
+				recurringTransactionStore.EXPECT().GetByID(gomock.Any(), 1, 1).Return(stored(), nil)
+				exceptionStore.EXPECT().GetAll(gomock.Any(), 1).Return(nil, nil)
+			}},
+		{"new schedule moves the next run", update(models.YEARLY, "2099-06-01T09:00:00.000Z", "", "2025-02-01T09:00:00.000Z"),
+			nil, func(mocks *container.Mocks) {
+				mocks.SQL.ExpectBegin()
+				recurringTransactionStore.EXPECT().GetByIDForUpdate(gomock.Any(), 1, gomock.Any()).Return(stored(), nil)
+				recurringTransactionStore.EXPECT().Update(gomock.Any(),
+					written(models.YEARLY, "2099-06-01 09:00:00", "2099-06-01 09:00:00"), gomock.Any()).Return(nil)
+				mocks.SQL.ExpectCommit()
+				recurringTransactionStore.EXPECT().GetByID(gomock.Any(), 1, 1).Return(stored(), nil)
+				exceptionStore.EXPECT().GetAll(gomock.Any(), 1).Return(nil, nil)
+			}},
+		{"Failure Case: rule of another user", update(models.MONTHLY, "2025-01-01T09:00:00.000Z", "", ""),
+			errors.New("recurring transaction not found"), func(mocks *container.Mocks) {
+				other := stored()
+				other.UserID = 2
+
+				mocks.SQL.ExpectBegin()
+				recurringTransactionStore.EXPECT().GetByIDForUpdate(gomock.Any(), 1, gomock.Any()).Return(other, nil)
+				mocks.SQL.ExpectRollback()
+			}},
+		{"Failure Case: error from store layer", update(models.MONTHLY, "2025-01-01T09:00:00.000Z", "", ""),
+			errors.New("DB error"), func(mocks *container.Mocks) {
+				mocks.SQL.ExpectBegin()
+				recurringTransactionStore.EXPECT().GetByIDForUpdate(gomock.Any(), 1, gomock.Any()).Return(stored(), nil)
+				recurringTransactionStore.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(errors.New("DB error"))
+				mocks.SQL.ExpectRollback()
+			}},
+	}
+
+	for i, tc := range tests {
+		mockContainer, mocks := container.NewMockContainer(t)
+		ctx := &gofr.Context{Context: context.WithValue(context.Background(), "userID", 1), Container: mockContainer}
+
+		tc.execMocks(mocks)
+
+		s := New(recurringTransactionStore, exceptionStore, nil, nil, nil)
+
+		_, err := s.Update(ctx, tc.input)
+
+		assert.Equalf(t, tc.expectedErr, err, "TEST[%d], failed.\n%s", i, tc.description)
+	}
+}
+
+func Test_PostDue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	recurringTransactionStore := stores.NewMockRecurringTransactions(ctrl)
+	exceptionStore := stores.NewMockRecurringExceptions(ctrl)
+	transactionSvc := services.NewMockTransactions(ctrl)
+
+	mockContainer, mocks := container.NewMockContainer(t)
+	ctx := &gofr.Context{Context: context.Background(), Container: mockContainer}
+
+	// A monthly rule the job last ran for in January catches up on every missed month up to its end date
+	rule := &models.RecurringTransaction{ID: 1, UserID: 1, Account: models.AccountDetails{ID: 2},
+		Amount: models.NewMoney(900), Type: models.EXPENSE, Category: "Rent", Frequency: models.MONTHLY,
+		StartDate: "2025-01-01T09:00:00.000Z", NextRun: "2025-01-01T09:00:00.000Z", EndDate: "2025-03-31T00:00:00.000Z"}
+
+	var posted []string
+
+	recurringTransactionStore.EXPECT().GetDue(ctx, gomock.Any()).Return([]*models.RecurringTransaction{rule}, nil)
+
+	// Each occurrence is posted in its own SQL transaction, the last run finds the rule finished
+	for range 3 {
+		mocks.SQL.ExpectBegin()
+		mocks.SQL.ExpectCommit()
+	}
+
+	mocks.SQL.ExpectBegin()
+	mocks.SQL.ExpectRollback()
+
+	recurringTransactionStore.EXPECT().GetByIDForUpdate(gomock.Any(), 1, gomock.Any()).DoAndReturn(
+		func(_ *gofr.Context, _ int, _ *gofrSQL.Tx) (*models.RecurringTransaction, error) {
+			locked := *rule
+			return &locked, nil
+		}).Times(4)
+	exceptionStore.EXPECT().GetByOccurrence(gomock.Any(), 1, gomock.Any(), gomock.Any()).Return(nil, nil).Times(3)
+	transactionSvc.EXPECT().CreateWithTx(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx *gofr.Context, transaction *models.Transaction, _ *gofrSQL.Tx) error {
+			assert.Equal(t, 1, ctx.Value("userID"), "occurrences are posted for the owner of the rule")
+
+			posted = append(posted, transaction.TransactionDate)
+
+			return nil
+		}).Times(3)
+	recurringTransactionStore.EXPECT().UpdateRun(gomock.Any(), 1, gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ *gofr.Context, _ int, _, nextRun interface{}, _ *gofrSQL.Tx) error {
+			rule.NextRun = ""
+
+			if nextRun != nil {
+				next, _ := time.Parse("2006-01-02 15:04:05", nextRun.(string))
+				rule.NextRun = next.Format("2006-01-02T15:04:05.000Z")
+			}
+
+			return nil
+		}).Times(3)
+
+	err := New(recurringTransactionStore, exceptionStore, nil, transactionSvc, nil).PostDue(ctx)
+
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []string{"2025-01-01 09:00:00", "2025-02-01 09:00:00", "2025-03-01 09:00:00"}, posted)
+	assert.Equal(t, "", rule.NextRun, "the rule is finished once its end date is passed")
+}
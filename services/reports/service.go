@@ -0,0 +1,270 @@
+package reports
+
+import (
+	"errors"
+	"gofr.dev/pkg/gofr"
+	"math"
+	"moneyManagement/filters"
+	"moneyManagement/models"
+	"moneyManagement/services"
+	"sort"
+	"time"
+)
+
+const (
+	defaultInterval = "month"
+	defaultWindow   = 3
+	maxWindow       = 52
+	maxBuckets      = 1000
+)
+
+type reportSvc struct {
+	transactionSvc  services.Transactions
+	exchangeRateSvc services.ExchangeRates
+}
+
+func New(transactionSvc services.Transactions, exchangeRateSvc services.ExchangeRates) services.Reports {
+	return &reportSvc{
+		transactionSvc:  transactionSvc,
+		exchangeRateSvc: exchangeRateSvc,
+	}
+}
+
+type seriesKey struct {
+	txnType  models.Type
+	category string
+}
+
+// Trends returns the income, expense and savings of the transactions matching f for every day, week, month or year
+// between its start and end date, with the moving averages over window buckets, 3 by default. Without dates it covers
+// the last 30 days, 12 weeks, 12 months or 5 years. The amounts of a bucket are converted to the home currency at the
+// rate in effect on its last day.
+func (s *reportSvc) Trends(ctx *gofr.Context, f *filters.Transactions, interval string, window int) (*models.TrendReport, error) {
+	if interval == "" {
+		interval = defaultInterval
+	}
+
+	if window == 0 {
+		window = defaultWindow
+	}
+
+	if window < 0 || window > maxWindow {
+		return nil, errors.New("window must be between 1 and 52")
+	}
+
+	start, end, err := trendRange(f.StartDate, f.EndDate, interval, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	buckets, err := trendBuckets(start, end, interval)
+	if err != nil {
+		return nil, err
+	}
+
+	f.StartDate, f.EndDate = start.Format("2006-01-02")+" 00:00:00", end.Format("2006-01-02")+" 23:59:59"
+
+	totals, err := s.transactionSvc.BucketTotals(ctx, f, interval)
+	if err != nil {
+		return nil, err
+	}
+
+	converter, err := s.exchangeRateSvc.Converter(ctx, f.StartDate, f.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	bucketIndex := make(map[string]int, len(buckets))
+	for i, bucket := range buckets {
+		bucketIndex[bucket.Start] = i
+	}
+
+	series := make(map[seriesKey]*models.TrendSeries)
+
+	for _, total := range totals {
+		i, ok := bucketIndex[total.Bucket]
+		if !ok {
+			continue
+		}
+
+		amount, err := converter.ToHome(total.Amount, total.Currency, buckets[i].End)
+		if err != nil {
+			return nil, err
+		}
+
+		switch total.Type {
+		case models.INCOME:
+			buckets[i].Income += amount
+		case models.EXPENSE:
+			buckets[i].Expense += amount
+		case models.SAVINGS:
+			buckets[i].Savings += amount
+		}
+
+		key := seriesKey{txnType: total.Type, category: total.Category}
+		if series[key] == nil {
+			series[key] = &models.TrendSeries{Type: total.Type, Category: total.Category, Values: make([]models.Money, len(buckets))}
+		}
+
+		series[key].Values[i] += amount
+		series[key].Total += amount
+	}
+
+	addRunningFigures(buckets, window)
+
+	return &models.TrendReport{
+		Currency: converter.HomeCurrency,
+		Interval: interval,
+		From:     start.Format("2006-01-02"),
+		To:       end.Format("2006-01-02"),
+		Window:   window,
+		Buckets:  buckets,
+		Series:   sortedSeries(series),
+	}, nil
+}
+
+// trendRange returns the first and last day of a report, from the "YYYY-MM-DD hh:mm:ss" dates of a filter. The start
+// is moved back to the first day of its bucket so that the first bucket is complete.
+func trendRange(startDate, endDate, interval string, now time.Time) (start, end time.Time, err error) {
+	const layout = "2006-01-02 15:04:05"
+
+	end = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	if endDate != "" {
+		end, err = time.Parse(layout, endDate)
+		if err != nil {
+			return start, end, errors.New("invalid endDate, use YYYY-MM-DD")
+		}
+
+		end = time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, time.UTC)
+	}
+
+	switch {
+	case startDate != "":
+		start, err = time.Parse(layout, startDate)
+		if err != nil {
+			return start, end, errors.New("invalid startDate, use YYYY-MM-DD")
+		}
+	case interval == "day":
+		start = end.AddDate(0, 0, -29)
+	case interval == "week":
+		start = end.AddDate(0, 0, -7*11)
+	case interval == "month":
+		start = end.AddDate(0, -11, 1-end.Day())
+	case interval == "year":
+		start = end.AddDate(-4, 0, 0)
+	}
+
+	start, err = bucketStart(start, interval)
+	if err != nil {
+		return start, end, err
+	}
+
+	if start.After(end) {
+		return start, end, errors.New("startDate must not be after endDate")
+	}
+
+	return start, end, nil
+}
+
+// bucketStart returns the first day of the day, week, month or year t falls in. Weeks start on Monday.
+func bucketStart(t time.Time, interval string) (time.Time, error) {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+
+	switch interval {
+	case "day":
+		return day, nil
+	case "week":
+		return day.AddDate(0, 0, -(int(day.Weekday())+6)%7), nil
+	case "month":
+		return day.AddDate(0, 0, 1-day.Day()), nil
+	case "year":
+		return time.Date(day.Year(), time.January, 1, 0, 0, 0, 0, time.UTC), nil
+	default:
+		return day, errors.New("invalid interval, use day, week, month or year")
+	}
+}
+
+// trendBuckets returns an empty bucket for every interval from start, the first day of one, to end. The last bucket
+// ends on end.
+func trendBuckets(start, end time.Time, interval string) ([]models.TrendBucket, error) {
+	next := map[string]func(t time.Time) time.Time{
+		"day":   func(t time.Time) time.Time { return t.AddDate(0, 0, 1) },
+		"week":  func(t time.Time) time.Time { return t.AddDate(0, 0, 7) },
+		"month": func(t time.Time) time.Time { return t.AddDate(0, 1, 0) },
+		"year":  func(t time.Time) time.Time { return t.AddDate(1, 0, 0) },
+	}[interval]
+
+	if next == nil {
+		return nil, errors.New("invalid interval, use day, week, month or year")
+	}
+
+	var buckets []models.TrendBucket
+
+	for t := start; !t.After(end); t = next(t) {
+		if len(buckets) == maxBuckets {
+			return nil, errors.New("too many buckets, use a shorter range or a longer interval")
+		}
+
+		bucketEnd := next(t).AddDate(0, 0, -1)
+		if bucketEnd.After(end) {
+			bucketEnd = end
+		}
+
+		buckets = append(buckets, models.TrendBucket{Start: t.Format("2006-01-02"), End: bucketEnd.Format("2006-01-02")})
+	}
+
+	return buckets, nil
+}
+
+// addRunningFigures sets the savings rate of every bucket, over all the income and expense up to its end, and the
+// averages of the last window buckets up to it. The first buckets average over as many buckets as there are.
+func addRunningFigures(buckets []models.TrendBucket, window int) {
+	var income, expense models.Money
+
+	for i := range buckets {
+		income += buckets[i].Income
+		expense += buckets[i].Expense
+
+		if income > 0 {
+			buckets[i].SavingsRate = math.Round((income-expense).Ratio(income)*10000) / 100
+		}
+
+		first := max(0, i-window+1)
+		count := int64(i - first + 1)
+
+		var incomeSum, expenseSum, savingsSum models.Money
+
+		for _, bucket := range buckets[first : i+1] {
+			incomeSum += bucket.Income
+			expenseSum += bucket.Expense
+			savingsSum += bucket.Savings
+		}
+
+		buckets[i].IncomeAverage = incomeSum.MulDiv(1, count)
+		buckets[i].ExpenseAverage = expenseSum.MulDiv(1, count)
+		buckets[i].SavingsAverage = savingsSum.MulDiv(1, count)
+	}
+}
+
+// sortedSeries orders the category series by type, then by their total, largest first.
+func sortedSeries(series map[seriesKey]*models.TrendSeries) []models.TrendSeries {
+	sorted := make([]models.TrendSeries, 0, len(series))
+	for _, s := range series {
+		sorted = append(sorted, *s)
+	}
+
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Type != sorted[j].Type {
+			return sorted[i].Type < sorted[j].Type
+		}
+
+		if sorted[i].Total != sorted[j].Total {
+			return sorted[i].Total > sorted[j].Total
+		}
+
+		return sorted[i].Category < sorted[j].Category
+	})
+
+	return sorted
+}
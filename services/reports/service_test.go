@@ -0,0 +1,84 @@
+package reports
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"moneyManagement/models"
+)
+
+func Test_TrendRange(t *testing.T) {
+	now := time.Date(2025, 3, 19, 10, 0, 0, 0, time.UTC)
+
+	date := func(value string) time.Time {
+		d, _ := time.Parse("2006-01-02", value)
+		return d
+	}
+
+	tests := []struct {
+		description   string
+		startDate     string
+		endDate       string
+		interval      string
+		expectedStart time.Time
+		expectedEnd   time.Time
+		expectedErr   error
+	}{
+		{"last 12 months by default", "", "", "month", date("2024-04-01"), date("2025-03-19"), nil},
+		{"last 30 days by default", "", "", "day", date("2025-02-18"), date("2025-03-19"), nil},
+		{"weeks start on Monday", "2025-03-05 00:00:00", "2025-03-16 23:59:59", "week", date("2025-03-03"),
+			date("2025-03-16"), nil},
+		{"years start in January", "2023-06-15 00:00:00", "", "year", date("2023-01-01"), date("2025-03-19"), nil},
+		{"unknown interval", "", "", "hour", time.Time{}, date("2025-03-19"),
+			errors.New("invalid interval, use day, week, month or year")},
+	}
+
+	for i, tc := range tests {
+		start, end, err := trendRange(tc.startDate, tc.endDate, tc.interval, now)
+
+		assert.Equalf(t, tc.expectedErr, err, "TEST[%d], failed.\n%s", i, tc.description)
+
+		if tc.expectedErr == nil {
+			assert.Equalf(t, tc.expectedStart, start, "TEST[%d], failed.\n%s", i, tc.description)
+			assert.Equalf(t, tc.expectedEnd, end, "TEST[%d], failed.\n%s", i, tc.description)
+		}
+	}
+}
+
+func Test_TrendBuckets(t *testing.T) {
+	start, _ := time.Parse("2006-01-02", "2025-01-01")
+	end, _ := time.Parse("2006-01-02", "2025-03-19")
+
+	buckets, err := trendBuckets(start, end, "month")
+
+	assert.Nil(t, err)
+	assert.Equal(t, []models.TrendBucket{
+		{Start: "2025-01-01", End: "2025-01-31"},
+		{Start: "2025-02-01", End: "2025-02-28"},
+		{Start: "2025-03-01", End: "2025-03-19"},
+	}, buckets)
+}
+
+func Test_AddRunningFigures(t *testing.T) {
+	buckets := []models.TrendBucket{
+		{Income: models.NewMoney(1000), Expense: models.NewMoney(600), Savings: models.NewMoney(300)},
+		{Income: models.NewMoney(1000), Expense: models.NewMoney(800)},
+		{Expense: models.NewMoney(400), Savings: models.NewMoney(100)},
+	}
+
+	addRunningFigures(buckets, 2)
+
+	expected := []models.TrendBucket{
+		{Income: models.NewMoney(1000), Expense: models.NewMoney(600), Savings: models.NewMoney(300), SavingsRate: 40,
+			IncomeAverage: models.NewMoney(1000), ExpenseAverage: models.NewMoney(600), SavingsAverage: models.NewMoney(300)},
+		{Income: models.NewMoney(1000), Expense: models.NewMoney(800), SavingsRate: 30,
+			IncomeAverage: models.NewMoney(1000), ExpenseAverage: models.NewMoney(700), SavingsAverage: models.NewMoney(150)},
+		{Expense: models.NewMoney(400), Savings: models.NewMoney(100), SavingsRate: 10,
+			IncomeAverage: models.NewMoney(500), ExpenseAverage: models.NewMoney(600), SavingsAverage: models.NewMoney(50)},
+	}
+
+	assert.Equal(t, expected, buckets)
+}
@@ -6,15 +6,18 @@ import (
 	"moneyManagement/models"
 	"moneyManagement/services"
 	"moneyManagement/stores"
+	"time"
 )
 
 type savingsSvc struct {
-	savingsStore stores.Savings
+	savingsStore   stores.Savings
+	valuationStore stores.SavingsValuations
 }
 
-func New(savingsStore stores.Savings) services.Savings {
+func New(savingsStore stores.Savings, valuationStore stores.SavingsValuations) services.Savings {
 	return &savingsSvc{
-		savingsStore: savingsStore,
+		savingsStore:   savingsStore,
+		valuationStore: valuationStore,
 	}
 }
 
@@ -82,7 +85,34 @@ func (s *savingsSvc) GetAll(ctx *gofr.Context) ([]*models.Savings, error) {
 	return allSavings, nil
 }
 
+// GetOpen returns the savings of the user that are not deleted, in the currency of the account they were paid from.
+func (s *savingsSvc) GetOpen(ctx *gofr.Context) ([]*models.Savings, error) {
+	userID, _ := ctx.Value("userID").(int)
+
+	return s.savingsStore.GetOpen(ctx, userID)
+}
+
+// GetHistory returns every savings of the user, the closed ones with the date they were closed on as DeletedAt, in
+// the currency of the account they were paid from.
+func (s *savingsSvc) GetHistory(ctx *gofr.Context) ([]*models.Savings, error) {
+	userID, _ := ctx.Value("userID").(int)
+
+	return s.savingsStore.GetHistory(ctx, userID)
+}
+
+// GetValuations returns every valuation of the savings of the user, ordered by savings and then by date.
+func (s *savingsSvc) GetValuations(ctx *gofr.Context) ([]*models.SavingsValuation, error) {
+	userID, _ := ctx.Value("userID").(int)
+
+	return s.valuationStore.GetAll(ctx, userID)
+}
+
 func (s *savingsSvc) Update(ctx *gofr.Context, savings *models.Savings) (*models.Savings, error) {
+	previous, err := s.GetByID(ctx, savings.ID)
+	if err != nil {
+		return nil, err
+	}
+
 	tx, err := ctx.SQL.Begin()
 	if err != nil {
 		return nil, err
@@ -97,6 +127,11 @@ func (s *savingsSvc) Update(ctx *gofr.Context, savings *models.Savings) (*models
 		return nil, err
 	}
 
+	err = s.recordValuation(ctx, previous, savings, tx)
+	if err != nil {
+		return nil, err
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return nil, err
@@ -117,7 +152,17 @@ func (s *savingsSvc) UpdateWithTx(ctx *gofr.Context, savings *models.Savings, Is
 			return err
 		}
 	} else {
-		err := s.savingsStore.Update(ctx, savings, tx)
+		previous, err := s.GetByID(ctx, savings.ID)
+		if err != nil {
+			return err
+		}
+
+		err = s.savingsStore.Update(ctx, savings, tx)
+		if err != nil {
+			return err
+		}
+
+		err = s.recordValuation(ctx, previous, savings, tx)
 		if err != nil {
 			return err
 		}
@@ -134,3 +179,14 @@ func (s *savingsSvc) Delete(ctx *gofr.Context, id int) error {
 
 	return nil
 }
+
+// recordValuation keeps the new value of savings from today when their current value changed, so the net worth of
+// earlier months is still counted at the value they had then.
+func (s *savingsSvc) recordValuation(ctx *gofr.Context, previous, savings *models.Savings, tx *sql.Tx) error {
+	if savings.CurrentValue == previous.CurrentValue {
+		return nil
+	}
+
+	return s.valuationStore.Create(ctx, &models.SavingsValuation{SavingsID: previous.ID, UserID: previous.UserID,
+		Value: savings.Value(), ValuedOn: time.Now().UTC().Format("2006-01-02")}, tx)
+}
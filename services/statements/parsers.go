@@ -0,0 +1,132 @@
+package statements
+
+import (
+	"moneyManagement/models"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Parser turns the text lines of one statement table layout into draft transactions.
+type Parser interface {
+	// Name identifies the layout, it can be requested explicitly when uploading a statement.
+	Name() string
+	// Match returns how many lines look like rows of this layout, it is used to pick a parser.
+	Match(lines []string) int
+	Parse(lines []string) []*models.Transaction
+}
+
+// DefaultParsers returns the built-in statement layouts.
+func DefaultParsers() []Parser {
+	return []Parser{&debitCreditParser{}, &creditCardParser{}}
+}
+
+// debitCreditParser reads bank statements where every row ends in an amount marked Dr or Cr followed by the
+// running balance, e.g. "05/03/2025 UPI-SWIGGY 450.00 Dr 12,050.00 Cr".
+type debitCreditParser struct{}
+
+var debitCreditRow = regexp.MustCompile(`^(\d{2}[/-]\d{2}[/-]\d{4})\s+(.+?)\s+([\d,]+\.\d{2})\s*(?i:(dr|cr))(?:\s+[\d,]+\.\d{2}(?:\s*(?i:dr|cr))?)?$`)
+
+func (p *debitCreditParser) Name() string {
+	return "debit-credit"
+}
+
+func (p *debitCreditParser) Match(lines []string) int {
+	return countMatches(debitCreditRow, lines)
+}
+
+func (p *debitCreditParser) Parse(lines []string) []*models.Transaction {
+	var transactions []*models.Transaction
+
+	for _, line := range lines {
+		match := debitCreditRow.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+
+		date, err := time.Parse("02/01/2006", strings.ReplaceAll(match[1], "-", "/"))
+		if err != nil {
+			continue
+		}
+
+		transactionType := models.EXPENSE
+		if strings.EqualFold(match[4], "cr") {
+			transactionType = models.INCOME
+		}
+
+		transaction, ok := draft(date, match[2], match[3], transactionType)
+		if ok {
+			transactions = append(transactions, transaction)
+		}
+	}
+
+	return transactions
+}
+
+// creditCardParser reads card statements where purchases are plain amounts and payments or refunds carry a
+// trailing CR, e.g. "07 Mar 2025 AMAZON PAY 1,299.00" and "15 Mar 2025 PAYMENT RECEIVED 5,000.00 CR".
+type creditCardParser struct{}
+
+var creditCardRow = regexp.MustCompile(`^(\d{1,2} [A-Za-z]{3} \d{4})\s+(.+?)\s+([\d,]+\.\d{2})(\s+(?i:cr))?$`)
+
+func (p *creditCardParser) Name() string {
+	return "credit-card"
+}
+
+func (p *creditCardParser) Match(lines []string) int {
+	return countMatches(creditCardRow, lines)
+}
+
+func (p *creditCardParser) Parse(lines []string) []*models.Transaction {
+	var transactions []*models.Transaction
+
+	for _, line := range lines {
+		match := creditCardRow.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+
+		date, err := time.Parse("2 Jan 2006", match[1])
+		if err != nil {
+			continue
+		}
+
+		transactionType := models.EXPENSE
+		if match[4] != "" {
+			transactionType = models.INCOME
+		}
+
+		transaction, ok := draft(date, match[2], match[3], transactionType)
+		if ok {
+			transactions = append(transactions, transaction)
+		}
+	}
+
+	return transactions
+}
+
+func countMatches(row *regexp.Regexp, lines []string) int {
+	count := 0
+
+	for _, line := range lines {
+		if row.MatchString(line) {
+			count++
+		}
+	}
+
+	return count
+}
+
+func draft(date time.Time, description, amount string, transactionType models.Type) (*models.Transaction, bool) {
+	value, err := models.ParseMoney(strings.ReplaceAll(amount, ",", ""))
+	if err != nil || value == 0 {
+		return nil, false
+	}
+
+	return &models.Transaction{
+		Amount:          value,
+		Type:            transactionType,
+		Description:     strings.TrimSpace(description),
+		TransactionDate: date.Format("2006-01-02T15:04:05.000Z"),
+	}, true
+}
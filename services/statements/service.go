@@ -0,0 +1,165 @@
+package statements
+
+import (
+	"errors"
+	"github.com/unidoc/unipdf/v3/extractor"
+	"github.com/unidoc/unipdf/v3/model"
+	"gofr.dev/pkg/gofr"
+	"io"
+	"moneyManagement/models"
+	"moneyManagement/services"
+	"strings"
+	"time"
+)
+
+type statementSvc struct {
+	transactionSvc services.Transactions
+	parsers        []Parser
+}
+
+// New returns the statement service. When no parsers are given the built-in layouts are used.
+func New(transactionSvc services.Transactions, parsers ...Parser) services.Statements {
+	if len(parsers) == 0 {
+		parsers = DefaultParsers()
+	}
+
+	return &statementSvc{
+		transactionSvc: transactionSvc,
+		parsers:        parsers,
+	}
+}
+
+// Parse extracts the text of a PDF statement and returns the rows found as draft transactions. The layout is
+// detected from the content unless one is requested by name. Nothing is written to the database.
+func (s *statementSvc) Parse(ctx *gofr.Context, file io.ReadSeeker, layout string, accountID int) (*models.StatementDraft, error) {
+	lines, err := extractLines(file)
+	if err != nil {
+		return nil, err
+	}
+
+	parser, err := s.parser(lines, layout)
+	if err != nil {
+		return nil, err
+	}
+
+	transactions := parser.Parse(lines)
+	for _, transaction := range transactions {
+		transaction.Account.ID = accountID
+	}
+
+	return &models.StatementDraft{Layout: parser.Name(), Transactions: transactions}, nil
+}
+
+// Confirm creates the confirmed draft transactions in the chosen account inside a single SQL transaction.
+func (s *statementSvc) Confirm(ctx *gofr.Context, confirmation *models.StatementConfirmation) ([]*models.Transaction, error) {
+	if len(confirmation.Transactions) == 0 {
+		return nil, errors.New("no transactions to confirm")
+	}
+
+	tx, err := ctx.SQL.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	userID, _ := ctx.Value("userID").(int)
+
+	for _, transaction := range confirmation.Transactions {
+		date, err := time.Parse(time.RFC3339, transaction.TransactionDate)
+		if err != nil {
+			return nil, errors.New("invalid transaction date format")
+		}
+
+		transaction.UserID = userID
+		transaction.Account.ID = confirmation.AccountID
+		transaction.TransactionDate = date.Format("2006-01-02 15:04:05")
+
+		err = s.transactionSvc.CreateWithTx(ctx, transaction, tx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return confirmation.Transactions, nil
+}
+
+func (s *statementSvc) parser(lines []string, layout string) (Parser, error) {
+	var (
+		best      Parser
+		bestCount int
+	)
+
+	for _, parser := range s.parsers {
+		if layout != "" {
+			if parser.Name() == layout {
+				return parser, nil
+			}
+
+			continue
+		}
+
+		count := parser.Match(lines)
+		if count > bestCount {
+			best, bestCount = parser, count
+		}
+	}
+
+	if layout != "" {
+		return nil, errors.New("unsupported statement layout")
+	}
+
+	if best == nil {
+		return nil, errors.New("no transactions found in statement")
+	}
+
+	return best, nil
+}
+
+// extractLines returns the non-empty text lines of every page of the PDF, with runs of whitespace collapsed.
+func extractLines(file io.ReadSeeker) ([]string, error) {
+	reader, err := model.NewPdfReader(file)
+	if err != nil {
+		return nil, errors.New("invalid pdf file")
+	}
+
+	pages, err := reader.GetNumPages()
+	if err != nil {
+		return nil, err
+	}
+
+	var lines []string
+
+	for i := 1; i <= pages; i++ {
+		page, err := reader.GetPage(i)
+		if err != nil {
+			return nil, err
+		}
+
+		ex, err := extractor.New(page)
+		if err != nil {
+			return nil, err
+		}
+
+		text, err := ex.ExtractText()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, line := range strings.Split(text, "\n") {
+			line = strings.Join(strings.Fields(line), " ")
+			if line != "" {
+				lines = append(lines, line)
+			}
+		}
+	}
+
+	return lines, nil
+}
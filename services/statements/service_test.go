@@ -0,0 +1,92 @@
+package statements
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"github.com/unidoc/unipdf/v3/creator"
+	"gofr.dev/pkg/gofr"
+	"moneyManagement/models"
+	"testing"
+)
+
+// statementPDF generates a single page PDF fixture with one paragraph per line.
+func statementPDF(t *testing.T, lines []string) *bytes.Reader {
+	c := creator.New()
+	c.NewPage()
+
+	for i, line := range lines {
+		p := c.NewParagraph(line)
+		p.SetFontSize(10)
+		p.SetPos(40, 40+float64(i)*16)
+
+		err := c.Draw(p)
+		if err != nil {
+			t.Fatalf("error drawing fixture: %v", err)
+		}
+	}
+
+	var buf bytes.Buffer
+
+	err := c.Write(&buf)
+	if err != nil {
+		t.Fatalf("error writing fixture: %v", err)
+	}
+
+	return bytes.NewReader(buf.Bytes())
+}
+
+func Test_Parse(t *testing.T) {
+	bankStatement := []string{
+		"Statement of Account",
+		"Date Narration Amount Balance",
+		"01/03/2025 SALARY MARCH 50,000.00 Cr 62,000.00 Cr",
+		"05/03/2025 UPI-SWIGGY 450.00 Dr 61,550.00 Cr",
+		"Closing Balance 61,550.00",
+	}
+
+	cardStatement := []string{
+		"Credit Card Statement",
+		"Date Transaction Details Amount",
+		"07 Mar 2025 AMAZON PAY INDIA 1,299.00",
+		"15 Mar 2025 PAYMENT RECEIVED 5,000.00 CR",
+	}
+
+	tests := []struct {
+		description    string
+		lines          []string
+		layout         string
+		expectedOutput *models.StatementDraft
+		expectedErr    string
+	}{
+		{"Success Case: bank statement", bankStatement, "", &models.StatementDraft{Layout: "debit-credit", Transactions: []*models.Transaction{
+			{Account: models.AccountDetails{ID: 1}, Amount: models.NewMoney(50000), Type: models.INCOME, Description: "SALARY MARCH", TransactionDate: "2025-03-01T00:00:00.000Z"},
+			{Account: models.AccountDetails{ID: 1}, Amount: models.NewMoney(450), Type: models.EXPENSE, Description: "UPI-SWIGGY", TransactionDate: "2025-03-05T00:00:00.000Z"},
+		}}, ""},
+		{"Success Case: credit card statement", cardStatement, "", &models.StatementDraft{Layout: "credit-card", Transactions: []*models.Transaction{
+			{Account: models.AccountDetails{ID: 1}, Amount: models.NewMoney(1299), Type: models.EXPENSE, Description: "AMAZON PAY INDIA", TransactionDate: "2025-03-07T00:00:00.000Z"},
+			{Account: models.AccountDetails{ID: 1}, Amount: models.NewMoney(5000), Type: models.INCOME, Description: "PAYMENT RECEIVED", TransactionDate: "2025-03-15T00:00:00.000Z"},
+		}}, ""},
+		{"Success Case: requested layout", cardStatement, "debit-credit", &models.StatementDraft{Layout: "debit-credit"}, ""},
+		{"Failure Case: unsupported layout", cardStatement, "unknown", nil, "unsupported statement layout"},
+		{"Failure Case: no rows", []string{"Nothing to see here"}, "", nil, "no transactions found in statement"},
+	}
+
+	for i, tc := range tests {
+		i := i
+		tc := tc
+
+		t.Run(tc.description, func(t *testing.T) {
+			s := New(nil)
+
+			output, err := s.Parse(&gofr.Context{}, statementPDF(t, tc.lines), tc.layout, 1)
+
+			assert.Equalf(t, tc.expectedOutput, output, "TEST[%d], failed.\n%s", i, tc.description)
+
+			if tc.expectedErr != "" {
+				assert.EqualErrorf(t, err, tc.expectedErr, "TEST[%d], failed.\n%s", i, tc.description)
+			} else {
+				assert.NoErrorf(t, err, "TEST[%d], failed.\n%s", i, tc.description)
+			}
+		})
+	}
+}
@@ -0,0 +1,295 @@
+package transactions
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"gofr.dev/pkg/gofr"
+	"io"
+	"moneyManagement/filters"
+	"moneyManagement/models"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// groupedAmount matches an amount with commas separating its thousands, the only commas an amount may have
+var groupedAmount = regexp.MustCompile(`^-?\d{1,3}(,\d{3})+(\.\d+)?$`)
+
+// Import parses a CSV file of transactions and, unless dryRun is set or any row is invalid, inserts every row in a
+// single SQL transaction, adjusting each affected account's balance exactly once.
+func (s *transactionSvc) Import(ctx *gofr.Context, file io.Reader, mapping *models.ImportColumnMapping, dryRun bool) (*models.ImportResult, error) {
+	userID, _ := ctx.Value("userID").(int)
+
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(records) == 0 {
+		return nil, errors.New("empty csv file")
+	}
+
+	columns, err := importColumns(records[0], mapping)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts, err := s.accountSvc.GetAll(ctx, &filters.Account{})
+	if err != nil {
+		return nil, err
+	}
+
+	byName, byID := make(map[string]*models.Account), make(map[int]*models.Account)
+	for _, account := range accounts {
+		byName[strings.ToLower(account.Name)] = account
+		byID[account.ID] = account
+	}
+
+	categories, err := s.categoryNames(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dateFormat := mapping.DateFormat
+	if dateFormat == "" {
+		dateFormat = "2006-01-02"
+	}
+
+	result := &models.ImportResult{DryRun: dryRun, TotalRows: len(records) - 1}
+	deltas := make(map[int]models.Money)
+
+	for i, record := range records[1:] {
+		transaction, err := parseImportRow(record, columns, dateFormat, byName, byID)
+		if err == nil {
+			err = validateCategories(transaction, categories)
+		}
+
+		if err != nil {
+			// Row numbers are 1-based and the header is row 1
+			result.Errors = append(result.Errors, models.ImportRowError{Row: i + 2, Error: err.Error()})
+			continue
+		}
+
+		transaction.UserID = userID
+
+		result.Transactions = append(result.Transactions, transaction)
+		for accountID, change := range transaction.BalanceEffects() {
+			deltas[accountID] += change
+		}
+	}
+
+	accountIDs := make([]int, 0, len(deltas))
+	for id := range deltas {
+		accountIDs = append(accountIDs, id)
+	}
+
+	// Lock accounts in a deterministic order so concurrent imports cannot deadlock
+	sort.Ints(accountIDs)
+
+	if dryRun || len(result.Errors) != 0 {
+		for _, id := range accountIDs {
+			account := byID[id]
+			result.Accounts = append(result.Accounts, models.ImportAccountSummary{
+				Account:         models.AccountDetails{ID: account.ID, Name: account.Name},
+				PreviousBalance: account.Balance,
+				NewBalance:      account.Balance + deltas[id],
+			})
+		}
+
+		return result, nil
+	}
+
+	tx, err := ctx.SQL.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	for _, id := range accountIDs {
+		account, err := s.accountSvc.GetByIDForUpdate(ctx, id, userID, tx)
+		if err != nil {
+			return nil, err
+		}
+
+		if account == nil {
+			return nil, errors.New("account not found")
+		}
+
+		previousBalance := account.Balance
+		account.Balance += deltas[id]
+
+		_, err = s.accountSvc.UpdateWithTx(ctx, account, tx)
+		if err != nil {
+			return nil, err
+		}
+
+		result.Accounts = append(result.Accounts, models.ImportAccountSummary{
+			Account:         models.AccountDetails{ID: account.ID, Name: account.Name},
+			PreviousBalance: previousBalance,
+			NewBalance:      account.Balance,
+		})
+	}
+
+	for _, transaction := range result.Transactions {
+		err = s.transactionStore.Create(ctx, transaction, tx)
+		if err != nil {
+			return nil, err
+		}
+
+		err = s.accountSvc.RecordBalanceChanges(ctx, ledgerEntries(transaction, transaction.TransactionDate, 1), tx)
+		if err != nil {
+			return nil, err
+		}
+
+		if transaction.Type == models.SAVINGS {
+			savings := &models.Savings{
+				UserID: transaction.UserID, Amount: transaction.Amount, Type: transaction.Category,
+				StartDate: transaction.TransactionDate, TransactionID: transaction.ID,
+			}
+
+			err = s.savingsSvc.CreateWithTx(ctx, savings, tx)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	result.Committed = true
+
+	return result, nil
+}
+
+// importColumns resolves the index of every mapped column in the CSV header.
+func importColumns(header []string, mapping *models.ImportColumnMapping) (map[string]int, error) {
+	fields := map[string]string{
+		"date":        mapping.Date,
+		"amount":      mapping.Amount,
+		"type":        mapping.Type,
+		"category":    mapping.Category,
+		"description": mapping.Description,
+		"account":     mapping.Account,
+	}
+
+	positions := make(map[string]int)
+	for i, name := range header {
+		positions[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+
+	columns := make(map[string]int)
+
+	for field, name := range fields {
+		if name == "" {
+			name = field
+		}
+
+		idx, ok := positions[strings.ToLower(name)]
+		if !ok {
+			if field == "date" || field == "amount" || field == "account" {
+				return nil, fmt.Errorf("missing column %q", name)
+			}
+
+			continue
+		}
+
+		columns[field] = idx
+	}
+
+	return columns, nil
+}
+
+// parseImportRow reads a CSV row into a transaction. Its account is referenced by name, or by id when no account has
+// that name, so an account named after the id of another one is still found.
+func parseImportRow(record []string, columns map[string]int, dateFormat string, byName map[string]*models.Account,
+	byID map[int]*models.Account) (*models.Transaction, error) {
+	value := func(field string) string {
+		idx, ok := columns[field]
+		if !ok || idx >= len(record) {
+			return ""
+		}
+
+		return strings.TrimSpace(record[idx])
+	}
+
+	rawAmount := value("amount")
+	if strings.Contains(rawAmount, ",") {
+		if !groupedAmount.MatchString(rawAmount) {
+			return nil, errors.New("invalid amount")
+		}
+
+		rawAmount = strings.ReplaceAll(rawAmount, ",", "")
+	}
+
+	amount, err := models.ParseMoney(rawAmount)
+	if err != nil {
+		return nil, errors.New("invalid amount")
+	}
+
+	transactionType := models.Type(strings.ToUpper(value("type")))
+
+	// Without a type column the sign of the amount decides between income and expense
+	if transactionType == "" {
+		transactionType = models.INCOME
+		if amount < 0 {
+			transactionType = models.EXPENSE
+		}
+	}
+
+	amount = amount.Abs()
+	if amount == 0 {
+		return nil, errors.New("amount must be non-zero")
+	}
+
+	if transactionType != models.INCOME && transactionType != models.EXPENSE && transactionType != models.SAVINGS {
+		return nil, fmt.Errorf("invalid type %q", transactionType)
+	}
+
+	date, err := time.Parse(dateFormat, value("date"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid date, expected format %s", dateFormat)
+	}
+
+	account, ok := byName[strings.ToLower(value("account"))]
+	if !ok {
+		id, err := strconv.Atoi(value("account"))
+		if err == nil {
+			account, ok = byID[id]
+		}
+	}
+
+	if !ok {
+		return nil, fmt.Errorf("unknown account %q", value("account"))
+	}
+
+	transaction := &models.Transaction{
+		Account:         models.AccountDetails{ID: account.ID, Name: account.Name},
+		Amount:          amount,
+		Currency:        account.Currency,
+		Type:            transactionType,
+		Category:        value("category"),
+		Description:     value("description"),
+		TransactionDate: date.Format("2006-01-02"),
+		CreatedAt:       time.Now().UTC().Format("2006-01-02"),
+	}
+
+	err = transaction.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return transaction, nil
+}
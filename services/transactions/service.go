@@ -3,26 +3,38 @@ package transactions
 import (
 	"database/sql"
 	"errors"
+	"fmt"
 	"gofr.dev/pkg/gofr"
+	datasourceSQL "gofr.dev/pkg/gofr/datasource/sql"
 	"moneyManagement/filters"
 	"moneyManagement/models"
 	"moneyManagement/services"
 	"moneyManagement/stores"
+	"sort"
+	"strings"
 	"time"
 )
 
 type transactionSvc struct {
 	transactionStore stores.Transactions
+	splitStore       stores.TransactionSplits
 	accountSvc       services.Account
 	savingsSvc       services.Savings
+	categorySvc      services.Categories
+	exchangeRateSvc  services.ExchangeRates
 	userSvc          services.User
 }
 
-func New(transactionStore stores.Transactions, accountSvc services.Account, savingsSvc services.Savings, userSvc services.User) services.Transactions {
+func New(transactionStore stores.Transactions, splitStore stores.TransactionSplits, accountSvc services.Account,
+	savingsSvc services.Savings, categorySvc services.Categories, exchangeRateSvc services.ExchangeRates,
+	userSvc services.User) services.Transactions {
 	return &transactionSvc{
 		transactionStore: transactionStore,
+		splitStore:       splitStore,
 		accountSvc:       accountSvc,
 		savingsSvc:       savingsSvc,
+		categorySvc:      categorySvc,
+		exchangeRateSvc:  exchangeRateSvc,
 		userSvc:          userSvc,
 	}
 }
@@ -45,40 +57,77 @@ func (s *transactionSvc) Create(ctx *gofr.Context, transaction *models.Transacti
 	transaction.UserID = userID
 	transaction.TransactionDate, _ = convertToMySQLDate(transaction.TransactionDate)
 
-	// 1️⃣ Lock the Account Row First using FOR UPDATE
-	account, err := s.accountSvc.GetByIDForUpdate(ctx, transaction.Account.ID, userID, tx)
+	err = s.CreateWithTx(ctx, transaction, tx)
 	if err != nil {
 		return nil, err
 	}
 
-	// 2️⃣ Update Account Balance Immediately
-	if transaction.Type == "INCOME" {
-		a := account.Balance + transaction.Amount
-		ctx.Logger.Warnf("transaction.Type :%v,transaction.Amount:%v,previous account balance :%v, new account balance: %v", "INCOME", transaction.Amount, account.Balance, a)
-		account.Balance = a
-	} else if transaction.Type == "SAVINGS" {
-		b := account.Balance - transaction.Amount
-		ctx.Logger.Warnf("transaction.Type :%v,transaction.Amount:%v,previous account balance :%v, new account balance: %v", "SAVINGS", transaction.Amount, account.Balance, b)
-		account.Balance = b
-	} else if transaction.Type == "EXPENSE" {
-		c := account.Balance - transaction.Amount
-		ctx.Logger.Warnf("transaction.Type :%v,transaction.Amount:%v,previous account balance :%v, new account balance: %v", "EXPENSE", transaction.Amount, account.Balance, c)
-		account.Balance = c
+	// 5️⃣ Commit Transaction
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
 	}
 
-	// ✅ Update account balance before inserting transaction
-	_, err = s.accountSvc.UpdateWithTx(ctx, account, tx)
+	// 6️⃣ Fetch and return the newly created transaction after commit
+	newTransaction, err := s.GetByID(ctx, transaction.ID)
 	if err != nil {
 		return nil, err
 	}
 
-	// 3️⃣ Insert the Transaction Record
+	return newTransaction, nil
+}
+
+// CreateWithTx locks the affected accounts, applies the balance change and inserts the transaction inside tx.
+// TransactionDate must already be in MySQL format and UserID must be set; the caller owns commit and rollback.
+func (s *transactionSvc) CreateWithTx(ctx *gofr.Context, transaction *models.Transaction, tx *datasourceSQL.Tx) error {
+	err := validateTransfer(transaction)
+	if err != nil {
+		return err
+	}
+
+	err = transaction.ValidateSplits()
+	if err != nil {
+		return err
+	}
+
+	categories, err := s.categoryNames(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = validateCategories(transaction, categories)
+	if err != nil {
+		return err
+	}
+
+	err = s.setCurrencies(ctx, transaction)
+	if err != nil {
+		return err
+	}
+
+	// 1️⃣ Lock the Account Rows First using FOR UPDATE and update their balances
+	err = s.applyBalanceChanges(ctx, transaction.UserID, transaction.BalanceEffects(), tx)
+	if err != nil {
+		return err
+	}
+
+	// 2️⃣ Insert the Transaction Record
 	err = s.transactionStore.Create(ctx, transaction, tx)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	// 4️⃣ Insert into Savings only if it's a "SAVINGS" transaction
+	err = s.accountSvc.RecordBalanceChanges(ctx, ledgerEntries(transaction, transaction.TransactionDate, 1), tx)
+	if err != nil {
+		return err
+	}
+
+	err = s.createSplits(ctx, transaction, tx)
+	if err != nil {
+		return err
+	}
+
+	// 3️⃣ Insert into Savings only if it's a "SAVINGS" transaction
 	if transaction.Type == "SAVINGS" {
 		savings := &models.Savings{
 			UserID: transaction.UserID, Amount: transaction.Amount, Type: transaction.Category,
@@ -87,47 +136,75 @@ func (s *transactionSvc) Create(ctx *gofr.Context, transaction *models.Transacti
 
 		err = s.savingsSvc.CreateWithTx(ctx, savings, tx)
 		if err != nil {
-			return nil, err
+			return err
 		}
 	}
 
-	// 5️⃣ Commit Transaction
-	err = tx.Commit()
+	return nil
+}
+
+func (s *transactionSvc) GetByID(ctx *gofr.Context, id int) (*models.Transaction, error) {
+	userID, _ := ctx.Value("userID").(int)
+
+	transaction, err := s.transactionStore.GetByID(ctx, id, userID)
 	if err != nil {
 		return nil, err
 	}
 
-	// 6️⃣ Fetch and return the newly created transaction after commit
-	newTransaction, err := s.GetByID(ctx, transaction.ID)
+	if transaction == nil {
+		return nil, nil
+	}
+
+	err = s.attachSplits(ctx, []*models.Transaction{transaction})
 	if err != nil {
 		return nil, err
 	}
 
-	return newTransaction, nil
+	return transaction, nil
 }
 
-func (s *transactionSvc) GetByID(ctx *gofr.Context, id int) (*models.Transaction, error) {
+func (s *transactionSvc) GetAll(ctx *gofr.Context, f *filters.Transactions) ([]*models.Transaction, error) {
 	userID, _ := ctx.Value("userID").(int)
 
-	transaction, err := s.transactionStore.GetByID(ctx, id, userID)
+	f.UserID = userID
+
+	// A parent category matches the transactions of all its sub-categories
+	categories, err := s.categorySvc.WithDescendants(ctx, f.Category)
 	if err != nil {
 		return nil, err
 	}
 
-	return transaction, nil
+	f.Category = categories
+
+	allTransactions, err := s.transactionStore.GetAll(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.attachSplits(ctx, allTransactions)
+	if err != nil {
+		return nil, err
+	}
+
+	return allTransactions, nil
 }
 
-func (s *transactionSvc) GetAll(ctx *gofr.Context, f *filters.Transactions) ([]*models.Transaction, error) {
+// BucketTotals adds up the transactions of the user matching f by the day, week, month or year they are dated in, their
+// type, category and currency.
+func (s *transactionSvc) BucketTotals(ctx *gofr.Context, f *filters.Transactions, interval string) ([]*models.BucketTotal, error) {
 	userID, _ := ctx.Value("userID").(int)
 
 	f.UserID = userID
 
-	allTransactions, err := s.transactionStore.GetAll(ctx, f)
+	// A parent category matches the transactions of all its sub-categories
+	categories, err := s.categorySvc.WithDescendants(ctx, f.Category)
 	if err != nil {
 		return nil, err
 	}
 
-	return allTransactions, nil
+	f.Category = categories
+
+	return s.transactionStore.GetBucketTotals(ctx, f, interval)
 }
 
 func (s *transactionSvc) Update(ctx *gofr.Context, transaction *models.Transaction) (*models.Transaction, error) {
@@ -146,31 +223,53 @@ func (s *transactionSvc) Update(ctx *gofr.Context, transaction *models.Transacti
 
 	transaction.TransactionDate, _ = convertToMySQLDate(transaction.TransactionDate)
 
-	// Fetch the original transaction to compare values
-	originalTransaction, err := s.GetByID(ctx, transaction.ID)
+	err = validateTransfer(transaction)
 	if err != nil {
 		return nil, err
 	}
 
-	// Get the associated account
-	account, err := s.accountSvc.GetByID(ctx, transaction.Account.ID)
+	err = transaction.ValidateSplits()
 	if err != nil {
 		return nil, err
 	}
 
-	// Reverse the effect of the original transaction
-	if originalTransaction.Type == "INCOME" {
-		account.Balance -= originalTransaction.Amount
-	} else if originalTransaction.Type == "SAVINGS" {
-		account.Balance += originalTransaction.Amount
-	} else if originalTransaction.Type == "EXPENSE" {
-		account.Balance += originalTransaction.Amount
+	// Fetch the original transaction to compare values, locked so a concurrent update or delete cannot reverse it twice
+	originalTransaction, err := s.getByIDForUpdate(ctx, transaction.ID, userID, tx)
+	if err != nil {
+		return nil, err
 	}
 
-	// Apply the effect of the updated transaction
-	if transaction.Type == "INCOME" {
-		account.Balance += transaction.Amount
-	} else if transaction.Type == "SAVINGS" {
+	// A deleted transaction no longer affects any balance, so it cannot be updated either
+	if originalTransaction == nil || originalTransaction.DeletedAt != "" {
+		return nil, errors.New("transaction not found")
+	}
+
+	categories, err := s.categoryNames(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Keep archived categories the transaction already uses valid, so unrelated edits are not rejected
+	addCategories(categories, originalTransaction)
+
+	err = validateCategories(transaction, categories)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.setCurrencies(ctx, transaction)
+	if err != nil {
+		return nil, err
+	}
+
+	err = validateStatusChange(originalTransaction, transaction)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := updateEffects(originalTransaction, transaction)
+
+	if transaction.Type == "SAVINGS" {
 		savings := &models.Savings{
 			UserID:        transaction.UserID,
 			Amount:        transaction.Amount,
@@ -197,9 +296,7 @@ func (s *transactionSvc) Update(ctx *gofr.Context, transaction *models.Transacti
 				return nil, err
 			}
 		}
-
-		account.Balance -= transaction.Amount
-	} else if transaction.Type == "EXPENSE" {
+	} else {
 		saving, er := s.savingsSvc.GetByTransactionID(ctx, transaction.ID)
 		if er == nil {
 			err = s.savingsSvc.Delete(ctx, saving.ID)
@@ -207,7 +304,24 @@ func (s *transactionSvc) Update(ctx *gofr.Context, transaction *models.Transacti
 				return nil, err
 			}
 		}
-		account.Balance -= transaction.Amount
+	}
+
+	// Update account balances
+	err = s.applyBalanceChanges(ctx, userID, changes, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The ledger reverses the original on its date and applies the update on the new one, so moving a transaction
+	// to another date moves its effect in the balance history as well
+	originalDate, _ := convertToMySQLDate(originalTransaction.TransactionDate)
+
+	entries := append(ledgerEntries(originalTransaction, originalDate, -1),
+		ledgerEntries(transaction, transaction.TransactionDate, 1)...)
+
+	err = s.accountSvc.RecordBalanceChanges(ctx, mergeEntries(entries), tx)
+	if err != nil {
+		return nil, err
 	}
 
 	// Update transaction record
@@ -216,8 +330,13 @@ func (s *transactionSvc) Update(ctx *gofr.Context, transaction *models.Transacti
 		return nil, err
 	}
 
-	// Update account balance
-	_, err = s.accountSvc.UpdateWithTx(ctx, account, tx)
+	// Replace the splits of the transaction
+	err = s.splitStore.DeleteByTransactionID(ctx, transaction.ID, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.createSplits(ctx, transaction, tx)
 	if err != nil {
 		return nil, err
 	}
@@ -246,20 +365,29 @@ func (s *transactionSvc) Delete(ctx *gofr.Context, id int) error {
 		_ = tx.Rollback()
 	}()
 
-	originalTransaction, err := s.GetByID(ctx, id)
-	if err != nil {
+	userID, _ := ctx.Value("userID").(int)
+
+	originalTransaction, err := s.getByIDForUpdate(ctx, id, userID, tx)
+	if err != nil || originalTransaction == nil || originalTransaction.DeletedAt != "" {
 		return errors.New("unauthorised")
 	}
 
-	account, err := s.accountSvc.GetByID(ctx, originalTransaction.Account.ID)
+	if status := originalTransaction.SettledStatus(); status == models.CLEARED || status == models.RECONCILED {
+		return fmt.Errorf("%s transactions cannot be deleted", strings.ToLower(string(status)))
+	}
+
+	changes := reverseEffects(originalTransaction)
+
+	err = s.applyBalanceChanges(ctx, userID, changes, tx)
 	if err != nil {
 		return err
 	}
 
-	if originalTransaction.Type == "EXPENSE" || originalTransaction.Type == "SAVINGS" {
-		account.Balance += originalTransaction.Amount
-	} else if originalTransaction.Type == "INCOME" {
-		account.Balance -= originalTransaction.Amount
+	originalDate, _ := convertToMySQLDate(originalTransaction.TransactionDate)
+
+	err = s.accountSvc.RecordBalanceChanges(ctx, ledgerEntries(originalTransaction, originalDate, -1), tx)
+	if err != nil {
+		return err
 	}
 
 	err = s.transactionStore.Delete(ctx, id, tx)
@@ -267,7 +395,7 @@ func (s *transactionSvc) Delete(ctx *gofr.Context, id int) error {
 		return err
 	}
 
-	_, err = s.accountSvc.UpdateWithTx(ctx, account, tx)
+	err = s.splitStore.DeleteByTransactionID(ctx, id, tx)
 	if err != nil {
 		return err
 	}
@@ -280,6 +408,332 @@ func (s *transactionSvc) Delete(ctx *gofr.Context, id int) error {
 	return nil
 }
 
+// SetStatusWithTx sets the status on an account of transactions of the user in ctx inside tx; the caller owns commit
+// and rollback.
+func (s *transactionSvc) SetStatusWithTx(ctx *gofr.Context, ids []int, accountID int, status models.TransactionStatus,
+	tx *datasourceSQL.Tx) error {
+	userID, _ := ctx.Value("userID").(int)
+
+	return s.transactionStore.UpdateStatus(ctx, ids, userID, accountID, status, tx)
+}
+
+// getByIDForUpdate fetches a transaction of the user with its splits, locking its row until tx ends.
+func (s *transactionSvc) getByIDForUpdate(ctx *gofr.Context, id, userID int, tx *datasourceSQL.Tx) (*models.Transaction, error) {
+	transaction, err := s.transactionStore.GetByIDForUpdate(ctx, id, userID, tx)
+	if err != nil || transaction == nil {
+		return nil, err
+	}
+
+	err = s.attachSplits(ctx, []*models.Transaction{transaction})
+	if err != nil {
+		return nil, err
+	}
+
+	return transaction, nil
+}
+
+func (s *transactionSvc) createSplits(ctx *gofr.Context, transaction *models.Transaction, tx *datasourceSQL.Tx) error {
+	for i := range transaction.Splits {
+		transaction.Splits[i].TransactionID = transaction.ID
+
+		err := s.splitStore.Create(ctx, &transaction.Splits[i], tx)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// attachSplits loads the splits of all given transactions with a single query.
+func (s *transactionSvc) attachSplits(ctx *gofr.Context, transactions []*models.Transaction) error {
+	ids := make([]int, 0, len(transactions))
+	for _, transaction := range transactions {
+		ids = append(ids, transaction.ID)
+	}
+
+	splits, err := s.splitStore.GetByTransactionIDs(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	for _, transaction := range transactions {
+		transaction.Splits = splits[transaction.ID]
+	}
+
+	return nil
+}
+
+// categoryNames returns the names of the active categories of the user in ctx by type.
+func (s *transactionSvc) categoryNames(ctx *gofr.Context) (map[models.Type]map[string]struct{}, error) {
+	categories, err := s.categorySvc.GetAll(ctx, &filters.Category{})
+	if err != nil {
+		return nil, err
+	}
+
+	names := make(map[models.Type]map[string]struct{})
+	for _, category := range categories {
+		if names[category.Type] == nil {
+			names[category.Type] = make(map[string]struct{})
+		}
+
+		names[category.Type][category.Name] = struct{}{}
+	}
+
+	return names, nil
+}
+
+// setCurrencies records the currency of the account of a transaction. A transfer to an account in another currency
+// also records the amount credited to it, converted at the rate of the transaction date unless ToAmount is given.
+func (s *transactionSvc) setCurrencies(ctx *gofr.Context, transaction *models.Transaction) error {
+	account, err := s.accountSvc.GetByID(ctx, transaction.Account.ID)
+	if err != nil {
+		return err
+	}
+
+	if account == nil {
+		return errors.New("account not found")
+	}
+
+	transaction.Currency = account.Currency
+
+	if transaction.Type != models.TRANSFER {
+		transaction.ToAmount, transaction.ToCurrency = nil, ""
+		return nil
+	}
+
+	toAccount, err := s.accountSvc.GetByID(ctx, transaction.ToAccount.ID)
+	if err != nil {
+		return err
+	}
+
+	if toAccount == nil {
+		return errors.New("destination account not found")
+	}
+
+	if toAccount.Currency == account.Currency {
+		transaction.ToAmount, transaction.ToCurrency = nil, ""
+		return nil
+	}
+
+	transaction.ToCurrency = toAccount.Currency
+
+	if transaction.ToAmount != nil {
+		if *transaction.ToAmount <= 0 {
+			return errors.New("toAmount must be positive")
+		}
+
+		return nil
+	}
+
+	converter, err := s.exchangeRateSvc.Converter(ctx, transaction.TransactionDate, transaction.TransactionDate)
+	if err != nil {
+		return err
+	}
+
+	toAmount, err := converter.Convert(transaction.Amount, account.Currency, toAccount.Currency, transaction.TransactionDate)
+	if err != nil {
+		return err
+	}
+
+	transaction.ToAmount = &toAmount
+
+	return nil
+}
+
+// applyBalanceChanges locks every account in changes with FOR UPDATE in ascending id order, so two transfers
+// between the same pair of accounts can never deadlock, and adds the change to each balance.
+func (s *transactionSvc) applyBalanceChanges(ctx *gofr.Context, userID int, changes map[int]models.Money, tx *datasourceSQL.Tx) error {
+	accountIDs := make([]int, 0, len(changes))
+	for accountID := range changes {
+		accountIDs = append(accountIDs, accountID)
+	}
+
+	sort.Ints(accountIDs)
+
+	for _, accountID := range accountIDs {
+		account, err := s.accountSvc.GetByIDForUpdate(ctx, accountID, userID, tx)
+		if err != nil {
+			return err
+		}
+
+		if account == nil {
+			return errors.New("account not found")
+		}
+
+		balance := account.Balance + changes[accountID]
+		ctx.Logger.Warnf("account :%v,change:%v,previous account balance :%v, new account balance: %v", accountID, changes[accountID], account.Balance, balance)
+		account.Balance = balance
+
+		_, err = s.accountSvc.UpdateWithTx(ctx, account, tx)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// updateEffects reverses the effect of the original transaction and applies the effect of the updated one on every
+// account either of them touches, so moving a transaction or a transfer leg to another account is handled as well.
+func updateEffects(original, updated *models.Transaction) map[int]models.Money {
+	changes := updated.BalanceEffects()
+	for accountID, change := range original.BalanceEffects() {
+		changes[accountID] -= change
+	}
+
+	return changes
+}
+
+// reverseEffects undoes every leg of a deleted transaction.
+func reverseEffects(transaction *models.Transaction) map[int]models.Money {
+	changes := make(map[int]models.Money)
+	for accountID, change := range transaction.BalanceEffects() {
+		changes[accountID] = -change
+	}
+
+	return changes
+}
+
+// ledgerEntries returns a balance ledger entry, effective on date, for every account a transaction changes. A sign of -1
+// reverses the changes.
+func ledgerEntries(transaction *models.Transaction, date string, sign models.Money) []*models.BalanceLedgerEntry {
+	effects := transaction.BalanceEffects()
+
+	accountIDs := make([]int, 0, len(effects))
+	for accountID := range effects {
+		accountIDs = append(accountIDs, accountID)
+	}
+
+	sort.Ints(accountIDs)
+
+	entries := make([]*models.BalanceLedgerEntry, 0, len(accountIDs))
+
+	for _, accountID := range accountIDs {
+		transactionID := transaction.ID
+
+		entries = append(entries, &models.BalanceLedgerEntry{
+			UserID:        transaction.UserID,
+			AccountID:     accountID,
+			TransactionID: &transactionID,
+			Change:        sign * effects[accountID],
+			EffectiveDate: date,
+		})
+	}
+
+	return entries
+}
+
+// mergeEntries adds up the entries of the same account and date and drops the ones that cancel out.
+func mergeEntries(entries []*models.BalanceLedgerEntry) []*models.BalanceLedgerEntry {
+	type key struct {
+		accountID int
+		date      string
+	}
+
+	merged := make([]*models.BalanceLedgerEntry, 0, len(entries))
+	byKey := make(map[key]*models.BalanceLedgerEntry)
+
+	for _, entry := range entries {
+		if existing, ok := byKey[key{entry.AccountID, entry.EffectiveDate}]; ok {
+			existing.Change += entry.Change
+			continue
+		}
+
+		byKey[key{entry.AccountID, entry.EffectiveDate}] = entry
+		merged = append(merged, entry)
+	}
+
+	nonZero := merged[:0]
+
+	for _, entry := range merged {
+		if entry.Change != 0 {
+			nonZero = append(nonZero, entry)
+		}
+	}
+
+	return nonZero
+}
+
+func addCategories(names map[models.Type]map[string]struct{}, transaction *models.Transaction) {
+	if names[transaction.Type] == nil {
+		names[transaction.Type] = make(map[string]struct{})
+	}
+
+	names[transaction.Type][transaction.Category] = struct{}{}
+
+	for _, split := range transaction.Splits {
+		names[transaction.Type][split.Category] = struct{}{}
+	}
+}
+
+// validateCategories checks the category and split categories of a transaction against the categories of its type.
+// EXPENSE transactions always need a category, INCOME and SAVINGS ones only when it is set and TRANSFERs have none.
+func validateCategories(transaction *models.Transaction, names map[models.Type]map[string]struct{}) error {
+	if transaction.Type == models.TRANSFER {
+		return nil
+	}
+
+	if transaction.Category != "" || transaction.Type == models.EXPENSE {
+		if _, exists := names[transaction.Type][transaction.Category]; !exists {
+			return fmt.Errorf("invalid category for %s type", transaction.Type)
+		}
+	}
+
+	for _, split := range transaction.Splits {
+		if _, exists := names[transaction.Type][split.Category]; !exists {
+			return fmt.Errorf("invalid split category for %s type", transaction.Type)
+		}
+	}
+
+	return nil
+}
+
+// validateStatusChange keeps a transaction cleared or reconciled on any of its accounts on its date with the same
+// effect on every balance, so only its category, splits and description can be edited.
+func validateStatusChange(original, updated *models.Transaction) error {
+	status := original.SettledStatus()
+	if status != models.CLEARED && status != models.RECONCILED {
+		return nil
+	}
+
+	originalDate, _ := convertToMySQLDate(original.TransactionDate)
+
+	originalEffects, updatedEffects := original.BalanceEffects(), updated.BalanceEffects()
+
+	changed := originalDate != updated.TransactionDate || len(originalEffects) != len(updatedEffects)
+
+	for accountID, change := range originalEffects {
+		if updatedEffects[accountID] != change {
+			changed = true
+		}
+	}
+
+	if changed {
+		return fmt.Errorf("%s transactions can only change their category, splits and description",
+			strings.ToLower(string(status)))
+	}
+
+	return nil
+}
+
+func validateTransfer(transaction *models.Transaction) error {
+	if transaction.Type != models.TRANSFER {
+		transaction.ToAccount = nil
+		return nil
+	}
+
+	if transaction.ToAccount == nil || transaction.ToAccount.ID == 0 {
+		return errors.New("destination account is required for TRANSFER type")
+	}
+
+	if transaction.ToAccount.ID == transaction.Account.ID {
+		return errors.New("source and destination accounts must be different")
+	}
+
+	return nil
+}
+
 func convertToMySQLDate(isoDate string) (string, error) {
 	t, err := time.Parse(time.RFC3339, isoDate) // Parses "2025-03-20T07:49:00.000Z"
 	if err != nil {
@@ -0,0 +1,373 @@
+package transactions
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	gofrSQL "gofr.dev/pkg/gofr/datasource/sql"
+
+	"moneyManagement/filters"
+	"moneyManagement/models"
+	"moneyManagement/services"
+	"moneyManagement/stores"
+)
+
+// accountUpdate is the balance an account is updated to
+type accountUpdate struct {
+	accountID int
+	balance   models.Money
+}
+
+func Test_BalanceChanges(t *testing.T) {
+	categories := []*models.Category{{Name: "Groceries", Type: models.EXPENSE}, {Name: "Salary", Type: models.INCOME}}
+
+	transaction := func(id, accountID int, amount float64, transactionType models.Type,
+		category, date string) *models.Transaction {
+		return &models.Transaction{ID: id, UserID: 1, Account: models.AccountDetails{ID: accountID},
+			Amount: models.NewMoney(amount), Type: transactionType, Category: category, TransactionDate: date}
+	}
+
+	transfer := func(id, from, to int, amount float64, date string) *models.Transaction {
+		t := transaction(id, from, amount, models.TRANSFER, "", date)
+		t.ToAccount = &models.AccountDetails{ID: to}
+
+		return t
+	}
+
+	entry := func(transactionID, accountID int, change float64, date string) *models.BalanceLedgerEntry {
+		return &models.BalanceLedgerEntry{UserID: 1, AccountID: accountID, TransactionID: &transactionID,
+			Change: models.NewMoney(change), EffectiveDate: date}
+	}
+
+	toAmount := models.NewMoney(8325)
+	crossCurrency := transfer(6, 3, 1, 100, "2025-06-01 00:00:00")
+	crossCurrency.ToAmount = &toAmount
+
+	const (
+		day     = "2025-06-01 00:00:00"
+		nextDay = "2025-06-02 00:00:00"
+	)
+
+	tests := []struct {
+		description     string
+		original        *models.Transaction
+		run             func(s services.Transactions, ctx *gofr.Context, tx *gofrSQL.Tx) error
+		expectedUpdates []accountUpdate
+		expectedEntries []*models.BalanceLedgerEntry
+	}{
+		{"fractions add up exactly", nil, func(s services.Transactions, ctx *gofr.Context, tx *gofrSQL.Tx) error {
+			for _, amount := range []float64{0.1, 0.2, 0.3} {
+				err := s.CreateWithTx(ctx, transaction(1, 1, amount, models.INCOME, "Salary", day), tx)
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		}, []accountUpdate{{1, models.NewMoney(1000.1)}, {1, models.NewMoney(1000.3)}, {1, models.NewMoney(1000.6)}},
+			[]*models.BalanceLedgerEntry{entry(1, 1, 0.1, day), entry(1, 1, 0.2, day), entry(1, 1, 0.3, day)}},
+		{"transfer moves the amount between accounts", nil,
+			func(s services.Transactions, ctx *gofr.Context, tx *gofrSQL.Tx) error {
+				return s.CreateWithTx(ctx, transfer(2, 2, 1, 19.99, day), tx)
+			}, []accountUpdate{{1, models.NewMoney(1019.99)}, {2, models.NewMoney(480.01)}},
+			[]*models.BalanceLedgerEntry{entry(2, 1, 19.99, day), entry(2, 2, -19.99, day)}},
+		{"transfer across currencies credits the converted amount", nil,
+			func(s services.Transactions, ctx *gofr.Context, tx *gofrSQL.Tx) error {
+				return s.CreateWithTx(ctx, crossCurrency, tx)
+			}, []accountUpdate{{1, models.NewMoney(9325)}, {3, models.NewMoney(0)}},
+			[]*models.BalanceLedgerEntry{entry(6, 1, 8325, day), entry(6, 3, -100, day)}},
+		{"update on the same date changes the balance by the difference",
+			transaction(3, 1, 40, models.EXPENSE, "Groceries", "2025-06-01T00:00:00Z"),
+			func(s services.Transactions, ctx *gofr.Context, _ *gofrSQL.Tx) error {
+				_, err := s.Update(ctx, transaction(3, 1, 25, models.EXPENSE, "Groceries", "2025-06-01T00:00:00.000Z"))
+				return err
+			}, []accountUpdate{{1, models.NewMoney(1015)}}, []*models.BalanceLedgerEntry{entry(3, 1, 15, day)}},
+		{"update to another account and date moves the effect",
+			transaction(4, 1, 40, models.EXPENSE, "Groceries", "2025-06-01T00:00:00Z"),
+			func(s services.Transactions, ctx *gofr.Context, _ *gofrSQL.Tx) error {
+				_, err := s.Update(ctx, transaction(4, 2, 40, models.EXPENSE, "Groceries", "2025-06-02T00:00:00.000Z"))
+				return err
+			}, []accountUpdate{{1, models.NewMoney(1040)}, {2, models.NewMoney(460)}},
+			[]*models.BalanceLedgerEntry{entry(4, 1, 40, day), entry(4, 2, -40, nextDay)}},
+		{"delete reverses every leg", transfer(5, 1, 2, 0.07, "2025-06-01T00:00:00Z"),
+			func(s services.Transactions, ctx *gofr.Context, _ *gofrSQL.Tx) error {
+				return s.Delete(ctx, 5)
+			}, []accountUpdate{{1, models.NewMoney(1000.07)}, {2, models.NewMoney(499.93)}},
+			[]*models.BalanceLedgerEntry{entry(5, 1, 0.07, day), entry(5, 2, -0.07, day)}},
+	}
+
+	for i, tc := range tests {
+		ctrl := gomock.NewController(t)
+		transactionStore := stores.NewMockTransactions(ctrl)
+		splitStore := stores.NewMockTransactionSplits(ctrl)
+		accountSvc := services.NewMockAccount(ctrl)
+		savingsSvc := services.NewMockSavings(ctrl)
+		categorySvc := services.NewMockCategories(ctrl)
+
+		mockContainer, mocks := container.NewMockContainer(t)
+		ctx := &gofr.Context{Context: context.WithValue(context.Background(), "userID", 1), Container: mockContainer}
+
+		currencies := map[int]string{1: "INR", 2: "INR", 3: "USD"}
+		balances := map[int]models.Money{1: models.NewMoney(1000), 2: models.NewMoney(500), 3: models.NewMoney(100)}
+
+		var (
+			updates []accountUpdate
+			entries []*models.BalanceLedgerEntry
+		)
+
+		accountSvc.EXPECT().GetByID(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(
+			func(_ *gofr.Context, id int) (*models.Account, error) {
+				return &models.Account{ID: id, Currency: currencies[id], Balance: balances[id]}, nil
+			})
+		accountSvc.EXPECT().GetByIDForUpdate(gomock.Any(), gomock.Any(), 1, gomock.Any()).AnyTimes().DoAndReturn(
+			func(_ *gofr.Context, id, _ int, _ *gofrSQL.Tx) (*models.Account, error) {
+				return &models.Account{ID: id, Currency: currencies[id], Balance: balances[id]}, nil
+			})
+		accountSvc.EXPECT().UpdateWithTx(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(
+			func(_ *gofr.Context, account *models.Account, _ *gofrSQL.Tx) (*models.Account, error) {
+				updates = append(updates, accountUpdate{account.ID, account.Balance})
+				balances[account.ID] = account.Balance
+
+				return account, nil
+			})
+		accountSvc.EXPECT().RecordBalanceChanges(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(
+			func(_ *gofr.Context, recorded []*models.BalanceLedgerEntry, _ *gofrSQL.Tx) error {
+				entries = append(entries, recorded...)
+				return nil
+			})
+		categorySvc.EXPECT().GetAll(gomock.Any(), &filters.Category{}).AnyTimes().Return(categories, nil)
+		splitStore.EXPECT().GetByTransactionIDs(gomock.Any(), gomock.Any()).AnyTimes().Return(nil, nil)
+		splitStore.EXPECT().DeleteByTransactionID(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(nil)
+		savingsSvc.EXPECT().GetByTransactionID(gomock.Any(), gomock.Any()).AnyTimes().Return(nil, sql.ErrNoRows)
+		transactionStore.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(nil)
+		transactionStore.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(nil)
+		transactionStore.EXPECT().Delete(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(nil)
+
+		var tx *gofrSQL.Tx
+
+		mocks.SQL.ExpectBegin()
+
+		// Update and Delete run their own SQL transaction, CreateWithTx joins the caller's
+		if tc.original != nil {
+			transactionStore.EXPECT().GetByIDForUpdate(gomock.Any(), tc.original.ID, 1, gomock.Any()).Return(tc.original, nil)
+			transactionStore.EXPECT().GetByID(gomock.Any(), tc.original.ID, 1).AnyTimes().Return(tc.original, nil)
+			mocks.SQL.ExpectCommit()
+		} else {
+			tx, _ = mockContainer.SQL.Begin()
+		}
+
+		s := New(transactionStore, splitStore, accountSvc, savingsSvc, categorySvc, nil, nil)
+
+		err := tc.run(s, ctx, tx)
+
+		assert.Equalf(t, nil, err, "TEST[%d], failed.\n%s", i, tc.description)
+		assert.Equalf(t, tc.expectedUpdates, updates, "TEST[%d], failed.\n%s", i, tc.description)
+		assert.Equalf(t, tc.expectedEntries, entries, "TEST[%d], failed.\n%s", i, tc.description)
+	}
+}
+
+func Test_ValidateSplitsExact(t *testing.T) {
+	amount, _ := models.ParseMoney("0.3")
+	first, _ := models.ParseMoney("0.1")
+	second, _ := models.ParseMoney("0.2")
+
+	transaction := &models.Transaction{Amount: amount, Type: models.EXPENSE,
+		Splits: []models.TransactionSplit{{Category: "Groceries", Amount: first}, {Category: "Pet Care", Amount: second}}}
+
+	assert.NoError(t, transaction.ValidateSplits())
+
+	transaction.Splits[1].Amount++
+
+	assert.Error(t, transaction.ValidateSplits())
+}
+
+func Test_BalanceEffectsCrossCurrencyTransfer(t *testing.T) {
+	amount, _ := models.ParseMoney("100")
+	toAmount, _ := models.ParseMoney("8325")
+
+	transfer := &models.Transaction{Account: models.AccountDetails{ID: 1}, ToAccount: &models.AccountDetails{ID: 2},
+		Amount: amount, Currency: "USD", ToAmount: &toAmount, ToCurrency: "INR", Type: models.TRANSFER}
+
+	assert.Equal(t, map[int]models.Money{1: -amount, 2: toAmount}, transfer.BalanceEffects())
+	assert.Equal(t, map[int]models.Money{1: amount, 2: -toAmount}, reverseEffects(transfer))
+}
+
+func Test_LedgerEntries(t *testing.T) {
+	amount, _ := models.ParseMoney("40")
+	updated, _ := models.ParseMoney("25")
+
+	original := &models.Transaction{ID: 7, UserID: 1, Account: models.AccountDetails{ID: 2}, Amount: amount, Type: models.EXPENSE}
+	moved := &models.Transaction{ID: 7, UserID: 1, Account: models.AccountDetails{ID: 2}, Amount: updated, Type: models.EXPENSE}
+
+	transactionID := 7
+
+	// Same date: the reversal and the new amount collapse into one entry
+	entries := mergeEntries(append(ledgerEntries(original, "2025-03-01 00:00:00", -1), ledgerEntries(moved, "2025-03-01 00:00:00", 1)...))
+	assert.Equal(t, []*models.BalanceLedgerEntry{{UserID: 1, AccountID: 2, TransactionID: &transactionID,
+		Change: amount - updated, EffectiveDate: "2025-03-01 00:00:00"}}, entries)
+
+	// Another date: the effect is reversed on the old date and applied on the new one
+	entries = mergeEntries(append(ledgerEntries(original, "2025-03-01 00:00:00", -1), ledgerEntries(moved, "2025-04-01 00:00:00", 1)...))
+	assert.Len(t, entries, 2)
+	assert.Equal(t, amount, entries[0].Change)
+	assert.Equal(t, -updated, entries[1].Change)
+
+	// An edit that changes nothing leaves no entry
+	assert.Empty(t, mergeEntries(append(ledgerEntries(original, "2025-03-01 00:00:00", -1), ledgerEntries(original, "2025-03-01 00:00:00", 1)...)))
+}
+
+func Test_ValidateStatusChange(t *testing.T) {
+	original := &models.Transaction{ID: 1, Account: models.AccountDetails{ID: 2}, Amount: models.NewMoney(40),
+		Type: models.EXPENSE, Category: "Groceries", TransactionDate: "2025-06-10T00:00:00.000Z", Status: models.CLEARED}
+
+	updated := func(change func(transaction *models.Transaction)) *models.Transaction {
+		transaction := *original
+		transaction.Status = ""
+		transaction.TransactionDate = "2025-06-10 00:00:00"
+		change(&transaction)
+
+		return &transaction
+	}
+
+	tests := []struct {
+		description string
+		status      models.TransactionStatus
+		updated     *models.Transaction
+		expectedErr error
+	}{
+		{"category of a cleared transaction", models.CLEARED,
+			updated(func(transaction *models.Transaction) { transaction.Category = "Dining Out" }), nil},
+		{"amount of a cleared transaction", models.CLEARED,
+			updated(func(transaction *models.Transaction) { transaction.Amount = models.NewMoney(45) }),
+			errors.New("cleared transactions can only change their category, splits and description")},
+		{"date of a reconciled transaction", models.RECONCILED,
+			updated(func(transaction *models.Transaction) { transaction.TransactionDate = "2025-06-11 00:00:00" }),
+			errors.New("reconciled transactions can only change their category, splits and description")},
+		{"account of a reconciled transaction", models.RECONCILED,
+			updated(func(transaction *models.Transaction) { transaction.Account.ID = 3 }),
+			errors.New("reconciled transactions can only change their category, splits and description")},
+		{"amount of an uncleared transaction", models.UNCLEARED,
+			updated(func(transaction *models.Transaction) { transaction.Amount = models.NewMoney(45) }), nil},
+	}
+
+	for i, tc := range tests {
+		transaction := *original
+		transaction.Status = tc.status
+
+		err := validateStatusChange(&transaction, tc.updated)
+
+		assert.Equalf(t, tc.expectedErr, err, "TEST[%d], failed.\n%s", i, tc.description)
+	}
+}
+
+func Test_Import(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	transactionStore := stores.NewMockTransactions(ctrl)
+	accountSvc := services.NewMockAccount(ctrl)
+	categorySvc := services.NewMockCategories(ctrl)
+
+	hdfc := &models.Account{ID: 1, Name: "HDFC", Currency: "INR", Balance: models.NewMoney(1000)}
+	cash := &models.Account{ID: 2, Name: "Cash", Currency: "INR", Balance: models.NewMoney(50)}
+	// An account named after the id of another one
+	named := &models.Account{ID: 3, Name: "1", Currency: "INR", Balance: models.NewMoney(10)}
+
+	categories := []*models.Category{{Name: "Groceries", Type: models.EXPENSE}, {Name: "Salary", Type: models.INCOME}}
+
+	groceries := func(account *models.Account) *models.Transaction {
+		return &models.Transaction{UserID: 1, Account: models.AccountDetails{ID: account.ID, Name: account.Name},
+			Amount: models.NewMoney(40), Currency: "INR", Type: models.EXPENSE, Category: "Groceries",
+			TransactionDate: "2025-06-01"}
+	}
+
+	salary := &models.Transaction{UserID: 1, Account: models.AccountDetails{ID: 2, Name: "Cash"},
+		Amount: models.NewMoney(100), Currency: "INR", Type: models.INCOME, Category: "Salary",
+		TransactionDate: "2025-06-02"}
+
+	bonus := &models.Transaction{UserID: 1, Account: models.AccountDetails{ID: 2, Name: "Cash"},
+		Amount: models.NewMoney(1234.5), Currency: "INR", Type: models.INCOME, Category: "Salary",
+		TransactionDate: "2025-06-02"}
+
+	summary := func(account *models.Account, change float64) models.ImportAccountSummary {
+		return models.ImportAccountSummary{Account: models.AccountDetails{ID: account.ID, Name: account.Name},
+			PreviousBalance: account.Balance, NewBalance: account.Balance + models.NewMoney(change)}
+	}
+
+	tests := []struct {
+		description    string
+		csv            string
+		dryRun         bool
+		expectedOutput *models.ImportResult
+		expectedErr    error
+		execMocks      func(mocks *container.Mocks)
+	}{
+		{"accounts are matched by name and by id", "Date,Amount,Category,Account\n2025-06-01,-40,Groceries,hdfc\n" +
+			"2025-06-02,100,Salary,2\n", true,
+			&models.ImportResult{DryRun: true, TotalRows: 2, Transactions: []*models.Transaction{groceries(hdfc), salary},
+				Accounts: []models.ImportAccountSummary{summary(hdfc, -40), summary(cash, 100)}}, nil, nil},
+		{"an account named after an id is matched by its name", "date,amount,category,account\n" +
+			"2025-06-01,-40,Groceries,1\n", true,
+			&models.ImportResult{DryRun: true, TotalRows: 1, Transactions: []*models.Transaction{groceries(named)},
+				Accounts: []models.ImportAccountSummary{summary(named, -40)}}, nil, nil},
+		{"invalid rows are reported and nothing is imported", "date,amount,category,account\n" +
+			"2025-06-01,-40,Groceries,Savings\n2025-06-01,abc,Groceries,Cash\n01/06/2025,-40,Groceries,Cash\n" +
+			"2025-06-01,-40,Rent,Cash\n2025-06-02,\"1,00\",Salary,Cash\n2025-06-02,100,Salary,Cash\n", false,
+			&models.ImportResult{TotalRows: 6, Transactions: []*models.Transaction{salary},
+				Accounts: []models.ImportAccountSummary{summary(cash, 100)}, Errors: []models.ImportRowError{
+					{Row: 2, Error: `unknown account "Savings"`}, {Row: 3, Error: "invalid amount"},
+					{Row: 4, Error: "invalid date, expected format 2006-01-02"},
+					{Row: 5, Error: "invalid category for EXPENSE type"}, {Row: 6, Error: "invalid amount"}}}, nil, nil},
+		{"thousands separated by commas are parsed", "date,amount,category,account\n" +
+			"2025-06-02,\"1,234.50\",Salary,Cash\n", true,
+			&models.ImportResult{DryRun: true, TotalRows: 1, Transactions: []*models.Transaction{bonus},
+				Accounts: []models.ImportAccountSummary{summary(cash, 1234.5)}}, nil, nil},
+		{"rows are committed in a single transaction", "date,amount,category,account\n2025-06-02,100,Salary,Cash\n",
+			false, &models.ImportResult{Committed: true, TotalRows: 1, Transactions: []*models.Transaction{salary},
+				Accounts: []models.ImportAccountSummary{summary(cash, 100)}}, nil,
+			func(mocks *container.Mocks) {
+				locked := *cash
+
+				mocks.SQL.ExpectBegin()
+				accountSvc.EXPECT().GetByIDForUpdate(gomock.Any(), 2, 1, gomock.Any()).Return(&locked, nil)
+				accountSvc.EXPECT().UpdateWithTx(gomock.Any(), &locked, gomock.Any()).Return(&locked, nil)
+				transactionStore.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				accountSvc.EXPECT().RecordBalanceChanges(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				mocks.SQL.ExpectCommit()
+			}},
+		{"Failure Case: missing column", "date,amount\n2025-06-02,100\n", true, nil,
+			errors.New(`missing column "account"`), nil},
+	}
+
+	for i, tc := range tests {
+		mockContainer, mocks := container.NewMockContainer(t)
+		ctx := &gofr.Context{Context: context.WithValue(context.Background(), "userID", 1), Container: mockContainer}
+
+		if tc.expectedErr == nil {
+			accountSvc.EXPECT().GetAll(ctx, &filters.Account{}).Return([]*models.Account{hdfc, cash, named}, nil)
+			categorySvc.EXPECT().GetAll(ctx, &filters.Category{}).Return(categories, nil)
+		}
+
+		if tc.execMocks != nil {
+			tc.execMocks(mocks)
+		}
+
+		s := New(transactionStore, nil, accountSvc, nil, categorySvc, nil, nil)
+
+		output, err := s.Import(ctx, strings.NewReader(tc.csv), &models.ImportColumnMapping{}, tc.dryRun)
+
+		if output != nil {
+			for _, transaction := range output.Transactions {
+				transaction.CreatedAt = ""
+			}
+		}
+
+		assert.Equalf(t, tc.expectedOutput, output, "TEST[%d], failed.\n%s", i, tc.description)
+		assert.Equalf(t, tc.expectedErr, err, "TEST[%d], failed.\n%s", i, tc.description)
+	}
+}
@@ -9,19 +9,50 @@ import (
 )
 
 type userSvc struct {
-	userStore stores.User
+	userStore   stores.User
+	categorySvc services.Categories
 }
 
-func New(userStore stores.User) services.User {
+func New(userStore stores.User, categorySvc services.Categories) services.User {
 	return &userSvc{
-		userStore: userStore,
+		userStore:   userStore,
+		categorySvc: categorySvc,
 	}
 }
 
 func (s *userSvc) Create(ctx *gofr.Context, user *models.User) (*models.User, error) {
 	user.Status = "ACTIVE"
 
-	err := s.userStore.Create(ctx, user)
+	if user.HomeCurrency == "" {
+		user.HomeCurrency = models.DefaultCurrency
+	}
+
+	err := s.homeCurrency(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := ctx.SQL.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	err = s.userStore.Create(ctx, user, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Every user starts with the built-in categories, seeded once here rather than on every read
+	err = s.categorySvc.SeedDefaults(ctx, user.ID, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
 	if err != nil {
 		return nil, err
 	}
@@ -53,7 +84,12 @@ func (s *userSvc) GetAll(ctx *gofr.Context, f *filters.User) ([]*models.User, er
 }
 
 func (s *userSvc) Update(ctx *gofr.Context, user *models.User) (*models.User, error) {
-	err := s.userStore.Update(ctx, user)
+	err := s.homeCurrency(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.userStore.Update(ctx, user)
 	if err != nil {
 		return nil, err
 	}
@@ -89,12 +125,38 @@ func (s *userSvc) AuthAdaptor(ctx *gofr.Context, claims *models.GoogleClaims) er
 			return err
 		}
 
-		claims.EntityID = newUser.ID
+		claims.EntityID, claims.Role = newUser.ID, models.RoleUser
+
+		return nil
+	}
+
+	claims.EntityID, claims.Role = user[0].ID, user[0].Role
+
+	return nil
+}
+
+// homeCurrency validates the home currency of user, keeping the stored one when it is not set.
+func (s *userSvc) homeCurrency(ctx *gofr.Context, user *models.User) error {
+	if user.HomeCurrency == "" {
+		existing, err := s.userStore.GetByID(ctx, user.ID)
+		if err != nil {
+			return err
+		}
+
+		user.HomeCurrency = models.DefaultCurrency
+		if existing != nil {
+			user.HomeCurrency = existing.HomeCurrency
+		}
 
 		return nil
 	}
 
-	claims.EntityID = user[0].ID
+	currency, err := models.NormalizeCurrency(user.HomeCurrency)
+	if err != nil {
+		return err
+	}
+
+	user.HomeCurrency = currency
 
 	return nil
 }
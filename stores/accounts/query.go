@@ -1,9 +1,20 @@
 package accounts
 
 const (
-	createAccount  = "INSERT INTO accounts (user_id, name, type,balance,status,expense_categories,saving_categories,created_at) VALUES (?, ?, ?, ?,?,?,?,?)"
-	getByIDAccount = "SELECT id,user_id, name, type,balance,status,expense_categories,saving_categories,created_at,deleted_at FROM accounts WHERE id=? AND user_id=?"
-	getAllAccount  = "SELECT id,user_id, name, type,balance,status,expense_categories,saving_categories,created_at,deleted_at FROM accounts"
-	updateAccount  = "UPDATE accounts SET name=?,type=?,balance=?,status=?,expense_categories=?,saving_categories=? WHERE id=? AND user_id=?"
+	createAccount  = "INSERT INTO accounts (user_id, name, type,balance,opening_balance,opening_date,currency,credit_limit,statement_day,payment_due_day,status,expense_categories,saving_categories,created_at) VALUES (?, ?, ?, ?,?,?,?,?,?,?,?,?,?,?)"
+	getByIDAccount = "SELECT id,user_id, name, type,balance,opening_balance,opening_date,currency,credit_limit,statement_day,payment_due_day,status,expense_categories,saving_categories,created_at,deleted_at FROM accounts WHERE id=? AND user_id=?"
+	getAllAccount  = "SELECT id,user_id, name, type,balance,opening_balance,opening_date,currency,credit_limit,statement_day,payment_due_day,status,expense_categories,saving_categories,created_at,deleted_at FROM accounts"
+	updateAccount  = "UPDATE accounts SET name=?,type=?,balance=?,opening_balance=?,opening_date=?,credit_limit=?,statement_day=?,payment_due_day=?,status=?,expense_categories=?,saving_categories=? WHERE id=? AND user_id=?"
 	deleteAccount  = "UPDATE accounts SET status=?,deleted_at=? WHERE id=?"
+
+	// balanceCheck adds up the adjustments made by hand and the effect of every transaction that is not deleted
+	balanceCheck = "SELECT a.id,a.name,a.user_id,a.currency,a.balance,a.opening_balance," +
+		"COALESCE((SELECT SUM(l.`change`) FROM balance_ledger l WHERE l.account_id=a.id AND l.transaction_id IS NULL),0)," +
+		"COALESCE((SELECT SUM(CASE WHEN t.type='INCOME' THEN t.amount ELSE -t.amount END) FROM transactions t " +
+		"WHERE t.account_id=a.id AND t.deleted_at IS NULL),0)+" +
+		"COALESCE((SELECT SUM(COALESCE(t.to_amount,t.amount)) FROM transactions t " +
+		"WHERE t.to_account_id=a.id AND t.type='TRANSFER' AND t.deleted_at IS NULL),0) " +
+		"FROM accounts a WHERE a.deleted_at IS NULL"
+	getAllBalanceChecks = balanceCheck + " ORDER BY a.id"
+	getByIDBalanceCheck = balanceCheck + " AND a.id=?"
 )
@@ -31,8 +31,11 @@ func (s *accountStore) Create(ctx *gofr.Context, account *models.Account) (int,
 		return 0, err
 	}
 
+	creditLimit, statementDay, dueDay := creditCardTerms(account)
+
 	res, err := ctx.SQL.ExecContext(ctx, createAccount, account.UserID, account.Name, account.Type, account.Balance,
-		account.Status, string(expenseCategoriesJSON), string(savingCategoriesJSON), createdAt)
+		account.OpeningBalance, account.OpeningDate, account.Currency, creditLimit, statementDay, dueDay, account.Status,
+		string(expenseCategoriesJSON), string(savingCategoriesJSON), createdAt)
 	if err != nil {
 		return 0, err
 	}
@@ -48,6 +51,10 @@ func (s *accountStore) Create(ctx *gofr.Context, account *models.Account) (int,
 func (s *accountStore) GetByID(ctx *gofr.Context, id, userID int) (*models.Account, error) {
 	var (
 		account               models.Account
+		openingDate           time.Time
+		creditLimit           *models.Money
+		statementDay          sql.NullInt64
+		dueDay                sql.NullInt64
 		createdAt             time.Time
 		deletedAt             sql.NullString
 		expenseCategoriesJSON string
@@ -55,7 +62,8 @@ func (s *accountStore) GetByID(ctx *gofr.Context, id, userID int) (*models.Accou
 	)
 
 	err := ctx.SQL.QueryRowContext(ctx, getByIDAccount, id, userID).Scan(&account.ID, &account.UserID, &account.Name,
-		&account.Type, &account.Balance, &account.Status, &expenseCategoriesJSON, &savingCategoriesJSON, &createdAt, &deletedAt)
+		&account.Type, &account.Balance, &account.OpeningBalance, &openingDate, &account.Currency, &creditLimit,
+		&statementDay, &dueDay, &account.Status, &expenseCategoriesJSON, &savingCategoriesJSON, &createdAt, &deletedAt)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -64,6 +72,8 @@ func (s *accountStore) GetByID(ctx *gofr.Context, id, userID int) (*models.Accou
 		return nil, datasource.ErrorDB{Err: err, Message: "error fetching user by id"}
 	}
 
+	account.OpeningDate = openingDate.Format("2006-01-02")
+	account.CreditCard = creditCard(creditLimit, statementDay, dueDay)
 	account.CreatedAt = createdAt.Format("2006-01-02T15:04:05.000Z")
 
 	if deletedAt.Valid {
@@ -86,6 +96,10 @@ func (s *accountStore) GetByID(ctx *gofr.Context, id, userID int) (*models.Accou
 func (s *accountStore) GetByIDForUpdate(ctx *gofr.Context, id, userID int, tx *datasourceSQL.Tx) (*models.Account, error) {
 	var (
 		account               models.Account
+		openingDate           time.Time
+		creditLimit           *models.Money
+		statementDay          sql.NullInt64
+		dueDay                sql.NullInt64
 		createdAt             time.Time
 		deletedAt             sql.NullString
 		expenseCategoriesJSON string
@@ -93,7 +107,8 @@ func (s *accountStore) GetByIDForUpdate(ctx *gofr.Context, id, userID int, tx *d
 	)
 
 	err := tx.QueryRowContext(ctx, getByIDAccount+" FOR UPDATE;", id, userID).Scan(&account.ID, &account.UserID, &account.Name,
-		&account.Type, &account.Balance, &account.Status, &expenseCategoriesJSON, &savingCategoriesJSON, &createdAt, &deletedAt)
+		&account.Type, &account.Balance, &account.OpeningBalance, &openingDate, &account.Currency, &creditLimit,
+		&statementDay, &dueDay, &account.Status, &expenseCategoriesJSON, &savingCategoriesJSON, &createdAt, &deletedAt)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -102,6 +117,8 @@ func (s *accountStore) GetByIDForUpdate(ctx *gofr.Context, id, userID int, tx *d
 		return nil, datasource.ErrorDB{Err: err, Message: "error fetching user by id"}
 	}
 
+	account.OpeningDate = openingDate.Format("2006-01-02")
+	account.CreditCard = creditCard(creditLimit, statementDay, dueDay)
 	account.CreatedAt = createdAt.Format("2006-01-02T15:04:05.000Z")
 
 	if deletedAt.Valid {
@@ -141,18 +158,25 @@ func (s *accountStore) GetAll(ctx *gofr.Context, f *filters.Account) ([]*models.
 	for rows.Next() {
 		var (
 			account               models.Account
+			openingDate           time.Time
+			creditLimit           *models.Money
+			statementDay          sql.NullInt64
+			dueDay                sql.NullInt64
 			createdAt             time.Time
 			deletedAt             sql.NullString
 			expenseCategoriesJSON string
 			savingCategoriesJSON  string
 		)
 
-		err = rows.Scan(&account.ID, &account.UserID, &account.Name, &account.Type, &account.Balance, &account.Status,
-			&expenseCategoriesJSON, &savingCategoriesJSON, &createdAt, &deletedAt)
+		err = rows.Scan(&account.ID, &account.UserID, &account.Name, &account.Type, &account.Balance, &account.OpeningBalance,
+			&openingDate, &account.Currency, &creditLimit, &statementDay, &dueDay, &account.Status, &expenseCategoriesJSON,
+			&savingCategoriesJSON, &createdAt, &deletedAt)
 		if err != nil {
 			return nil, err
 		}
 
+		account.OpeningDate = openingDate.Format("2006-01-02")
+		account.CreditCard = creditCard(creditLimit, statementDay, dueDay)
 		account.CreatedAt = createdAt.Format("2006-01-02T15:04:05.000Z")
 
 		if deletedAt.Valid {
@@ -186,8 +210,11 @@ func (s *accountStore) Update(ctx *gofr.Context, account *models.Account, tx *da
 		return err
 	}
 
-	result, err := tx.ExecContext(ctx, updateAccount, account.Name, account.Type, account.Balance, account.Status,
-		string(expenseCategoriesJSON), string(savingCategoriesJSON), account.ID, account.UserID)
+	creditLimit, statementDay, dueDay := creditCardTerms(account)
+
+	result, err := tx.ExecContext(ctx, updateAccount, account.Name, account.Type, account.Balance, account.OpeningBalance,
+		account.OpeningDate, creditLimit, statementDay, dueDay, account.Status, string(expenseCategoriesJSON),
+		string(savingCategoriesJSON), account.ID, account.UserID)
 	if err != nil {
 		return err
 	}
@@ -210,3 +237,64 @@ func (s *accountStore) Delete(ctx *gofr.Context, id int) error {
 
 	return nil
 }
+
+func (s *accountStore) GetBalanceChecks(ctx *gofr.Context) ([]*models.BalanceCheck, error) {
+	var allChecks []*models.BalanceCheck
+
+	rows, err := ctx.SQL.QueryContext(ctx, getAllBalanceChecks)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		_ = rows.Close()
+		_ = rows.Err()
+	}()
+
+	for rows.Next() {
+		var check models.BalanceCheck
+
+		err = rows.Scan(&check.Account.ID, &check.Account.Name, &check.UserID, &check.Currency, &check.Balance,
+			&check.OpeningBalance, &check.Adjustments, &check.Transactions)
+		if err != nil {
+			return nil, err
+		}
+
+		allChecks = append(allChecks, &check)
+	}
+
+	return allChecks, nil
+}
+
+func (s *accountStore) GetBalanceCheckWithTx(ctx *gofr.Context, id int, tx *datasourceSQL.Tx) (*models.BalanceCheck, error) {
+	var check models.BalanceCheck
+
+	err := tx.QueryRowContext(ctx, getByIDBalanceCheck, id).Scan(&check.Account.ID, &check.Account.Name, &check.UserID,
+		&check.Currency, &check.Balance, &check.OpeningBalance, &check.Adjustments, &check.Transactions)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+
+		return nil, datasource.ErrorDB{Err: err, Message: "error fetching balance check by id"}
+	}
+
+	return &check, nil
+}
+
+// creditCardTerms returns the credit card columns of an account, all nil for accounts without credit card terms.
+func creditCardTerms(account *models.Account) (limit, statementDay, dueDay interface{}) {
+	if account.CreditCard == nil {
+		return nil, nil, nil
+	}
+
+	return account.CreditCard.Limit, account.CreditCard.StatementDay, account.CreditCard.DueDay
+}
+
+func creditCard(limit *models.Money, statementDay, dueDay sql.NullInt64) *models.CreditCard {
+	if limit == nil {
+		return nil
+	}
+
+	return &models.CreditCard{Limit: *limit, StatementDay: int(statementDay.Int64), DueDay: int(dueDay.Int64)}
+}
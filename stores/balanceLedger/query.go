@@ -0,0 +1,6 @@
+package balanceLedger
+
+const (
+	createEntry = "INSERT INTO balance_ledger (user_id,account_id,transaction_id,`change`,effective_date,created_at) VALUES (?,?,?,?,?,?)"
+	getAllEntry = "SELECT id,user_id,account_id,transaction_id,`change`,effective_date,created_at FROM balance_ledger"
+)
@@ -0,0 +1,80 @@
+package balanceLedger
+
+import (
+	"database/sql"
+	"gofr.dev/pkg/gofr"
+	datasourceSQL "gofr.dev/pkg/gofr/datasource/sql"
+	"moneyManagement/filters"
+	"moneyManagement/models"
+	"moneyManagement/stores"
+	"time"
+)
+
+type balanceLedgerStore struct{}
+
+func New() stores.BalanceLedger {
+	return &balanceLedgerStore{}
+}
+
+func (s *balanceLedgerStore) Create(ctx *gofr.Context, entry *models.BalanceLedgerEntry, tx *datasourceSQL.Tx) error {
+	createdAt := time.Now().UTC().Format("2006-01-02 15:04:05")
+
+	res, err := tx.ExecContext(ctx, createEntry, entry.UserID, entry.AccountID, entry.TransactionID, entry.Change,
+		entry.EffectiveDate, createdAt)
+	if err != nil {
+		return err
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil || id == 0 {
+		return err
+	}
+
+	entry.ID = int(id)
+
+	return nil
+}
+
+func (s *balanceLedgerStore) GetAll(ctx *gofr.Context, f *filters.BalanceLedger) ([]*models.BalanceLedgerEntry, error) {
+	var allEntries []*models.BalanceLedgerEntry
+
+	clause, val := f.WhereClause()
+
+	q := getAllEntry + clause + " ORDER BY effective_date, id"
+
+	rows, err := ctx.SQL.QueryContext(ctx, q, val...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		_ = rows.Close()
+		_ = rows.Err()
+	}()
+
+	for rows.Next() {
+		var (
+			entry         models.BalanceLedgerEntry
+			transactionID sql.NullInt64
+			effectiveDate time.Time
+			createdAt     time.Time
+		)
+
+		err = rows.Scan(&entry.ID, &entry.UserID, &entry.AccountID, &transactionID, &entry.Change, &effectiveDate, &createdAt)
+		if err != nil {
+			return nil, err
+		}
+
+		if transactionID.Valid {
+			id := int(transactionID.Int64)
+			entry.TransactionID = &id
+		}
+
+		entry.EffectiveDate = effectiveDate.Format("2006-01-02T15:04:05.000Z")
+		entry.CreatedAt = createdAt.Format("2006-01-02T15:04:05.000Z")
+
+		allEntries = append(allEntries, &entry)
+	}
+
+	return allEntries, nil
+}
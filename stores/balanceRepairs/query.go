@@ -0,0 +1,5 @@
+package balanceRepairs
+
+const (
+	createRepair = "INSERT INTO balance_repairs (user_id,account_id,previous_balance,balance,created_at) VALUES (?,?,?,?,?)"
+)
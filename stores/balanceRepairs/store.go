@@ -0,0 +1,35 @@
+package balanceRepairs
+
+import (
+	"gofr.dev/pkg/gofr"
+	datasourceSQL "gofr.dev/pkg/gofr/datasource/sql"
+	"moneyManagement/models"
+	"moneyManagement/stores"
+	"time"
+)
+
+type balanceRepairStore struct{}
+
+func New() stores.BalanceRepairs {
+	return &balanceRepairStore{}
+}
+
+func (s *balanceRepairStore) Create(ctx *gofr.Context, repair *models.BalanceRepair, tx *datasourceSQL.Tx) error {
+	createdAt := time.Now().UTC().Format("2006-01-02 15:04:05")
+
+	res, err := tx.ExecContext(ctx, createRepair, repair.UserID, repair.AccountID, repair.PreviousBalance, repair.Balance,
+		createdAt)
+	if err != nil {
+		return err
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil || id == 0 {
+		return err
+	}
+
+	repair.ID = int(id)
+	repair.CreatedAt = createdAt
+
+	return nil
+}
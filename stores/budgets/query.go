@@ -0,0 +1,11 @@
+package budgets
+
+const (
+	createBudget  = "INSERT INTO budgets (user_id,category,month,account_id,amount,rollover,created_at) VALUES (?,?,?,?,?,?,?)"
+	getByIDBudget = "SELECT b.id,b.user_id,b.category,b.month,b.account_id,b.amount,b.rollover,b.created_at,b.deleted_at,a.name FROM budgets as b " +
+		"LEFT JOIN accounts as a ON b.account_id=a.id WHERE b.id=? AND b.user_id=?"
+	getAllBudget = "SELECT b.id,b.user_id,b.category,b.month,b.account_id,b.amount,b.rollover,b.created_at,b.deleted_at,a.name FROM budgets as b " +
+		"LEFT JOIN accounts as a ON b.account_id=a.id"
+	updateBudget = "UPDATE budgets SET category=?,month=?,account_id=?,amount=?,rollover=? WHERE id=? AND user_id=?"
+	deleteBudget = "UPDATE budgets SET deleted_at=? WHERE id=?"
+)
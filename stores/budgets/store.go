@@ -0,0 +1,139 @@
+package budgets
+
+import (
+	"database/sql"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/datasource"
+	"moneyManagement/filters"
+	"moneyManagement/models"
+	"moneyManagement/stores"
+	"time"
+)
+
+type budgetStore struct{}
+
+func New() stores.Budgets {
+	return &budgetStore{}
+}
+
+func (s *budgetStore) Create(ctx *gofr.Context, budget *models.Budget) error {
+	createdAt := time.Now().UTC().Format("2006-01-02 15:04:05")
+
+	res, err := ctx.SQL.ExecContext(ctx, createBudget, budget.UserID, budget.Category, budget.Month, accountID(budget),
+		budget.Amount, budget.Rollover, createdAt)
+	if err != nil {
+		return err
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil || id == 0 {
+		return err
+	}
+
+	budget.ID = int(id)
+
+	return nil
+}
+
+func (s *budgetStore) GetByID(ctx *gofr.Context, id, userID int) (*models.Budget, error) {
+	budget, err := scanBudget(ctx.SQL.QueryRowContext(ctx, getByIDBudget, id, userID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+
+		return nil, datasource.ErrorDB{Err: err, Message: "error fetching budget by id"}
+	}
+
+	return budget, nil
+}
+
+func (s *budgetStore) GetAll(ctx *gofr.Context, f *filters.Budget) ([]*models.Budget, error) {
+	var allBudgets []*models.Budget
+
+	clause, val := f.WhereClause()
+
+	q := getAllBudget + clause + " ORDER BY b.category, b.id"
+
+	rows, err := ctx.SQL.QueryContext(ctx, q, val...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		_ = rows.Close()
+		_ = rows.Err()
+	}()
+
+	for rows.Next() {
+		budget, err := scanBudget(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		allBudgets = append(allBudgets, budget)
+	}
+
+	return allBudgets, nil
+}
+
+func (s *budgetStore) Update(ctx *gofr.Context, budget *models.Budget) error {
+	_, err := ctx.SQL.ExecContext(ctx, updateBudget, budget.Category, budget.Month, accountID(budget), budget.Amount,
+		budget.Rollover, budget.ID, budget.UserID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *budgetStore) Delete(ctx *gofr.Context, id int) error {
+	deletedAt := time.Now().UTC().Format("2006-01-02 15:04:05")
+
+	_, err := ctx.SQL.ExecContext(ctx, deleteBudget, deletedAt, id)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanBudget(row scanner) (*models.Budget, error) {
+	var (
+		budget      models.Budget
+		accountID   sql.NullInt64
+		accountName sql.NullString
+		createdAt   time.Time
+		deletedAt   sql.NullString
+	)
+
+	err := row.Scan(&budget.ID, &budget.UserID, &budget.Category, &budget.Month, &accountID, &budget.Amount, &budget.Rollover,
+		&createdAt, &deletedAt, &accountName)
+	if err != nil {
+		return nil, err
+	}
+
+	if accountID.Valid {
+		budget.Account = &models.AccountDetails{ID: int(accountID.Int64), Name: accountName.String}
+	}
+
+	budget.CreatedAt = createdAt.Format("2006-01-02T15:04:05.000Z")
+
+	if deletedAt.Valid {
+		budget.DeletedAt = deletedAt.String
+	}
+
+	return &budget, nil
+}
+
+func accountID(budget *models.Budget) interface{} {
+	if budget.Account == nil || budget.Account.ID == 0 {
+		return nil
+	}
+
+	return budget.Account.ID
+}
@@ -0,0 +1,10 @@
+package calendarFeeds
+
+const (
+	createFeed  = "INSERT INTO calendar_feeds (user_id,name,token_hash,created_at) VALUES (?,?,?,?)"
+	getAllFeeds = "SELECT id,user_id,name,created_at,revoked_at FROM calendar_feeds WHERE user_id=? " +
+		"ORDER BY id"
+	getFeedByToken = "SELECT id,user_id,name,created_at,revoked_at FROM calendar_feeds WHERE token_hash=? " +
+		"AND revoked_at IS NULL"
+	revokeFeed = "UPDATE calendar_feeds SET revoked_at=? WHERE id=? AND user_id=? AND revoked_at IS NULL"
+)
@@ -0,0 +1,117 @@
+package calendarFeeds
+
+import (
+	"database/sql"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/datasource"
+	"moneyManagement/models"
+	"moneyManagement/stores"
+	"time"
+)
+
+type calendarFeedStore struct{}
+
+func New() stores.CalendarFeeds {
+	return &calendarFeedStore{}
+}
+
+func (s *calendarFeedStore) Create(ctx *gofr.Context, feed *models.CalendarFeed, tokenHash string) error {
+	createdAt := time.Now().UTC()
+
+	res, err := ctx.SQL.ExecContext(ctx, createFeed, feed.UserID, feed.Name, tokenHash,
+		createdAt.Format("2006-01-02 15:04:05"))
+	if err != nil {
+		return err
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil || id == 0 {
+		return err
+	}
+
+	feed.ID = int(id)
+	feed.CreatedAt = createdAt.Format("2006-01-02T15:04:05.000Z")
+
+	return nil
+}
+
+func (s *calendarFeedStore) GetAll(ctx *gofr.Context, userID int) ([]*models.CalendarFeed, error) {
+	var feeds []*models.CalendarFeed
+
+	rows, err := ctx.SQL.QueryContext(ctx, getAllFeeds, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		_ = rows.Close()
+		_ = rows.Err()
+	}()
+
+	for rows.Next() {
+		feed, err := scanFeed(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		feeds = append(feeds, feed)
+	}
+
+	return feeds, nil
+}
+
+// GetByTokenHash returns the feed a token that is not revoked belongs to, or nil when there is none.
+func (s *calendarFeedStore) GetByTokenHash(ctx *gofr.Context, tokenHash string) (*models.CalendarFeed, error) {
+	feed, err := scanFeed(ctx.SQL.QueryRowContext(ctx, getFeedByToken, tokenHash))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+
+		return nil, datasource.ErrorDB{Err: err, Message: "error fetching calendar feed"}
+	}
+
+	return feed, nil
+}
+
+// Revoke revokes a feed of the user, reporting whether there was one to revoke.
+func (s *calendarFeedStore) Revoke(ctx *gofr.Context, id, userID int) (bool, error) {
+	revokedAt := time.Now().UTC().Format("2006-01-02 15:04:05")
+
+	res, err := ctx.SQL.ExecContext(ctx, revokeFeed, revokedAt, id, userID)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanFeed(row scanner) (*models.CalendarFeed, error) {
+	var (
+		feed      models.CalendarFeed
+		createdAt time.Time
+		revokedAt sql.NullTime
+	)
+
+	err := row.Scan(&feed.ID, &feed.UserID, &feed.Name, &createdAt, &revokedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	feed.CreatedAt = createdAt.Format("2006-01-02T15:04:05.000Z")
+
+	if revokedAt.Valid {
+		feed.RevokedAt = revokedAt.Time.Format("2006-01-02T15:04:05.000Z")
+	}
+
+	return &feed, nil
+}
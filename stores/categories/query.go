@@ -0,0 +1,26 @@
+package categories
+
+const (
+	createCategory  = "INSERT INTO categories (user_id,parent_id,name,type,icon,color,archived,created_at) VALUES (?,?,?,?,?,?,?,?)"
+	getByIDCategory = "SELECT id,user_id,parent_id,name,type,icon,color,archived,created_at,deleted_at FROM categories WHERE id=? AND user_id=?"
+	getAllCategory  = "SELECT id,user_id,parent_id,name,type,icon,color,archived,created_at,deleted_at FROM categories"
+	updateCategory  = "UPDATE categories SET parent_id=?,name=?,icon=?,color=?,archived=? WHERE id=? AND user_id=?"
+	deleteCategory  = "UPDATE categories SET deleted_at=? WHERE id=?"
+
+	// seedCategory leaves a category the user already has alone, the unique key on the active names finds it
+	seedCategory = "INSERT IGNORE INTO categories (user_id,name,type,color,created_at) VALUES (?,?,?,?,?)"
+
+	reparentCategories = "UPDATE categories SET parent_id=? WHERE user_id=? AND parent_id=?"
+
+	repointTransactions = "UPDATE transactions SET category=? WHERE user_id=? AND type=? AND category=?"
+	repointSplits       = "UPDATE transaction_splits as s INNER JOIN transactions as t ON s.transaction_id=t.id " +
+		"SET s.category=? WHERE t.user_id=? AND t.type=? AND s.category=?"
+	repointRecurringTransactions = "UPDATE recurring_transactions SET category=? WHERE user_id=? AND type=? AND category=?"
+	repointSavings               = "UPDATE savings SET type=? WHERE user_id=? AND type=?"
+	// mergeBudgets adds the amount of a budget to the budget of the category it moves to for the same month and
+	// account, when there is one, and deletes it
+	mergeBudgets = "UPDATE budgets as t INNER JOIN budgets as b ON b.user_id=t.user_id AND b.month=t.month " +
+		"AND b.account_id<=>t.account_id SET t.amount=t.amount+b.amount, b.deleted_at=? " +
+		"WHERE t.user_id=? AND t.category=? AND b.category=? AND t.deleted_at IS NULL AND b.deleted_at IS NULL"
+	repointBudgets = "UPDATE budgets SET category=? WHERE user_id=? AND category=?"
+)
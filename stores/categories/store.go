@@ -0,0 +1,212 @@
+package categories
+
+import (
+	"database/sql"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/datasource"
+	datasourceSQL "gofr.dev/pkg/gofr/datasource/sql"
+	"moneyManagement/filters"
+	"moneyManagement/models"
+	"moneyManagement/stores"
+	"time"
+)
+
+type categoryStore struct{}
+
+func New() stores.Categories {
+	return &categoryStore{}
+}
+
+func (s *categoryStore) Create(ctx *gofr.Context, category *models.Category) error {
+	createdAt := time.Now().UTC().Format("2006-01-02 15:04:05")
+
+	res, err := ctx.SQL.ExecContext(ctx, createCategory, category.UserID, category.ParentID, category.Name, category.Type,
+		category.Icon, category.Color, category.Archived, createdAt)
+	if err != nil {
+		return err
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil || id == 0 {
+		return err
+	}
+
+	category.ID = int(id)
+
+	return nil
+}
+
+// Seed creates the categories a user does not have yet, so that seeding twice leaves them as they are.
+func (s *categoryStore) Seed(ctx *gofr.Context, categories []*models.Category, tx *datasourceSQL.Tx) error {
+	createdAt := time.Now().UTC().Format("2006-01-02 15:04:05")
+
+	for _, category := range categories {
+		_, err := tx.ExecContext(ctx, seedCategory, category.UserID, category.Name, category.Type, category.Color, createdAt)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *categoryStore) GetByID(ctx *gofr.Context, id, userID int) (*models.Category, error) {
+	var (
+		category  models.Category
+		parentID  sql.NullInt64
+		icon      sql.NullString
+		color     sql.NullString
+		createdAt time.Time
+		deletedAt sql.NullString
+	)
+
+	err := ctx.SQL.QueryRowContext(ctx, getByIDCategory, id, userID).Scan(&category.ID, &category.UserID, &parentID, &category.Name,
+		&category.Type, &icon, &color, &category.Archived, &createdAt, &deletedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+
+		return nil, datasource.ErrorDB{Err: err, Message: "error fetching category by id"}
+	}
+
+	if parentID.Valid {
+		parent := int(parentID.Int64)
+		category.ParentID = &parent
+	}
+
+	category.Icon = icon.String
+	category.Color = color.String
+	category.CreatedAt = createdAt.Format("2006-01-02T15:04:05.000Z")
+
+	if deletedAt.Valid {
+		category.DeletedAt = deletedAt.String
+	}
+
+	return &category, nil
+}
+
+func (s *categoryStore) GetAll(ctx *gofr.Context, f *filters.Category) ([]*models.Category, error) {
+	var allCategories []*models.Category
+
+	clause, val := f.WhereClause()
+
+	q := getAllCategory + clause + " ORDER BY type, name"
+
+	rows, err := ctx.SQL.QueryContext(ctx, q, val...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		_ = rows.Close()
+		_ = rows.Err()
+	}()
+
+	for rows.Next() {
+		var (
+			category  models.Category
+			parentID  sql.NullInt64
+			icon      sql.NullString
+			color     sql.NullString
+			createdAt time.Time
+			deletedAt sql.NullString
+		)
+
+		err = rows.Scan(&category.ID, &category.UserID, &parentID, &category.Name, &category.Type, &icon, &color,
+			&category.Archived, &createdAt, &deletedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		if parentID.Valid {
+			parent := int(parentID.Int64)
+			category.ParentID = &parent
+		}
+
+		category.Icon = icon.String
+		category.Color = color.String
+		category.CreatedAt = createdAt.Format("2006-01-02T15:04:05.000Z")
+
+		if deletedAt.Valid {
+			category.DeletedAt = deletedAt.String
+		}
+
+		allCategories = append(allCategories, &category)
+	}
+
+	return allCategories, nil
+}
+
+func (s *categoryStore) Update(ctx *gofr.Context, category *models.Category, tx *datasourceSQL.Tx) error {
+	_, err := tx.ExecContext(ctx, updateCategory, category.ParentID, category.Name, category.Icon, category.Color, category.Archived,
+		category.ID, category.UserID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *categoryStore) Delete(ctx *gofr.Context, id int, tx *datasourceSQL.Tx) error {
+	deletedAt := time.Now().UTC().Format("2006-01-02 15:04:05")
+
+	_, err := tx.ExecContext(ctx, deleteCategory, deletedAt, id)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Reparent moves the children of a category under another parent, or to the top level when parentID is nil.
+func (s *categoryStore) Reparent(ctx *gofr.Context, userID, fromID int, parentID *int, tx *datasourceSQL.Tx) error {
+	_, err := tx.ExecContext(ctx, reparentCategories, parentID, userID, fromID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Repoint moves every transaction, split, recurring transaction, budget and savings record of the user from one
+// category name to another.
+func (s *categoryStore) Repoint(ctx *gofr.Context, userID int, categoryType models.Type, from, to string, tx *datasourceSQL.Tx) error {
+	deletedAt := time.Now().UTC().Format("2006-01-02 15:04:05")
+
+	for _, update := range repoints(userID, categoryType, from, to, deletedAt) {
+		_, err := tx.ExecContext(ctx, update.query, update.args...)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type repoint struct {
+	query string
+	args  []interface{}
+}
+
+// repoints returns the updates moving the records of a category type from one category name to another, in order.
+func repoints(userID int, categoryType models.Type, from, to, deletedAt string) []repoint {
+	updates := []repoint{
+		{repointTransactions, []interface{}{to, userID, categoryType, from}},
+		{repointSplits, []interface{}{to, userID, categoryType, from}},
+		{repointRecurringTransactions, []interface{}{to, userID, categoryType, from}},
+	}
+
+	switch categoryType {
+	case models.EXPENSE:
+		// Budgets are only set on EXPENSE categories. When merging, a budget the target already has for the same
+		// month and account takes the amount of the merged one, as two budgets of a category cannot overlap.
+		updates = append(updates, repoint{mergeBudgets, []interface{}{deletedAt, userID, to, from}},
+			repoint{repointBudgets, []interface{}{to, userID, from}})
+	case models.SAVINGS:
+		// Savings records carry their SAVINGS category as type
+		updates = append(updates, repoint{repointSavings, []interface{}{to, userID, from}})
+	}
+
+	return updates
+}
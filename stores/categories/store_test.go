@@ -0,0 +1,44 @@
+package categories
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"moneyManagement/models"
+)
+
+func Test_Repoints(t *testing.T) {
+	const deletedAt = "2025-05-10 08:00:00"
+
+	transactions := []repoint{
+		{repointTransactions, []interface{}{"Groceries", 1, models.EXPENSE, "Food"}},
+		{repointSplits, []interface{}{"Groceries", 1, models.EXPENSE, "Food"}},
+		{repointRecurringTransactions, []interface{}{"Groceries", 1, models.EXPENSE, "Food"}},
+	}
+
+	tests := []struct {
+		description  string
+		categoryType models.Type
+		expected     []repoint
+	}{
+		{"expense categories move their budgets, merging overlapping ones", models.EXPENSE, append(transactions,
+			repoint{mergeBudgets, []interface{}{deletedAt, 1, "Groceries", "Food"}},
+			repoint{repointBudgets, []interface{}{"Groceries", 1, "Food"}})},
+		{"savings categories move the savings records", models.SAVINGS, []repoint{
+			{repointTransactions, []interface{}{"Groceries", 1, models.SAVINGS, "Food"}},
+			{repointSplits, []interface{}{"Groceries", 1, models.SAVINGS, "Food"}},
+			{repointRecurringTransactions, []interface{}{"Groceries", 1, models.SAVINGS, "Food"}},
+			{repointSavings, []interface{}{"Groceries", 1, "Food"}}}},
+		{"income categories only move transactions", models.INCOME, []repoint{
+			{repointTransactions, []interface{}{"Groceries", 1, models.INCOME, "Food"}},
+			{repointSplits, []interface{}{"Groceries", 1, models.INCOME, "Food"}},
+			{repointRecurringTransactions, []interface{}{"Groceries", 1, models.INCOME, "Food"}}}},
+	}
+
+	for i, tc := range tests {
+		output := repoints(1, tc.categoryType, "Food", "Groceries", deletedAt)
+
+		assert.Equalf(t, tc.expected, output, "TEST[%d], failed.\n%s", i, tc.description)
+	}
+}
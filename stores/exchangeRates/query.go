@@ -0,0 +1,18 @@
+package exchangeRates
+
+const (
+	// Loading a rate that already exists for the pair and date replaces it
+	upsertExchangeRate = "INSERT INTO exchange_rates (from_currency,to_currency,rate,rate_date,created_at) VALUES (?,?,?,?,?) " +
+		"ON DUPLICATE KEY UPDATE rate=VALUES(rate)"
+	getAllExchangeRate = "SELECT id,from_currency,to_currency,rate,rate_date,created_at FROM exchange_rates"
+
+	// userCurrencies are the currencies of the accounts of a user and its home currency
+	userCurrencies = "(SELECT currency FROM accounts WHERE user_id=? UNION SELECT ?)"
+	// getInEffect selects the rates between the currencies of a user dated in a range and, for each pair, the latest
+	// one dated on or before its start, which is in effect at the start of the range
+	getInEffect = "SELECT r.id,r.from_currency,r.to_currency,r.rate,r.rate_date,r.created_at FROM exchange_rates AS r " +
+		"WHERE r.from_currency IN " + userCurrencies + " AND r.to_currency IN " + userCurrencies + " AND r.rate_date<=? " +
+		"AND r.rate_date>=COALESCE((SELECT MAX(p.rate_date) FROM exchange_rates AS p WHERE " +
+		"p.from_currency=r.from_currency AND p.to_currency=r.to_currency AND p.rate_date<=?),?) " +
+		"ORDER BY r.from_currency, r.to_currency, r.rate_date"
+)
@@ -0,0 +1,75 @@
+package exchangeRates
+
+import (
+	"gofr.dev/pkg/gofr"
+	datasourceSQL "gofr.dev/pkg/gofr/datasource/sql"
+	"moneyManagement/filters"
+	"moneyManagement/models"
+	"moneyManagement/stores"
+	"time"
+)
+
+type exchangeRateStore struct{}
+
+func New() stores.ExchangeRates {
+	return &exchangeRateStore{}
+}
+
+func (s *exchangeRateStore) Upsert(ctx *gofr.Context, rate *models.ExchangeRate, tx *datasourceSQL.Tx) error {
+	createdAt := time.Now().UTC().Format("2006-01-02 15:04:05")
+
+	_, err := tx.ExecContext(ctx, upsertExchangeRate, rate.From, rate.To, rate.Rate, rate.Date, createdAt)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *exchangeRateStore) GetAll(ctx *gofr.Context, f *filters.ExchangeRate) ([]*models.ExchangeRate, error) {
+	clause, val := f.WhereClause()
+
+	q := getAllExchangeRate + clause + " ORDER BY from_currency, to_currency, rate_date"
+
+	return s.getAll(ctx, q, val...)
+}
+
+// GetInEffect returns the rates between the currencies of the accounts of a user and homeCurrency that are in effect
+// on some day from from to to, both dates in the YYYY-MM-DD layout.
+func (s *exchangeRateStore) GetInEffect(ctx *gofr.Context, userID int, homeCurrency, from, to string) ([]*models.ExchangeRate, error) {
+	return s.getAll(ctx, getInEffect, userID, homeCurrency, userID, homeCurrency, to, from, from)
+}
+
+func (s *exchangeRateStore) getAll(ctx *gofr.Context, query string, val ...interface{}) ([]*models.ExchangeRate, error) {
+	var allRates []*models.ExchangeRate
+
+	rows, err := ctx.SQL.QueryContext(ctx, query, val...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		_ = rows.Close()
+		_ = rows.Err()
+	}()
+
+	for rows.Next() {
+		var (
+			rate      models.ExchangeRate
+			rateDate  time.Time
+			createdAt time.Time
+		)
+
+		err = rows.Scan(&rate.ID, &rate.From, &rate.To, &rate.Rate, &rateDate, &createdAt)
+		if err != nil {
+			return nil, err
+		}
+
+		rate.Date = rateDate.Format("2006-01-02")
+		rate.CreatedAt = createdAt.Format("2006-01-02T15:04:05.000Z")
+
+		allRates = append(allRates, &rate)
+	}
+
+	return allRates, nil
+}
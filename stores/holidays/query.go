@@ -0,0 +1,7 @@
+package holidays
+
+const (
+	createHoliday  = "INSERT INTO holidays (user_id,date,name,created_at) VALUES (?,?,?,?)"
+	getAllHolidays = "SELECT id,user_id,date,name,created_at FROM holidays WHERE user_id=? ORDER BY date"
+	deleteHoliday  = "DELETE FROM holidays WHERE id=? AND user_id=?"
+)
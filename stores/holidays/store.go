@@ -0,0 +1,83 @@
+package holidays
+
+import (
+	"gofr.dev/pkg/gofr"
+	"moneyManagement/models"
+	"moneyManagement/stores"
+	"time"
+)
+
+type holidayStore struct{}
+
+func New() stores.Holidays {
+	return &holidayStore{}
+}
+
+func (s *holidayStore) Create(ctx *gofr.Context, holiday *models.Holiday) error {
+	createdAt := time.Now().UTC()
+
+	res, err := ctx.SQL.ExecContext(ctx, createHoliday, holiday.UserID, holiday.Date, holiday.Name,
+		createdAt.Format("2006-01-02 15:04:05"))
+	if err != nil {
+		return err
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil || id == 0 {
+		return err
+	}
+
+	holiday.ID = int(id)
+	holiday.CreatedAt = createdAt.Format("2006-01-02T15:04:05.000Z")
+
+	return nil
+}
+
+func (s *holidayStore) GetAll(ctx *gofr.Context, userID int) ([]*models.Holiday, error) {
+	var holidays []*models.Holiday
+
+	rows, err := ctx.SQL.QueryContext(ctx, getAllHolidays, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		_ = rows.Close()
+		_ = rows.Err()
+	}()
+
+	for rows.Next() {
+		var (
+			holiday   models.Holiday
+			date      time.Time
+			createdAt time.Time
+		)
+
+		err = rows.Scan(&holiday.ID, &holiday.UserID, &date, &holiday.Name, &createdAt)
+		if err != nil {
+			return nil, err
+		}
+
+		holiday.Date = date.Format("2006-01-02")
+		holiday.CreatedAt = createdAt.Format("2006-01-02T15:04:05.000Z")
+
+		holidays = append(holidays, &holiday)
+	}
+
+	return holidays, nil
+}
+
+// Delete removes a holiday of the user, reporting whether there was one to remove.
+func (s *holidayStore) Delete(ctx *gofr.Context, id, userID int) (bool, error) {
+	res, err := ctx.SQL.ExecContext(ctx, deleteHoliday, id, userID)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
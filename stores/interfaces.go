@@ -8,7 +8,7 @@ import (
 )
 
 type User interface {
-	Create(ctx *gofr.Context, user *models.User) error
+	Create(ctx *gofr.Context, user *models.User, tx *sql.Tx) error
 	GetByID(ctx *gofr.Context, id int) (*models.User, error)
 	GetAll(ctx *gofr.Context, f *filters.User) ([]*models.User, error)
 	Update(ctx *gofr.Context, user *models.User) error
@@ -22,14 +22,25 @@ type Account interface {
 	Update(ctx *gofr.Context, account *models.Account, tx *sql.Tx) error
 	Delete(ctx *gofr.Context, id int) error
 	GetByIDForUpdate(ctx *gofr.Context, id, userID int, tx *sql.Tx) (*models.Account, error)
+	GetBalanceChecks(ctx *gofr.Context) ([]*models.BalanceCheck, error)
+	GetBalanceCheckWithTx(ctx *gofr.Context, id int, tx *sql.Tx) (*models.BalanceCheck, error)
 }
 
 type Transactions interface {
 	Create(ctx *gofr.Context, transaction *models.Transaction, tx *sql.Tx) error
 	GetAll(ctx *gofr.Context, f *filters.Transactions) ([]*models.Transaction, error)
 	GetByID(ctx *gofr.Context, id, userID int) (*models.Transaction, error)
+	GetByIDForUpdate(ctx *gofr.Context, id, userID int, tx *sql.Tx) (*models.Transaction, error)
 	Update(ctx *gofr.Context, transaction *models.Transaction, tx *sql.Tx) error
 	Delete(ctx *gofr.Context, id int, tx *sql.Tx) error
+	UpdateStatus(ctx *gofr.Context, ids []int, userID, accountID int, status models.TransactionStatus, tx *sql.Tx) error
+	GetBucketTotals(ctx *gofr.Context, f *filters.Transactions, interval string) ([]*models.BucketTotal, error)
+}
+
+type TransactionSplits interface {
+	Create(ctx *gofr.Context, split *models.TransactionSplit, tx *sql.Tx) error
+	GetByTransactionIDs(ctx *gofr.Context, transactionIDs []int) (map[int][]models.TransactionSplit, error)
+	DeleteByTransactionID(ctx *gofr.Context, transactionID int, tx *sql.Tx) error
 }
 
 type Savings interface {
@@ -40,6 +51,13 @@ type Savings interface {
 	Delete(ctx *gofr.Context, id int) error
 	UpdateWIthTransactionID(ctx *gofr.Context, savings *models.Savings, tx *sql.Tx) error
 	GetByTransactionID(ctx *gofr.Context, id int) (*models.Savings, error)
+	GetOpen(ctx *gofr.Context, userID int) ([]*models.Savings, error)
+	GetHistory(ctx *gofr.Context, userID int) ([]*models.Savings, error)
+}
+
+type SavingsValuations interface {
+	Create(ctx *gofr.Context, valuation *models.SavingsValuation, tx *sql.Tx) error
+	GetAll(ctx *gofr.Context, userID int) ([]*models.SavingsValuation, error)
 }
 
 type SavingsSource interface {
@@ -53,6 +71,71 @@ type RecurringTransactions interface {
 	Create(ctx *gofr.Context, recurringTransaction *models.RecurringTransaction) error
 	GetAll(ctx *gofr.Context, f *filters.RecurringTransactions) ([]*models.RecurringTransaction, error)
 	GetByID(ctx *gofr.Context, id, userID int) (*models.RecurringTransaction, error)
-	Update(ctx *gofr.Context, recurringTransaction *models.RecurringTransaction) error
+	Update(ctx *gofr.Context, recurringTransaction *models.RecurringTransaction, tx *sql.Tx) error
+	Delete(ctx *gofr.Context, id int) error
+	GetDue(ctx *gofr.Context, runAt string) ([]*models.RecurringTransaction, error)
+	GetByIDForUpdate(ctx *gofr.Context, id int, tx *sql.Tx) (*models.RecurringTransaction, error)
+	UpdateRun(ctx *gofr.Context, id int, lastRun, nextRun interface{}, tx *sql.Tx) error
+	UpdatePause(ctx *gofr.Context, id, userID int, pausedAt, pausedUntil interface{}) (bool, error)
+}
+
+type RecurringExceptions interface {
+	Upsert(ctx *gofr.Context, exception *models.RecurringException) error
+	GetAll(ctx *gofr.Context, userID int) ([]*models.RecurringException, error)
+	GetByOccurrence(ctx *gofr.Context, recurringTransactionID int, occurrence string, tx *sql.Tx) (*models.RecurringException, error)
+	Delete(ctx *gofr.Context, id, recurringTransactionID, userID int) (bool, error)
+}
+
+type Categories interface {
+	Create(ctx *gofr.Context, category *models.Category) error
+	Seed(ctx *gofr.Context, categories []*models.Category, tx *sql.Tx) error
+	GetByID(ctx *gofr.Context, id, userID int) (*models.Category, error)
+	GetAll(ctx *gofr.Context, f *filters.Category) ([]*models.Category, error)
+	Update(ctx *gofr.Context, category *models.Category, tx *sql.Tx) error
+	Delete(ctx *gofr.Context, id int, tx *sql.Tx) error
+	Reparent(ctx *gofr.Context, userID, fromID int, parentID *int, tx *sql.Tx) error
+	Repoint(ctx *gofr.Context, userID int, categoryType models.Type, from, to string, tx *sql.Tx) error
+}
+
+type Budgets interface {
+	Create(ctx *gofr.Context, budget *models.Budget) error
+	GetByID(ctx *gofr.Context, id, userID int) (*models.Budget, error)
+	GetAll(ctx *gofr.Context, f *filters.Budget) ([]*models.Budget, error)
+	Update(ctx *gofr.Context, budget *models.Budget) error
 	Delete(ctx *gofr.Context, id int) error
 }
+
+type ExchangeRates interface {
+	Upsert(ctx *gofr.Context, rate *models.ExchangeRate, tx *sql.Tx) error
+	GetAll(ctx *gofr.Context, f *filters.ExchangeRate) ([]*models.ExchangeRate, error)
+	GetInEffect(ctx *gofr.Context, userID int, homeCurrency, from, to string) ([]*models.ExchangeRate, error)
+}
+
+type BalanceLedger interface {
+	Create(ctx *gofr.Context, entry *models.BalanceLedgerEntry, tx *sql.Tx) error
+	GetAll(ctx *gofr.Context, f *filters.BalanceLedger) ([]*models.BalanceLedgerEntry, error)
+}
+
+type BalanceRepairs interface {
+	Create(ctx *gofr.Context, repair *models.BalanceRepair, tx *sql.Tx) error
+}
+
+type Reconciliations interface {
+	Create(ctx *gofr.Context, reconciliation *models.Reconciliation) error
+	GetByID(ctx *gofr.Context, id, userID int) (*models.Reconciliation, error)
+	GetAll(ctx *gofr.Context, f *filters.Reconciliation) ([]*models.Reconciliation, error)
+	Lock(ctx *gofr.Context, reconciliation *models.Reconciliation, tx *sql.Tx) error
+}
+
+type CalendarFeeds interface {
+	Create(ctx *gofr.Context, feed *models.CalendarFeed, tokenHash string) error
+	GetAll(ctx *gofr.Context, userID int) ([]*models.CalendarFeed, error)
+	GetByTokenHash(ctx *gofr.Context, tokenHash string) (*models.CalendarFeed, error)
+	Revoke(ctx *gofr.Context, id, userID int) (bool, error)
+}
+
+type Holidays interface {
+	Create(ctx *gofr.Context, holiday *models.Holiday) error
+	GetAll(ctx *gofr.Context, userID int) ([]*models.Holiday, error)
+	Delete(ctx *gofr.Context, id, userID int) (bool, error)
+}
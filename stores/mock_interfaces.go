@@ -43,17 +43,17 @@ func (m *MockUser) EXPECT() *MockUserMockRecorder {
 }
 
 // Create mocks base method.
-func (m *MockUser) Create(ctx *gofr.Context, user *models.User) error {
+func (m *MockUser) Create(ctx *gofr.Context, user *models.User, tx *sql.Tx) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", ctx, user)
+	ret := m.ctrl.Call(m, "Create", ctx, user, tx)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockUserMockRecorder) Create(ctx, user any) *gomock.Call {
+func (mr *MockUserMockRecorder) Create(ctx, user, tx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockUser)(nil).Create), ctx, user)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockUser)(nil).Create), ctx, user, tx)
 }
 
 // Delete mocks base method.
@@ -181,6 +181,36 @@ func (mr *MockAccountMockRecorder) GetAll(ctx, f any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockAccount)(nil).GetAll), ctx, f)
 }
 
+// GetBalanceCheckWithTx mocks base method.
+func (m *MockAccount) GetBalanceCheckWithTx(ctx *gofr.Context, id int, tx *sql.Tx) (*models.BalanceCheck, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBalanceCheckWithTx", ctx, id, tx)
+	ret0, _ := ret[0].(*models.BalanceCheck)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBalanceCheckWithTx indicates an expected call of GetBalanceCheckWithTx.
+func (mr *MockAccountMockRecorder) GetBalanceCheckWithTx(ctx, id, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalanceCheckWithTx", reflect.TypeOf((*MockAccount)(nil).GetBalanceCheckWithTx), ctx, id, tx)
+}
+
+// GetBalanceChecks mocks base method.
+func (m *MockAccount) GetBalanceChecks(ctx *gofr.Context) ([]*models.BalanceCheck, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBalanceChecks", ctx)
+	ret0, _ := ret[0].([]*models.BalanceCheck)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBalanceChecks indicates an expected call of GetBalanceChecks.
+func (mr *MockAccountMockRecorder) GetBalanceChecks(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalanceChecks", reflect.TypeOf((*MockAccount)(nil).GetBalanceChecks), ctx)
+}
+
 // GetByID mocks base method.
 func (m *MockAccount) GetByID(ctx *gofr.Context, id, userID int) (*models.Account, error) {
 	m.ctrl.T.Helper()
@@ -291,6 +321,21 @@ func (mr *MockTransactionsMockRecorder) GetAll(ctx, f any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockTransactions)(nil).GetAll), ctx, f)
 }
 
+// GetBucketTotals mocks base method.
+func (m *MockTransactions) GetBucketTotals(ctx *gofr.Context, f *filters.Transactions, interval string) ([]*models.BucketTotal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBucketTotals", ctx, f, interval)
+	ret0, _ := ret[0].([]*models.BucketTotal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBucketTotals indicates an expected call of GetBucketTotals.
+func (mr *MockTransactionsMockRecorder) GetBucketTotals(ctx, f, interval any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBucketTotals", reflect.TypeOf((*MockTransactions)(nil).GetBucketTotals), ctx, f, interval)
+}
+
 // GetByID mocks base method.
 func (m *MockTransactions) GetByID(ctx *gofr.Context, id, userID int) (*models.Transaction, error) {
 	m.ctrl.T.Helper()
@@ -306,6 +351,21 @@ func (mr *MockTransactionsMockRecorder) GetByID(ctx, id, userID any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockTransactions)(nil).GetByID), ctx, id, userID)
 }
 
+// GetByIDForUpdate mocks base method.
+func (m *MockTransactions) GetByIDForUpdate(ctx *gofr.Context, id, userID int, tx *sql.Tx) (*models.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIDForUpdate", ctx, id, userID, tx)
+	ret0, _ := ret[0].(*models.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIDForUpdate indicates an expected call of GetByIDForUpdate.
+func (mr *MockTransactionsMockRecorder) GetByIDForUpdate(ctx, id, userID, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDForUpdate", reflect.TypeOf((*MockTransactions)(nil).GetByIDForUpdate), ctx, id, userID, tx)
+}
+
 // Update mocks base method.
 func (m *MockTransactions) Update(ctx *gofr.Context, transaction *models.Transaction, tx *sql.Tx) error {
 	m.ctrl.T.Helper()
@@ -320,6 +380,86 @@ func (mr *MockTransactionsMockRecorder) Update(ctx, transaction, tx any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTransactions)(nil).Update), ctx, transaction, tx)
 }
 
+// UpdateStatus mocks base method.
+func (m *MockTransactions) UpdateStatus(ctx *gofr.Context, ids []int, userID, accountID int, status models.TransactionStatus, tx *sql.Tx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", ctx, ids, userID, accountID, status, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockTransactionsMockRecorder) UpdateStatus(ctx, ids, userID, accountID, status, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockTransactions)(nil).UpdateStatus), ctx, ids, userID, accountID, status, tx)
+}
+
+// MockTransactionSplits is a mock of TransactionSplits interface.
+type MockTransactionSplits struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransactionSplitsMockRecorder
+}
+
+// MockTransactionSplitsMockRecorder is the mock recorder for MockTransactionSplits.
+type MockTransactionSplitsMockRecorder struct {
+	mock *MockTransactionSplits
+}
+
+// NewMockTransactionSplits creates a new mock instance.
+func NewMockTransactionSplits(ctrl *gomock.Controller) *MockTransactionSplits {
+	mock := &MockTransactionSplits{ctrl: ctrl}
+	mock.recorder = &MockTransactionSplitsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransactionSplits) EXPECT() *MockTransactionSplitsMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockTransactionSplits) Create(ctx *gofr.Context, split *models.TransactionSplit, tx *sql.Tx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, split, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTransactionSplitsMockRecorder) Create(ctx, split, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTransactionSplits)(nil).Create), ctx, split, tx)
+}
+
+// DeleteByTransactionID mocks base method.
+func (m *MockTransactionSplits) DeleteByTransactionID(ctx *gofr.Context, transactionID int, tx *sql.Tx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByTransactionID", ctx, transactionID, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByTransactionID indicates an expected call of DeleteByTransactionID.
+func (mr *MockTransactionSplitsMockRecorder) DeleteByTransactionID(ctx, transactionID, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByTransactionID", reflect.TypeOf((*MockTransactionSplits)(nil).DeleteByTransactionID), ctx, transactionID, tx)
+}
+
+// GetByTransactionIDs mocks base method.
+func (m *MockTransactionSplits) GetByTransactionIDs(ctx *gofr.Context, transactionIDs []int) (map[int][]models.TransactionSplit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByTransactionIDs", ctx, transactionIDs)
+	ret0, _ := ret[0].(map[int][]models.TransactionSplit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByTransactionIDs indicates an expected call of GetByTransactionIDs.
+func (mr *MockTransactionSplitsMockRecorder) GetByTransactionIDs(ctx, transactionIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByTransactionIDs", reflect.TypeOf((*MockTransactionSplits)(nil).GetByTransactionIDs), ctx, transactionIDs)
+}
+
 // MockSavings is a mock of Savings interface.
 type MockSavings struct {
 	ctrl     *gomock.Controller
@@ -416,6 +556,36 @@ func (mr *MockSavingsMockRecorder) GetByTransactionID(ctx, id any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByTransactionID", reflect.TypeOf((*MockSavings)(nil).GetByTransactionID), ctx, id)
 }
 
+// GetHistory mocks base method.
+func (m *MockSavings) GetHistory(ctx *gofr.Context, userID int) ([]*models.Savings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHistory", ctx, userID)
+	ret0, _ := ret[0].([]*models.Savings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHistory indicates an expected call of GetHistory.
+func (mr *MockSavingsMockRecorder) GetHistory(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistory", reflect.TypeOf((*MockSavings)(nil).GetHistory), ctx, userID)
+}
+
+// GetOpen mocks base method.
+func (m *MockSavings) GetOpen(ctx *gofr.Context, userID int) ([]*models.Savings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOpen", ctx, userID)
+	ret0, _ := ret[0].([]*models.Savings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOpen indicates an expected call of GetOpen.
+func (mr *MockSavingsMockRecorder) GetOpen(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpen", reflect.TypeOf((*MockSavings)(nil).GetOpen), ctx, userID)
+}
+
 // Update mocks base method.
 func (m *MockSavings) Update(ctx *gofr.Context, savings *models.Savings, tx *sql.Tx) error {
 	m.ctrl.T.Helper()
@@ -444,6 +614,58 @@ func (mr *MockSavingsMockRecorder) UpdateWIthTransactionID(ctx, savings, tx any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWIthTransactionID", reflect.TypeOf((*MockSavings)(nil).UpdateWIthTransactionID), ctx, savings, tx)
 }
 
+// MockSavingsValuations is a mock of SavingsValuations interface.
+type MockSavingsValuations struct {
+	ctrl     *gomock.Controller
+	recorder *MockSavingsValuationsMockRecorder
+}
+
+// MockSavingsValuationsMockRecorder is the mock recorder for MockSavingsValuations.
+type MockSavingsValuationsMockRecorder struct {
+	mock *MockSavingsValuations
+}
+
+// NewMockSavingsValuations creates a new mock instance.
+func NewMockSavingsValuations(ctrl *gomock.Controller) *MockSavingsValuations {
+	mock := &MockSavingsValuations{ctrl: ctrl}
+	mock.recorder = &MockSavingsValuationsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSavingsValuations) EXPECT() *MockSavingsValuationsMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockSavingsValuations) Create(ctx *gofr.Context, valuation *models.SavingsValuation, tx *sql.Tx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, valuation, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockSavingsValuationsMockRecorder) Create(ctx, valuation, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSavingsValuations)(nil).Create), ctx, valuation, tx)
+}
+
+// GetAll mocks base method.
+func (m *MockSavingsValuations) GetAll(ctx *gofr.Context, userID int) ([]*models.SavingsValuation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx, userID)
+	ret0, _ := ret[0].([]*models.SavingsValuation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockSavingsValuationsMockRecorder) GetAll(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockSavingsValuations)(nil).GetAll), ctx, userID)
+}
+
 // MockSavingsSource is a mock of SavingsSource interface.
 type MockSavingsSource struct {
 	ctrl     *gomock.Controller
@@ -523,3 +745,857 @@ func (mr *MockSavingsSourceMockRecorder) Update(ctx, savingsSource any) *gomock.
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockSavingsSource)(nil).Update), ctx, savingsSource)
 }
+
+// MockRecurringTransactions is a mock of RecurringTransactions interface.
+type MockRecurringTransactions struct {
+	ctrl     *gomock.Controller
+	recorder *MockRecurringTransactionsMockRecorder
+}
+
+// MockRecurringTransactionsMockRecorder is the mock recorder for MockRecurringTransactions.
+type MockRecurringTransactionsMockRecorder struct {
+	mock *MockRecurringTransactions
+}
+
+// NewMockRecurringTransactions creates a new mock instance.
+func NewMockRecurringTransactions(ctrl *gomock.Controller) *MockRecurringTransactions {
+	mock := &MockRecurringTransactions{ctrl: ctrl}
+	mock.recorder = &MockRecurringTransactionsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRecurringTransactions) EXPECT() *MockRecurringTransactionsMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockRecurringTransactions) Create(ctx *gofr.Context, recurringTransaction *models.RecurringTransaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, recurringTransaction)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRecurringTransactionsMockRecorder) Create(ctx, recurringTransaction any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRecurringTransactions)(nil).Create), ctx, recurringTransaction)
+}
+
+// Delete mocks base method.
+func (m *MockRecurringTransactions) Delete(ctx *gofr.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockRecurringTransactionsMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRecurringTransactions)(nil).Delete), ctx, id)
+}
+
+// GetAll mocks base method.
+func (m *MockRecurringTransactions) GetAll(ctx *gofr.Context, f *filters.RecurringTransactions) ([]*models.RecurringTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx, f)
+	ret0, _ := ret[0].([]*models.RecurringTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockRecurringTransactionsMockRecorder) GetAll(ctx, f any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockRecurringTransactions)(nil).GetAll), ctx, f)
+}
+
+// GetByID mocks base method.
+func (m *MockRecurringTransactions) GetByID(ctx *gofr.Context, id, userID int) (*models.RecurringTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id, userID)
+	ret0, _ := ret[0].(*models.RecurringTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockRecurringTransactionsMockRecorder) GetByID(ctx, id, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockRecurringTransactions)(nil).GetByID), ctx, id, userID)
+}
+
+// GetByIDForUpdate mocks base method.
+func (m *MockRecurringTransactions) GetByIDForUpdate(ctx *gofr.Context, id int, tx *sql.Tx) (*models.RecurringTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIDForUpdate", ctx, id, tx)
+	ret0, _ := ret[0].(*models.RecurringTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIDForUpdate indicates an expected call of GetByIDForUpdate.
+func (mr *MockRecurringTransactionsMockRecorder) GetByIDForUpdate(ctx, id, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDForUpdate", reflect.TypeOf((*MockRecurringTransactions)(nil).GetByIDForUpdate), ctx, id, tx)
+}
+
+// GetDue mocks base method.
+func (m *MockRecurringTransactions) GetDue(ctx *gofr.Context, runAt string) ([]*models.RecurringTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDue", ctx, runAt)
+	ret0, _ := ret[0].([]*models.RecurringTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDue indicates an expected call of GetDue.
+func (mr *MockRecurringTransactionsMockRecorder) GetDue(ctx, runAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDue", reflect.TypeOf((*MockRecurringTransactions)(nil).GetDue), ctx, runAt)
+}
+
+// Update mocks base method.
+func (m *MockRecurringTransactions) Update(ctx *gofr.Context, recurringTransaction *models.RecurringTransaction, tx *sql.Tx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, recurringTransaction, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockRecurringTransactionsMockRecorder) Update(ctx, recurringTransaction, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRecurringTransactions)(nil).Update), ctx, recurringTransaction, tx)
+}
+
+// UpdatePause mocks base method.
+func (m *MockRecurringTransactions) UpdatePause(ctx *gofr.Context, id, userID int, pausedAt, pausedUntil any) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePause", ctx, id, userID, pausedAt, pausedUntil)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePause indicates an expected call of UpdatePause.
+func (mr *MockRecurringTransactionsMockRecorder) UpdatePause(ctx, id, userID, pausedAt, pausedUntil any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePause", reflect.TypeOf((*MockRecurringTransactions)(nil).UpdatePause), ctx, id, userID, pausedAt, pausedUntil)
+}
+
+// UpdateRun mocks base method.
+func (m *MockRecurringTransactions) UpdateRun(ctx *gofr.Context, id int, lastRun, nextRun any, tx *sql.Tx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRun", ctx, id, lastRun, nextRun, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRun indicates an expected call of UpdateRun.
+func (mr *MockRecurringTransactionsMockRecorder) UpdateRun(ctx, id, lastRun, nextRun, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRun", reflect.TypeOf((*MockRecurringTransactions)(nil).UpdateRun), ctx, id, lastRun, nextRun, tx)
+}
+
+// MockRecurringExceptions is a mock of RecurringExceptions interface.
+type MockRecurringExceptions struct {
+	ctrl     *gomock.Controller
+	recorder *MockRecurringExceptionsMockRecorder
+}
+
+// MockRecurringExceptionsMockRecorder is the mock recorder for MockRecurringExceptions.
+type MockRecurringExceptionsMockRecorder struct {
+	mock *MockRecurringExceptions
+}
+
+// NewMockRecurringExceptions creates a new mock instance.
+func NewMockRecurringExceptions(ctrl *gomock.Controller) *MockRecurringExceptions {
+	mock := &MockRecurringExceptions{ctrl: ctrl}
+	mock.recorder = &MockRecurringExceptionsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRecurringExceptions) EXPECT() *MockRecurringExceptionsMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockRecurringExceptions) Delete(ctx *gofr.Context, id, recurringTransactionID, userID int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id, recurringTransactionID, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockRecurringExceptionsMockRecorder) Delete(ctx, id, recurringTransactionID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRecurringExceptions)(nil).Delete), ctx, id, recurringTransactionID, userID)
+}
+
+// GetAll mocks base method.
+func (m *MockRecurringExceptions) GetAll(ctx *gofr.Context, userID int) ([]*models.RecurringException, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx, userID)
+	ret0, _ := ret[0].([]*models.RecurringException)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockRecurringExceptionsMockRecorder) GetAll(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockRecurringExceptions)(nil).GetAll), ctx, userID)
+}
+
+// GetByOccurrence mocks base method.
+func (m *MockRecurringExceptions) GetByOccurrence(ctx *gofr.Context, recurringTransactionID int, occurrence string, tx *sql.Tx) (*models.RecurringException, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByOccurrence", ctx, recurringTransactionID, occurrence, tx)
+	ret0, _ := ret[0].(*models.RecurringException)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByOccurrence indicates an expected call of GetByOccurrence.
+func (mr *MockRecurringExceptionsMockRecorder) GetByOccurrence(ctx, recurringTransactionID, occurrence, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByOccurrence", reflect.TypeOf((*MockRecurringExceptions)(nil).GetByOccurrence), ctx, recurringTransactionID, occurrence, tx)
+}
+
+// Upsert mocks base method.
+func (m *MockRecurringExceptions) Upsert(ctx *gofr.Context, exception *models.RecurringException) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", ctx, exception)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockRecurringExceptionsMockRecorder) Upsert(ctx, exception any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockRecurringExceptions)(nil).Upsert), ctx, exception)
+}
+
+// MockCategories is a mock of Categories interface.
+type MockCategories struct {
+	ctrl     *gomock.Controller
+	recorder *MockCategoriesMockRecorder
+}
+
+// MockCategoriesMockRecorder is the mock recorder for MockCategories.
+type MockCategoriesMockRecorder struct {
+	mock *MockCategories
+}
+
+// NewMockCategories creates a new mock instance.
+func NewMockCategories(ctrl *gomock.Controller) *MockCategories {
+	mock := &MockCategories{ctrl: ctrl}
+	mock.recorder = &MockCategoriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCategories) EXPECT() *MockCategoriesMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockCategories) Create(ctx *gofr.Context, category *models.Category) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, category)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCategoriesMockRecorder) Create(ctx, category any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCategories)(nil).Create), ctx, category)
+}
+
+// Delete mocks base method.
+func (m *MockCategories) Delete(ctx *gofr.Context, id int, tx *sql.Tx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockCategoriesMockRecorder) Delete(ctx, id, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCategories)(nil).Delete), ctx, id, tx)
+}
+
+// GetAll mocks base method.
+func (m *MockCategories) GetAll(ctx *gofr.Context, f *filters.Category) ([]*models.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx, f)
+	ret0, _ := ret[0].([]*models.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockCategoriesMockRecorder) GetAll(ctx, f any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockCategories)(nil).GetAll), ctx, f)
+}
+
+// GetByID mocks base method.
+func (m *MockCategories) GetByID(ctx *gofr.Context, id, userID int) (*models.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id, userID)
+	ret0, _ := ret[0].(*models.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockCategoriesMockRecorder) GetByID(ctx, id, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockCategories)(nil).GetByID), ctx, id, userID)
+}
+
+// Reparent mocks base method.
+func (m *MockCategories) Reparent(ctx *gofr.Context, userID, fromID int, parentID *int, tx *sql.Tx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reparent", ctx, userID, fromID, parentID, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reparent indicates an expected call of Reparent.
+func (mr *MockCategoriesMockRecorder) Reparent(ctx, userID, fromID, parentID, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reparent", reflect.TypeOf((*MockCategories)(nil).Reparent), ctx, userID, fromID, parentID, tx)
+}
+
+// Repoint mocks base method.
+func (m *MockCategories) Repoint(ctx *gofr.Context, userID int, categoryType models.Type, from, to string, tx *sql.Tx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Repoint", ctx, userID, categoryType, from, to, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Repoint indicates an expected call of Repoint.
+func (mr *MockCategoriesMockRecorder) Repoint(ctx, userID, categoryType, from, to, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Repoint", reflect.TypeOf((*MockCategories)(nil).Repoint), ctx, userID, categoryType, from, to, tx)
+}
+
+// Seed mocks base method.
+func (m *MockCategories) Seed(ctx *gofr.Context, categories []*models.Category, tx *sql.Tx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Seed", ctx, categories, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Seed indicates an expected call of Seed.
+func (mr *MockCategoriesMockRecorder) Seed(ctx, categories, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Seed", reflect.TypeOf((*MockCategories)(nil).Seed), ctx, categories, tx)
+}
+
+// Update mocks base method.
+func (m *MockCategories) Update(ctx *gofr.Context, category *models.Category, tx *sql.Tx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, category, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockCategoriesMockRecorder) Update(ctx, category, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockCategories)(nil).Update), ctx, category, tx)
+}
+
+// MockBudgets is a mock of Budgets interface.
+type MockBudgets struct {
+	ctrl     *gomock.Controller
+	recorder *MockBudgetsMockRecorder
+}
+
+// MockBudgetsMockRecorder is the mock recorder for MockBudgets.
+type MockBudgetsMockRecorder struct {
+	mock *MockBudgets
+}
+
+// NewMockBudgets creates a new mock instance.
+func NewMockBudgets(ctrl *gomock.Controller) *MockBudgets {
+	mock := &MockBudgets{ctrl: ctrl}
+	mock.recorder = &MockBudgetsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBudgets) EXPECT() *MockBudgetsMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockBudgets) Create(ctx *gofr.Context, budget *models.Budget) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, budget)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockBudgetsMockRecorder) Create(ctx, budget any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockBudgets)(nil).Create), ctx, budget)
+}
+
+// Delete mocks base method.
+func (m *MockBudgets) Delete(ctx *gofr.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockBudgetsMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockBudgets)(nil).Delete), ctx, id)
+}
+
+// GetAll mocks base method.
+func (m *MockBudgets) GetAll(ctx *gofr.Context, f *filters.Budget) ([]*models.Budget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx, f)
+	ret0, _ := ret[0].([]*models.Budget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockBudgetsMockRecorder) GetAll(ctx, f any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockBudgets)(nil).GetAll), ctx, f)
+}
+
+// GetByID mocks base method.
+func (m *MockBudgets) GetByID(ctx *gofr.Context, id, userID int) (*models.Budget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id, userID)
+	ret0, _ := ret[0].(*models.Budget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockBudgetsMockRecorder) GetByID(ctx, id, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockBudgets)(nil).GetByID), ctx, id, userID)
+}
+
+// Update mocks base method.
+func (m *MockBudgets) Update(ctx *gofr.Context, budget *models.Budget) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, budget)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockBudgetsMockRecorder) Update(ctx, budget any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockBudgets)(nil).Update), ctx, budget)
+}
+
+// MockExchangeRates is a mock of ExchangeRates interface.
+type MockExchangeRates struct {
+	ctrl     *gomock.Controller
+	recorder *MockExchangeRatesMockRecorder
+}
+
+// MockExchangeRatesMockRecorder is the mock recorder for MockExchangeRates.
+type MockExchangeRatesMockRecorder struct {
+	mock *MockExchangeRates
+}
+
+// NewMockExchangeRates creates a new mock instance.
+func NewMockExchangeRates(ctrl *gomock.Controller) *MockExchangeRates {
+	mock := &MockExchangeRates{ctrl: ctrl}
+	mock.recorder = &MockExchangeRatesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExchangeRates) EXPECT() *MockExchangeRatesMockRecorder {
+	return m.recorder
+}
+
+// GetAll mocks base method.
+func (m *MockExchangeRates) GetAll(ctx *gofr.Context, f *filters.ExchangeRate) ([]*models.ExchangeRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx, f)
+	ret0, _ := ret[0].([]*models.ExchangeRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockExchangeRatesMockRecorder) GetAll(ctx, f any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockExchangeRates)(nil).GetAll), ctx, f)
+}
+
+// GetInEffect mocks base method.
+func (m *MockExchangeRates) GetInEffect(ctx *gofr.Context, userID int, homeCurrency, from, to string) ([]*models.ExchangeRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInEffect", ctx, userID, homeCurrency, from, to)
+	ret0, _ := ret[0].([]*models.ExchangeRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInEffect indicates an expected call of GetInEffect.
+func (mr *MockExchangeRatesMockRecorder) GetInEffect(ctx, userID, homeCurrency, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInEffect", reflect.TypeOf((*MockExchangeRates)(nil).GetInEffect), ctx, userID, homeCurrency, from, to)
+}
+
+// Upsert mocks base method.
+func (m *MockExchangeRates) Upsert(ctx *gofr.Context, rate *models.ExchangeRate, tx *sql.Tx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", ctx, rate, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockExchangeRatesMockRecorder) Upsert(ctx, rate, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockExchangeRates)(nil).Upsert), ctx, rate, tx)
+}
+
+// MockBalanceLedger is a mock of BalanceLedger interface.
+type MockBalanceLedger struct {
+	ctrl     *gomock.Controller
+	recorder *MockBalanceLedgerMockRecorder
+}
+
+// MockBalanceLedgerMockRecorder is the mock recorder for MockBalanceLedger.
+type MockBalanceLedgerMockRecorder struct {
+	mock *MockBalanceLedger
+}
+
+// NewMockBalanceLedger creates a new mock instance.
+func NewMockBalanceLedger(ctrl *gomock.Controller) *MockBalanceLedger {
+	mock := &MockBalanceLedger{ctrl: ctrl}
+	mock.recorder = &MockBalanceLedgerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBalanceLedger) EXPECT() *MockBalanceLedgerMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockBalanceLedger) Create(ctx *gofr.Context, entry *models.BalanceLedgerEntry, tx *sql.Tx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, entry, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockBalanceLedgerMockRecorder) Create(ctx, entry, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockBalanceLedger)(nil).Create), ctx, entry, tx)
+}
+
+// GetAll mocks base method.
+func (m *MockBalanceLedger) GetAll(ctx *gofr.Context, f *filters.BalanceLedger) ([]*models.BalanceLedgerEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx, f)
+	ret0, _ := ret[0].([]*models.BalanceLedgerEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockBalanceLedgerMockRecorder) GetAll(ctx, f any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockBalanceLedger)(nil).GetAll), ctx, f)
+}
+
+// MockBalanceRepairs is a mock of BalanceRepairs interface.
+type MockBalanceRepairs struct {
+	ctrl     *gomock.Controller
+	recorder *MockBalanceRepairsMockRecorder
+}
+
+// MockBalanceRepairsMockRecorder is the mock recorder for MockBalanceRepairs.
+type MockBalanceRepairsMockRecorder struct {
+	mock *MockBalanceRepairs
+}
+
+// NewMockBalanceRepairs creates a new mock instance.
+func NewMockBalanceRepairs(ctrl *gomock.Controller) *MockBalanceRepairs {
+	mock := &MockBalanceRepairs{ctrl: ctrl}
+	mock.recorder = &MockBalanceRepairsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBalanceRepairs) EXPECT() *MockBalanceRepairsMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockBalanceRepairs) Create(ctx *gofr.Context, repair *models.BalanceRepair, tx *sql.Tx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, repair, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockBalanceRepairsMockRecorder) Create(ctx, repair, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockBalanceRepairs)(nil).Create), ctx, repair, tx)
+}
+
+// MockReconciliations is a mock of Reconciliations interface.
+type MockReconciliations struct {
+	ctrl     *gomock.Controller
+	recorder *MockReconciliationsMockRecorder
+}
+
+// MockReconciliationsMockRecorder is the mock recorder for MockReconciliations.
+type MockReconciliationsMockRecorder struct {
+	mock *MockReconciliations
+}
+
+// NewMockReconciliations creates a new mock instance.
+func NewMockReconciliations(ctrl *gomock.Controller) *MockReconciliations {
+	mock := &MockReconciliations{ctrl: ctrl}
+	mock.recorder = &MockReconciliationsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReconciliations) EXPECT() *MockReconciliationsMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockReconciliations) Create(ctx *gofr.Context, reconciliation *models.Reconciliation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, reconciliation)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockReconciliationsMockRecorder) Create(ctx, reconciliation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockReconciliations)(nil).Create), ctx, reconciliation)
+}
+
+// GetAll mocks base method.
+func (m *MockReconciliations) GetAll(ctx *gofr.Context, f *filters.Reconciliation) ([]*models.Reconciliation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx, f)
+	ret0, _ := ret[0].([]*models.Reconciliation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockReconciliationsMockRecorder) GetAll(ctx, f any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockReconciliations)(nil).GetAll), ctx, f)
+}
+
+// GetByID mocks base method.
+func (m *MockReconciliations) GetByID(ctx *gofr.Context, id, userID int) (*models.Reconciliation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id, userID)
+	ret0, _ := ret[0].(*models.Reconciliation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockReconciliationsMockRecorder) GetByID(ctx, id, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockReconciliations)(nil).GetByID), ctx, id, userID)
+}
+
+// Lock mocks base method.
+func (m *MockReconciliations) Lock(ctx *gofr.Context, reconciliation *models.Reconciliation, tx *sql.Tx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Lock", ctx, reconciliation, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Lock indicates an expected call of Lock.
+func (mr *MockReconciliationsMockRecorder) Lock(ctx, reconciliation, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lock", reflect.TypeOf((*MockReconciliations)(nil).Lock), ctx, reconciliation, tx)
+}
+
+// MockCalendarFeeds is a mock of CalendarFeeds interface.
+type MockCalendarFeeds struct {
+	ctrl     *gomock.Controller
+	recorder *MockCalendarFeedsMockRecorder
+}
+
+// MockCalendarFeedsMockRecorder is the mock recorder for MockCalendarFeeds.
+type MockCalendarFeedsMockRecorder struct {
+	mock *MockCalendarFeeds
+}
+
+// NewMockCalendarFeeds creates a new mock instance.
+func NewMockCalendarFeeds(ctrl *gomock.Controller) *MockCalendarFeeds {
+	mock := &MockCalendarFeeds{ctrl: ctrl}
+	mock.recorder = &MockCalendarFeedsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCalendarFeeds) EXPECT() *MockCalendarFeedsMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockCalendarFeeds) Create(ctx *gofr.Context, feed *models.CalendarFeed, tokenHash string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, feed, tokenHash)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCalendarFeedsMockRecorder) Create(ctx, feed, tokenHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCalendarFeeds)(nil).Create), ctx, feed, tokenHash)
+}
+
+// GetAll mocks base method.
+func (m *MockCalendarFeeds) GetAll(ctx *gofr.Context, userID int) ([]*models.CalendarFeed, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx, userID)
+	ret0, _ := ret[0].([]*models.CalendarFeed)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockCalendarFeedsMockRecorder) GetAll(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockCalendarFeeds)(nil).GetAll), ctx, userID)
+}
+
+// GetByTokenHash mocks base method.
+func (m *MockCalendarFeeds) GetByTokenHash(ctx *gofr.Context, tokenHash string) (*models.CalendarFeed, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByTokenHash", ctx, tokenHash)
+	ret0, _ := ret[0].(*models.CalendarFeed)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByTokenHash indicates an expected call of GetByTokenHash.
+func (mr *MockCalendarFeedsMockRecorder) GetByTokenHash(ctx, tokenHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByTokenHash", reflect.TypeOf((*MockCalendarFeeds)(nil).GetByTokenHash), ctx, tokenHash)
+}
+
+// Revoke mocks base method.
+func (m *MockCalendarFeeds) Revoke(ctx *gofr.Context, id, userID int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", ctx, id, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockCalendarFeedsMockRecorder) Revoke(ctx, id, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockCalendarFeeds)(nil).Revoke), ctx, id, userID)
+}
+
+// MockHolidays is a mock of Holidays interface.
+type MockHolidays struct {
+	ctrl     *gomock.Controller
+	recorder *MockHolidaysMockRecorder
+}
+
+// MockHolidaysMockRecorder is the mock recorder for MockHolidays.
+type MockHolidaysMockRecorder struct {
+	mock *MockHolidays
+}
+
+// NewMockHolidays creates a new mock instance.
+func NewMockHolidays(ctrl *gomock.Controller) *MockHolidays {
+	mock := &MockHolidays{ctrl: ctrl}
+	mock.recorder = &MockHolidaysMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHolidays) EXPECT() *MockHolidaysMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockHolidays) Create(ctx *gofr.Context, holiday *models.Holiday) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, holiday)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockHolidaysMockRecorder) Create(ctx, holiday any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockHolidays)(nil).Create), ctx, holiday)
+}
+
+// Delete mocks base method.
+func (m *MockHolidays) Delete(ctx *gofr.Context, id, userID int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockHolidaysMockRecorder) Delete(ctx, id, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockHolidays)(nil).Delete), ctx, id, userID)
+}
+
+// GetAll mocks base method.
+func (m *MockHolidays) GetAll(ctx *gofr.Context, userID int) ([]*models.Holiday, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx, userID)
+	ret0, _ := ret[0].([]*models.Holiday)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockHolidaysMockRecorder) GetAll(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockHolidays)(nil).GetAll), ctx, userID)
+}
@@ -0,0 +1,10 @@
+package reconciliations
+
+const (
+	createReconciliation  = "INSERT INTO reconciliations (user_id,account_id,statement_date,statement_balance,status,created_at) VALUES (?,?,?,?,?,?)"
+	getByIDReconciliation = "SELECT r.id,r.user_id,r.account_id,a.name,r.statement_date,r.statement_balance,r.cleared_balance,r.status," +
+		"r.created_at,r.locked_at FROM reconciliations as r INNER JOIN accounts as a ON r.account_id=a.id WHERE r.id=? AND r.user_id=?"
+	getAllReconciliation = "SELECT r.id,r.user_id,r.account_id,a.name,r.statement_date,r.statement_balance,r.cleared_balance,r.status," +
+		"r.created_at,r.locked_at FROM reconciliations as r INNER JOIN accounts as a ON r.account_id=a.id"
+	lockReconciliation = "UPDATE reconciliations SET status=?,cleared_balance=?,locked_at=? WHERE id=? AND user_id=?"
+)
@@ -0,0 +1,120 @@
+package reconciliations
+
+import (
+	"database/sql"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/datasource"
+	datasourceSQL "gofr.dev/pkg/gofr/datasource/sql"
+	"moneyManagement/filters"
+	"moneyManagement/models"
+	"moneyManagement/stores"
+	"time"
+)
+
+type reconciliationStore struct{}
+
+func New() stores.Reconciliations {
+	return &reconciliationStore{}
+}
+
+func (s *reconciliationStore) Create(ctx *gofr.Context, reconciliation *models.Reconciliation) error {
+	createdAt := time.Now().UTC().Format("2006-01-02 15:04:05")
+
+	res, err := ctx.SQL.ExecContext(ctx, createReconciliation, reconciliation.UserID, reconciliation.Account.ID,
+		reconciliation.StatementDate, reconciliation.StatementBalance, reconciliation.Status, createdAt)
+	if err != nil {
+		return err
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil || id == 0 {
+		return err
+	}
+
+	reconciliation.ID = int(id)
+
+	return nil
+}
+
+func (s *reconciliationStore) GetByID(ctx *gofr.Context, id, userID int) (*models.Reconciliation, error) {
+	reconciliation, err := scanReconciliation(ctx.SQL.QueryRowContext(ctx, getByIDReconciliation, id, userID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+
+		return nil, datasource.ErrorDB{Err: err, Message: "error fetching reconciliation by id"}
+	}
+
+	return reconciliation, nil
+}
+
+func (s *reconciliationStore) GetAll(ctx *gofr.Context, f *filters.Reconciliation) ([]*models.Reconciliation, error) {
+	var allReconciliations []*models.Reconciliation
+
+	clause, val := f.WhereClause()
+
+	q := getAllReconciliation + clause + " ORDER BY r.statement_date, r.id"
+
+	rows, err := ctx.SQL.QueryContext(ctx, q, val...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		_ = rows.Close()
+		_ = rows.Err()
+	}()
+
+	for rows.Next() {
+		reconciliation, err := scanReconciliation(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		allReconciliations = append(allReconciliations, reconciliation)
+	}
+
+	return allReconciliations, nil
+}
+
+func (s *reconciliationStore) Lock(ctx *gofr.Context, reconciliation *models.Reconciliation, tx *datasourceSQL.Tx) error {
+	lockedAt := time.Now().UTC().Format("2006-01-02 15:04:05")
+
+	_, err := tx.ExecContext(ctx, lockReconciliation, models.LOCKED, reconciliation.ClearedBalance, lockedAt,
+		reconciliation.ID, reconciliation.UserID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanReconciliation(row scanner) (*models.Reconciliation, error) {
+	var (
+		reconciliation models.Reconciliation
+		statementDate  time.Time
+		createdAt      time.Time
+		lockedAt       sql.NullTime
+	)
+
+	err := row.Scan(&reconciliation.ID, &reconciliation.UserID, &reconciliation.Account.ID, &reconciliation.Account.Name,
+		&statementDate, &reconciliation.StatementBalance, &reconciliation.ClearedBalance, &reconciliation.Status, &createdAt,
+		&lockedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	reconciliation.StatementDate = statementDate.Format("2006-01-02")
+	reconciliation.CreatedAt = createdAt.Format("2006-01-02T15:04:05.000Z")
+
+	if lockedAt.Valid {
+		reconciliation.LockedAt = lockedAt.Time.Format("2006-01-02T15:04:05.000Z")
+	}
+
+	return &reconciliation, nil
+}
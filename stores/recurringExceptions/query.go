@@ -0,0 +1,12 @@
+package recurringExceptions
+
+const (
+	upsertException = "INSERT INTO recurring_exceptions (recurring_transaction_id,user_id,occurrence,skip,amount,date,created_at) " +
+		"VALUES (?,?,?,?,?,?,?) ON DUPLICATE KEY UPDATE id=LAST_INSERT_ID(id),skip=VALUES(skip),amount=VALUES(amount)," +
+		"date=VALUES(date)"
+	getAllExceptions = "SELECT id,recurring_transaction_id,user_id,occurrence,skip,amount,date,created_at " +
+		"FROM recurring_exceptions WHERE user_id=? ORDER BY recurring_transaction_id, occurrence"
+	getExceptionByOccurrence = "SELECT id,recurring_transaction_id,user_id,occurrence,skip,amount,date,created_at " +
+		"FROM recurring_exceptions WHERE recurring_transaction_id=? AND occurrence=?"
+	deleteException = "DELETE FROM recurring_exceptions WHERE id=? AND recurring_transaction_id=? AND user_id=?"
+)
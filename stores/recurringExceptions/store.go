@@ -0,0 +1,129 @@
+package recurringExceptions
+
+import (
+	"database/sql"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/datasource"
+	datasourceSQL "gofr.dev/pkg/gofr/datasource/sql"
+	"moneyManagement/models"
+	"moneyManagement/stores"
+	"time"
+)
+
+type recurringExceptionStore struct{}
+
+func New() stores.RecurringExceptions {
+	return &recurringExceptionStore{}
+}
+
+// Upsert records the exception of an occurrence, replacing the one it already has.
+func (s *recurringExceptionStore) Upsert(ctx *gofr.Context, exception *models.RecurringException) error {
+	var date interface{}
+
+	if exception.Date != "" {
+		date = exception.Date
+	}
+
+	createdAt := time.Now().UTC()
+
+	res, err := ctx.SQL.ExecContext(ctx, upsertException, exception.RecurringTransactionID, exception.UserID,
+		exception.Occurrence, exception.Skip, exception.Amount, date, createdAt.Format("2006-01-02 15:04:05"))
+	if err != nil {
+		return err
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil || id == 0 {
+		return err
+	}
+
+	exception.ID = int(id)
+	exception.CreatedAt = createdAt.Format("2006-01-02T15:04:05.000Z")
+
+	return nil
+}
+
+// GetAll returns the exceptions of every recurring transaction of a user.
+func (s *recurringExceptionStore) GetAll(ctx *gofr.Context, userID int) ([]*models.RecurringException, error) {
+	var exceptions []*models.RecurringException
+
+	rows, err := ctx.SQL.QueryContext(ctx, getAllExceptions, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		_ = rows.Close()
+		_ = rows.Err()
+	}()
+
+	for rows.Next() {
+		exception, err := scanException(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		exceptions = append(exceptions, exception)
+	}
+
+	return exceptions, nil
+}
+
+// GetByOccurrence returns the exception of the occurrence of a recurring transaction scheduled at occurrence, nil
+// when it has none.
+func (s *recurringExceptionStore) GetByOccurrence(ctx *gofr.Context, recurringTransactionID int, occurrence string,
+	tx *datasourceSQL.Tx) (*models.RecurringException, error) {
+	exception, err := scanException(tx.QueryRowContext(ctx, getExceptionByOccurrence, recurringTransactionID, occurrence))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+
+		return nil, datasource.ErrorDB{Err: err, Message: "error fetching recurring exception"}
+	}
+
+	return exception, nil
+}
+
+// Delete removes an exception of a recurring transaction of the user, reporting whether there was one.
+func (s *recurringExceptionStore) Delete(ctx *gofr.Context, id, recurringTransactionID, userID int) (bool, error) {
+	res, err := ctx.SQL.ExecContext(ctx, deleteException, id, recurringTransactionID, userID)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanException(row scanner) (*models.RecurringException, error) {
+	var (
+		exception  models.RecurringException
+		occurrence time.Time
+		date       sql.NullTime
+		createdAt  time.Time
+	)
+
+	err := row.Scan(&exception.ID, &exception.RecurringTransactionID, &exception.UserID, &occurrence, &exception.Skip,
+		&exception.Amount, &date, &createdAt)
+	if err != nil {
+		return nil, err
+	}
+
+	exception.Occurrence = occurrence.Format("2006-01-02T15:04:05.000Z")
+	exception.CreatedAt = createdAt.Format("2006-01-02T15:04:05.000Z")
+
+	if date.Valid {
+		exception.Date = date.Time.Format("2006-01-02T15:04:05.000Z")
+	}
+
+	return &exception, nil
+}
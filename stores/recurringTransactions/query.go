@@ -1,9 +1,17 @@
 package recurringTransactions
 
 const (
-	createTransaction   = "INSERT INTO recurring_transactions (user_id, account_id, amount,type,category,description,frequency,custom_days,start_date,end_date,next_run,created_at) VALUES (?, ?, ?, ?,?,?,?,?,?,?,?,?)"
-	getByIDTransactions = "SELECT t.id,t.user_id, t.account_id, t.amount,t.type,t.category,t.description,t.frequency,t.custom_days,t.start_date,t.end_date,t.last_run,t.next_run,t.created_at,t.deleted_at,a.name FROM recurring_transactions as t INNER JOIN accounts as a ON t.account_id=a.id WHERE t.id=? AND t.user_id=?"
-	getAllTransactions  = "SELECT t.id,t.user_id, t.account_id, t.amount,t.type,t.category,t.description,t.frequency,t.custom_days,t.start_date,t.end_date,t.last_run,t.next_run,t.created_at,t.deleted_at,a.name FROM recurring_transactions as t INNER JOIN accounts as a ON t.account_id=a.id"
-	updateTransaction   = "UPDATE recurring_transactions SET account_id=?, amount=?,type=?,category=?,description=?,frequency=?,custom_days=?,start_date=?,end_date=?,last_run=?,next_run=? WHERE id=?"
+	columns = "t.id,t.user_id,t.account_id,t.amount,t.type,t.category,t.description,t.frequency,t.custom_days," +
+		"t.interval_count,t.month_days,t.weekday,t.weekday_ordinal,t.shift,t.requires_approval,t.start_date,t.end_date,t.last_run," +
+		"t.next_run,t.paused_at,t.paused_until,t.created_at,t.deleted_at"
+
+	createTransaction   = "INSERT INTO recurring_transactions (user_id, account_id, amount,type,category,description,frequency,custom_days,interval_count,month_days,weekday,weekday_ordinal,shift,requires_approval,start_date,end_date,next_run,created_at) VALUES (?, ?, ?, ?,?,?,?,?,?,?,?,?,?,?,?,?,?,?)"
+	getByIDTransactions = "SELECT " + columns + ",a.name FROM recurring_transactions as t INNER JOIN accounts as a ON t.account_id=a.id WHERE t.id=? AND t.user_id=?"
+	getAllTransactions  = "SELECT " + columns + ",a.name FROM recurring_transactions as t INNER JOIN accounts as a ON t.account_id=a.id"
+	updateTransaction   = "UPDATE recurring_transactions SET account_id=?, amount=?,type=?,category=?,description=?,frequency=?,custom_days=?,interval_count=?,month_days=?,weekday=?,weekday_ordinal=?,shift=?,requires_approval=?,start_date=?,end_date=?,last_run=?,next_run=? WHERE id=?"
 	deleteTransaction   = "UPDATE recurring_transactions SET deleted_at=? WHERE id=?"
+	getDueTransactions  = getAllTransactions + " WHERE t.deleted_at IS NULL AND t.next_run IS NOT NULL AND (t.next_run<=? OR EXISTS (SELECT 1 FROM recurring_exceptions as e WHERE e.recurring_transaction_id=t.id AND e.occurrence=t.next_run AND e.date<=?)) AND (t.end_date IS NULL OR t.next_run<=t.end_date) ORDER BY t.next_run"
+	getByIDForUpdate    = "SELECT " + columns + ",'' FROM recurring_transactions as t WHERE t.id=? FOR UPDATE"
+	updateRun           = "UPDATE recurring_transactions SET last_run=COALESCE(?,last_run),next_run=? WHERE id=?"
+	updatePause         = "UPDATE recurring_transactions SET paused_at=?,paused_until=? WHERE id=? AND user_id=? AND deleted_at IS NULL"
 )
@@ -4,9 +4,12 @@ import (
 	"database/sql"
 	"gofr.dev/pkg/gofr"
 	"gofr.dev/pkg/gofr/datasource"
+	datasourceSQL "gofr.dev/pkg/gofr/datasource/sql"
 	"moneyManagement/filters"
 	"moneyManagement/models"
 	"moneyManagement/stores"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -17,7 +20,13 @@ func New() stores.RecurringTransactions {
 }
 
 func (s *recurringTransactionStore) Create(ctx *gofr.Context, recurringTransaction *models.RecurringTransaction) error {
-	var nextRun interface{}
+	var endDate, nextRun interface{}
+
+	if recurringTransaction.EndDate == "" {
+		endDate = nil
+	} else {
+		endDate = recurringTransaction.EndDate
+	}
 
 	if recurringTransaction.NextRun == "" {
 		nextRun = nil
@@ -29,7 +38,9 @@ func (s *recurringTransactionStore) Create(ctx *gofr.Context, recurringTransacti
 
 	res, err := ctx.SQL.ExecContext(ctx, createTransaction, recurringTransaction.UserID, recurringTransaction.Account.ID,
 		recurringTransaction.Amount, recurringTransaction.Type, recurringTransaction.Category, recurringTransaction.Description,
-		recurringTransaction.Frequency, recurringTransaction.CustomDays, recurringTransaction.StartDate, recurringTransaction.EndDate,
+		recurringTransaction.Frequency, recurringTransaction.CustomDays, max(recurringTransaction.Interval, 1),
+		joinMonthDays(recurringTransaction.MonthDays), recurringTransaction.Weekday, recurringTransaction.WeekdayOrdinal,
+		recurringTransaction.ShiftPolicy(), recurringTransaction.RequiresApproval, recurringTransaction.StartDate, endDate,
 		nextRun, createdAt)
 	if err != nil {
 		return err
@@ -46,19 +57,7 @@ func (s *recurringTransactionStore) Create(ctx *gofr.Context, recurringTransacti
 }
 
 func (s *recurringTransactionStore) GetByID(ctx *gofr.Context, id, userID int) (*models.RecurringTransaction, error) {
-	var (
-		recurringTransaction models.RecurringTransaction
-		deletedAt            sql.NullString
-		createdAt            time.Time
-		startDate            sql.NullTime
-		endDate              sql.NullTime
-		lastRun              sql.NullTime
-		nextRun              sql.NullTime
-	)
-
-	err := ctx.SQL.QueryRowContext(ctx, getByIDTransactions, id, userID).Scan(&recurringTransaction.ID, &recurringTransaction.UserID,
-		&recurringTransaction.Account.ID, &recurringTransaction.Amount, &recurringTransaction.Type, &recurringTransaction.Category, &recurringTransaction.Description,
-		&recurringTransaction.Frequency, &recurringTransaction.CustomDays, &startDate, &endDate, &lastRun, &nextRun, &createdAt, &deletedAt, &recurringTransaction.Account.Name)
+	recurringTransaction, err := scanRecurringTransaction(ctx.SQL.QueryRowContext(ctx, getByIDTransactions, id, userID))
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -67,38 +66,26 @@ func (s *recurringTransactionStore) GetByID(ctx *gofr.Context, id, userID int) (
 		return nil, datasource.ErrorDB{Err: err, Message: "error fetching user by id"}
 	}
 
-	recurringTransaction.CreatedAt = createdAt.Format("2006-01-02T15:04:05.000Z")
-
-	if startDate.Valid {
-		recurringTransaction.StartDate = startDate.Time.Format("2006-01-02T15:04:05.000Z")
-	}
-
-	if endDate.Valid {
-		recurringTransaction.EndDate = endDate.Time.Format("2006-01-02T15:04:05.000Z")
-	}
+	return recurringTransaction, nil
+}
 
-	if nextRun.Valid {
-		recurringTransaction.NextRun = nextRun.Time.Format("2006-01-02T15:04:05.000Z")
-	}
+func (s *recurringTransactionStore) GetAll(ctx *gofr.Context, f *filters.RecurringTransactions) ([]*models.RecurringTransaction, error) {
+	clause, val := f.WhereClause()
 
-	if lastRun.Valid {
-		recurringTransaction.LastRun = lastRun.Time.Format("2006-01-02T15:04:05.000Z")
-	}
+	query := getAllTransactions + clause + " ORDER BY next_run"
 
-	if deletedAt.Valid {
-		recurringTransaction.DeletedAt = deletedAt.String
-	}
+	return s.getAll(ctx, query, val...)
+}
 
-	return &recurringTransaction, nil
+// GetDue returns every non-deleted recurring transaction whose next run is at or before runAt, or whose next
+// occurrence was moved to a date at or before it.
+func (s *recurringTransactionStore) GetDue(ctx *gofr.Context, runAt string) ([]*models.RecurringTransaction, error) {
+	return s.getAll(ctx, getDueTransactions, runAt, runAt)
 }
 
-func (s *recurringTransactionStore) GetAll(ctx *gofr.Context, f *filters.RecurringTransactions) ([]*models.RecurringTransaction, error) {
+func (s *recurringTransactionStore) getAll(ctx *gofr.Context, query string, val ...interface{}) ([]*models.RecurringTransaction, error) {
 	var allRecurringTransactions []*models.RecurringTransaction
 
-	clause, val := f.WhereClause()
-
-	query := getAllTransactions + clause + " ORDER BY next_run"
-
 	rows, err := ctx.SQL.QueryContext(ctx, query, val...)
 	if err != nil {
 		return nil, err
@@ -110,52 +97,26 @@ func (s *recurringTransactionStore) GetAll(ctx *gofr.Context, f *filters.Recurri
 	}()
 
 	for rows.Next() {
-		var (
-			recurringTransaction models.RecurringTransaction
-			deletedAt            sql.NullString
-			createdAt            time.Time
-			startDate            sql.NullTime
-			endDate              sql.NullTime
-			lastRun              sql.NullTime
-			nextRun              sql.NullTime
-		)
-
-		err = rows.Scan(&recurringTransaction.ID, &recurringTransaction.UserID,
-			&recurringTransaction.Account.ID, &recurringTransaction.Amount, &recurringTransaction.Type, &recurringTransaction.Category, &recurringTransaction.Description,
-			&recurringTransaction.Frequency, &recurringTransaction.CustomDays, &startDate, &endDate, &lastRun, &nextRun, &createdAt, &deletedAt, &recurringTransaction.Account.Name)
+		recurringTransaction, err := scanRecurringTransaction(rows)
 		if err != nil {
 			return nil, err
 		}
 
-		recurringTransaction.CreatedAt = createdAt.Format("2006-01-02T15:04:05.000Z")
-		if startDate.Valid {
-			recurringTransaction.StartDate = startDate.Time.Format("2006-01-02T15:04:05.000Z")
-		}
-
-		if endDate.Valid {
-			recurringTransaction.EndDate = endDate.Time.Format("2006-01-02T15:04:05.000Z")
-		}
-
-		if nextRun.Valid {
-			recurringTransaction.NextRun = nextRun.Time.Format("2006-01-02T15:04:05.000Z")
-		}
-
-		if lastRun.Valid {
-			recurringTransaction.LastRun = lastRun.Time.Format("2006-01-02T15:04:05.000Z")
-		}
-
-		if deletedAt.Valid {
-			recurringTransaction.DeletedAt = deletedAt.String
-		}
-
-		allRecurringTransactions = append(allRecurringTransactions, &recurringTransaction)
+		allRecurringTransactions = append(allRecurringTransactions, recurringTransaction)
 	}
 
 	return allRecurringTransactions, nil
 }
 
-func (s *recurringTransactionStore) Update(ctx *gofr.Context, recurringTransaction *models.RecurringTransaction) error {
-	var lastRun, nextRun interface{}
+func (s *recurringTransactionStore) Update(ctx *gofr.Context, recurringTransaction *models.RecurringTransaction,
+	tx *datasourceSQL.Tx) error {
+	var endDate, lastRun, nextRun interface{}
+	if recurringTransaction.EndDate == "" {
+		endDate = nil
+	} else {
+		endDate = recurringTransaction.EndDate
+	}
+
 	if recurringTransaction.LastRun == "" {
 		lastRun = nil
 	} else {
@@ -168,10 +129,36 @@ func (s *recurringTransactionStore) Update(ctx *gofr.Context, recurringTransacti
 		nextRun = recurringTransaction.NextRun
 	}
 
-	_, err := ctx.SQL.ExecContext(ctx, updateTransaction, recurringTransaction.Account.ID, recurringTransaction.Amount,
+	_, err := tx.ExecContext(ctx, updateTransaction, recurringTransaction.Account.ID, recurringTransaction.Amount,
 		recurringTransaction.Type, recurringTransaction.Category, recurringTransaction.Description, recurringTransaction.Frequency,
-		recurringTransaction.CustomDays, recurringTransaction.StartDate, recurringTransaction.EndDate, lastRun,
-		nextRun, recurringTransaction.ID)
+		recurringTransaction.CustomDays, max(recurringTransaction.Interval, 1), joinMonthDays(recurringTransaction.MonthDays),
+		recurringTransaction.Weekday, recurringTransaction.WeekdayOrdinal, recurringTransaction.ShiftPolicy(),
+		recurringTransaction.RequiresApproval, recurringTransaction.StartDate, endDate, lastRun, nextRun, recurringTransaction.ID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetByIDForUpdate locks the recurring transaction row for the lifetime of tx. Account name is not populated.
+func (s *recurringTransactionStore) GetByIDForUpdate(ctx *gofr.Context, id int, tx *datasourceSQL.Tx) (*models.RecurringTransaction, error) {
+	recurringTransaction, err := scanRecurringTransaction(tx.QueryRowContext(ctx, getByIDForUpdate, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+
+		return nil, datasource.ErrorDB{Err: err, Message: "error fetching recurring transaction by id"}
+	}
+
+	return recurringTransaction, nil
+}
+
+// UpdateRun advances the run markers of a recurring transaction. A nil lastRun keeps the last run, for an occurrence
+// that was not posted, and a nil nextRun marks the rule as finished.
+func (s *recurringTransactionStore) UpdateRun(ctx *gofr.Context, id int, lastRun, nextRun interface{}, tx *datasourceSQL.Tx) error {
+	_, err := tx.ExecContext(ctx, updateRun, lastRun, nextRun, id)
 	if err != nil {
 		return err
 	}
@@ -179,6 +166,22 @@ func (s *recurringTransactionStore) Update(ctx *gofr.Context, recurringTransacti
 	return nil
 }
 
+// UpdatePause pauses a recurring transaction of the user from pausedAt until pausedUntil, both nil resuming it,
+// reporting whether there was one.
+func (s *recurringTransactionStore) UpdatePause(ctx *gofr.Context, id, userID int, pausedAt, pausedUntil interface{}) (bool, error) {
+	res, err := ctx.SQL.ExecContext(ctx, updatePause, pausedAt, pausedUntil, id, userID)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
 func (s *recurringTransactionStore) Delete(ctx *gofr.Context, id int) error {
 	deletedAt := time.Now().UTC().Format("2006-01-02 15:04:05")
 
@@ -189,3 +192,93 @@ func (s *recurringTransactionStore) Delete(ctx *gofr.Context, id int) error {
 
 	return nil
 }
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanRecurringTransaction(row scanner) (*models.RecurringTransaction, error) {
+	var (
+		recurringTransaction models.RecurringTransaction
+		monthDays            string
+		deletedAt            sql.NullString
+		createdAt            time.Time
+		startDate            sql.NullTime
+		endDate              sql.NullTime
+		lastRun              sql.NullTime
+		nextRun              sql.NullTime
+		pausedAt             sql.NullTime
+		pausedUntil          sql.NullTime
+	)
+
+	err := row.Scan(&recurringTransaction.ID, &recurringTransaction.UserID, &recurringTransaction.Account.ID,
+		&recurringTransaction.Amount, &recurringTransaction.Type, &recurringTransaction.Category,
+		&recurringTransaction.Description, &recurringTransaction.Frequency, &recurringTransaction.CustomDays,
+		&recurringTransaction.Interval, &monthDays, &recurringTransaction.Weekday, &recurringTransaction.WeekdayOrdinal,
+		&recurringTransaction.Shift, &recurringTransaction.RequiresApproval, &startDate, &endDate, &lastRun, &nextRun, &pausedAt, &pausedUntil,
+		&createdAt, &deletedAt,
+		&recurringTransaction.Account.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	recurringTransaction.MonthDays = splitMonthDays(monthDays)
+	recurringTransaction.CreatedAt = createdAt.Format("2006-01-02T15:04:05.000Z")
+
+	if startDate.Valid {
+		recurringTransaction.StartDate = startDate.Time.Format("2006-01-02T15:04:05.000Z")
+	}
+
+	if endDate.Valid {
+		recurringTransaction.EndDate = endDate.Time.Format("2006-01-02T15:04:05.000Z")
+	}
+
+	if nextRun.Valid {
+		recurringTransaction.NextRun = nextRun.Time.Format("2006-01-02T15:04:05.000Z")
+	}
+
+	if lastRun.Valid {
+		recurringTransaction.LastRun = lastRun.Time.Format("2006-01-02T15:04:05.000Z")
+	}
+
+	if pausedAt.Valid {
+		recurringTransaction.PausedAt = pausedAt.Time.Format("2006-01-02T15:04:05.000Z")
+	}
+
+	if pausedUntil.Valid {
+		recurringTransaction.PausedUntil = pausedUntil.Time.Format("2006-01-02")
+	}
+
+	if deletedAt.Valid {
+		recurringTransaction.DeletedAt = deletedAt.String
+	}
+
+	// The rule is also given as an RRULE, for calendar apps
+	recurringTransaction.RRule, _ = recurringTransaction.ScheduleRRule()
+
+	return &recurringTransaction, nil
+}
+
+// joinMonthDays stores month days as a comma separated list, e.g. "1,15,-1".
+func joinMonthDays(monthDays []int) string {
+	days := make([]string, 0, len(monthDays))
+
+	for _, day := range monthDays {
+		days = append(days, strconv.Itoa(day))
+	}
+
+	return strings.Join(days, ",")
+}
+
+func splitMonthDays(monthDays string) []int {
+	var days []int
+
+	for _, day := range strings.Split(monthDays, ",") {
+		d, err := strconv.Atoi(strings.TrimSpace(day))
+		if err == nil {
+			days = append(days, d)
+		}
+	}
+
+	return days
+}
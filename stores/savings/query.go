@@ -8,4 +8,11 @@ const (
 	deleteSavings                  = "UPDATE savings SET deleted_at=? WHERE id=?"
 	updateSavingsWithTransactionID = "UPDATE savings SET type=?,category=?,amount=?,current_value=?,start_date=?,maturity_date=? WHERE transaction_id=?"
 	getByTransactionIDSavings      = "SELECT id,user_id,transaction_id,type,category,amount,current_value,start_date,maturity_date,created_at,deleted_at FROM savings WHERE transaction_id=?"
+
+	// Savings are paid from an account, so they are in its currency
+	savingsWithCurrency = "SELECT s.id,s.user_id,s.transaction_id,s.type,s.category,s.amount,s.current_value,s.start_date," +
+		"s.maturity_date,s.created_at,s.deleted_at,a.currency FROM savings s JOIN transactions t ON t.id=s.transaction_id " +
+		"JOIN accounts a ON a.id=t.account_id WHERE s.user_id=?"
+	getOpenSavings    = savingsWithCurrency + " AND s.deleted_at IS NULL ORDER BY s.id"
+	getSavingsHistory = savingsWithCurrency + " ORDER BY s.id"
 )
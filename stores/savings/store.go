@@ -157,6 +157,65 @@ func (s *savingsStore) GetAll(ctx *gofr.Context) ([]*models.Savings, error) {
 	return allSavings, nil
 }
 
+// GetOpen returns the savings of a user that are not deleted, with the currency of the account they were paid from.
+func (s *savingsStore) GetOpen(ctx *gofr.Context, userID int) ([]*models.Savings, error) {
+	return s.getWithCurrency(ctx, getOpenSavings, userID)
+}
+
+// GetHistory returns every savings of a user, the deleted ones with the date they were closed on as DeletedAt, with
+// the currency of the account they were paid from.
+func (s *savingsStore) GetHistory(ctx *gofr.Context, userID int) ([]*models.Savings, error) {
+	return s.getWithCurrency(ctx, getSavingsHistory, userID)
+}
+
+func (s *savingsStore) getWithCurrency(ctx *gofr.Context, query string, userID int) ([]*models.Savings, error) {
+	var allSavings []*models.Savings
+
+	rows, err := ctx.SQL.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		_ = rows.Close()
+		_ = rows.Err()
+	}()
+
+	for rows.Next() {
+		var (
+			savings      models.Savings
+			startDate    sql.NullTime
+			maturityDate sql.NullTime
+			createdAt    time.Time
+			deletedAt    sql.NullTime
+		)
+
+		err = rows.Scan(&savings.ID, &savings.UserID, &savings.TransactionID, &savings.Type, &savings.Category,
+			&savings.Amount, &savings.CurrentValue, &startDate, &maturityDate, &createdAt, &deletedAt, &savings.Currency)
+		if err != nil {
+			return nil, err
+		}
+
+		savings.CreatedAt = createdAt.Format("2006-01-02T15:04:05.000Z")
+
+		if startDate.Valid {
+			savings.StartDate = startDate.Time.Format("2006-01-02")
+		}
+
+		if maturityDate.Valid {
+			savings.MaturityDate = maturityDate.Time.Format("2006-01-02")
+		}
+
+		if deletedAt.Valid {
+			savings.DeletedAt = deletedAt.Time.Format("2006-01-02")
+		}
+
+		allSavings = append(allSavings, &savings)
+	}
+
+	return allSavings, nil
+}
+
 func (s *savingsStore) Update(ctx *gofr.Context, savings *models.Savings, tx *datasourceSQL.Tx) error {
 	var startDate, maturityDate interface{}
 
@@ -0,0 +1,7 @@
+package savingsValuations
+
+const (
+	createValuation  = "INSERT INTO savings_valuations (savings_id,user_id,value,valued_on,created_at) VALUES (?,?,?,?,?)"
+	getAllValuations = "SELECT id,savings_id,user_id,value,valued_on,created_at FROM savings_valuations WHERE user_id=? " +
+		"ORDER BY savings_id, valued_on, id"
+)
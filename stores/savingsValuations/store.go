@@ -0,0 +1,70 @@
+package savingsValuations
+
+import (
+	"gofr.dev/pkg/gofr"
+	datasourceSQL "gofr.dev/pkg/gofr/datasource/sql"
+	"moneyManagement/models"
+	"moneyManagement/stores"
+	"time"
+)
+
+type savingsValuationStore struct{}
+
+func New() stores.SavingsValuations {
+	return &savingsValuationStore{}
+}
+
+func (s *savingsValuationStore) Create(ctx *gofr.Context, valuation *models.SavingsValuation, tx *datasourceSQL.Tx) error {
+	createdAt := time.Now().UTC().Format("2006-01-02 15:04:05")
+
+	res, err := tx.ExecContext(ctx, createValuation, valuation.SavingsID, valuation.UserID, valuation.Value,
+		valuation.ValuedOn, createdAt)
+	if err != nil {
+		return err
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil || id == 0 {
+		return err
+	}
+
+	valuation.ID = int(id)
+	valuation.CreatedAt = createdAt
+
+	return nil
+}
+
+// GetAll returns every valuation of the savings of a user, ordered by savings and then by date.
+func (s *savingsValuationStore) GetAll(ctx *gofr.Context, userID int) ([]*models.SavingsValuation, error) {
+	var valuations []*models.SavingsValuation
+
+	rows, err := ctx.SQL.QueryContext(ctx, getAllValuations, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		_ = rows.Close()
+		_ = rows.Err()
+	}()
+
+	for rows.Next() {
+		var (
+			valuation models.SavingsValuation
+			valuedOn  time.Time
+			createdAt time.Time
+		)
+
+		err = rows.Scan(&valuation.ID, &valuation.SavingsID, &valuation.UserID, &valuation.Value, &valuedOn, &createdAt)
+		if err != nil {
+			return nil, err
+		}
+
+		valuation.ValuedOn = valuedOn.Format("2006-01-02")
+		valuation.CreatedAt = createdAt.Format("2006-01-02T15:04:05.000Z")
+
+		valuations = append(valuations, &valuation)
+	}
+
+	return valuations, nil
+}
@@ -0,0 +1,7 @@
+package transactionSplits
+
+const (
+	createSplit               = "INSERT INTO transaction_splits (transaction_id,category,amount,note,created_at) VALUES (?,?,?,?,?)"
+	getByTransactionIDs       = "SELECT id,transaction_id,category,amount,note FROM transaction_splits WHERE deleted_at IS NULL AND transaction_id IN "
+	deleteByTransactionSplits = "UPDATE transaction_splits SET deleted_at=? WHERE transaction_id=? AND deleted_at IS NULL"
+)
@@ -0,0 +1,84 @@
+package transactionSplits
+
+import (
+	"gofr.dev/pkg/gofr"
+	datasourceSQL "gofr.dev/pkg/gofr/datasource/sql"
+	"moneyManagement/models"
+	"moneyManagement/stores"
+	"strings"
+	"time"
+)
+
+type transactionSplitStore struct{}
+
+func New() stores.TransactionSplits {
+	return &transactionSplitStore{}
+}
+
+func (s *transactionSplitStore) Create(ctx *gofr.Context, split *models.TransactionSplit, tx *datasourceSQL.Tx) error {
+	createdAt := time.Now().UTC().Format("2006-01-02 15:04:05")
+
+	res, err := tx.ExecContext(ctx, createSplit, split.TransactionID, split.Category, split.Amount, split.Note, createdAt)
+	if err != nil {
+		return err
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil || id == 0 {
+		return err
+	}
+
+	split.ID = int(id)
+
+	return nil
+}
+
+// GetByTransactionIDs returns the splits of every given transaction keyed by transaction id.
+func (s *transactionSplitStore) GetByTransactionIDs(ctx *gofr.Context, transactionIDs []int) (map[int][]models.TransactionSplit, error) {
+	splits := make(map[int][]models.TransactionSplit)
+
+	if len(transactionIDs) == 0 {
+		return splits, nil
+	}
+
+	args := make([]interface{}, 0, len(transactionIDs))
+	for _, id := range transactionIDs {
+		args = append(args, id)
+	}
+
+	query := getByTransactionIDs + "(" + strings.TrimRight(strings.Repeat("?,", len(transactionIDs)), ",") + ") ORDER BY id"
+
+	rows, err := ctx.SQL.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		_ = rows.Close()
+		_ = rows.Err()
+	}()
+
+	for rows.Next() {
+		var split models.TransactionSplit
+
+		err = rows.Scan(&split.ID, &split.TransactionID, &split.Category, &split.Amount, &split.Note)
+		if err != nil {
+			return nil, err
+		}
+
+		splits[split.TransactionID] = append(splits[split.TransactionID], split)
+	}
+
+	return splits, nil
+}
+
+func (s *transactionSplitStore) DeleteByTransactionID(ctx *gofr.Context, transactionID int, tx *datasourceSQL.Tx) error {
+	deletedAt := time.Now().UTC().Format("2006-01-02 15:04:05")
+
+	_, err := tx.ExecContext(ctx, deleteByTransactionSplits, deletedAt, transactionID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -1,10 +1,30 @@
 package transactions
 
 const (
-	createTransaction   = "INSERT INTO transactions (user_id, account_id, amount,type,category,description,transaction_date,created_at) VALUES (?, ?, ?, ?,?,?,?,?)"
-	getByIDTransactions = "SELECT t.id,t.user_id, t.account_id, t.amount,t.type,t.category,t.description,t.transaction_date,t.created_at,t.deleted_at,a.name FROM transactions as t INNER JOIN accounts as a ON t.account_id=a.id WHERE t.id=? AND t.user_id=?"
-	getAllTransactions  = "SELECT t.id,t.user_id, t.account_id, t.amount,t.type,t.category,t.description,t.transaction_date," +
-		"t.created_at,t.deleted_at,a.name FROM transactions as t INNER JOIN accounts as a ON t.account_id=a.id"
-	updateTransaction = "UPDATE transactions SET account_id=?, amount=?,type=?,category=?,description=?,transaction_date=? WHERE id=?"
+	createTransaction   = "INSERT INTO transactions (user_id, account_id, to_account_id, amount,currency,to_amount,to_currency,type,category,description,transaction_date,created_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?,?,?,?,?)"
+	getByIDTransactions = "SELECT t.id,t.user_id, t.account_id, t.amount,t.currency,t.to_amount,t.to_currency,t.type,t.category,t.description,t.status,t.to_status,t.transaction_date,t.created_at,t.deleted_at,a.name,t.to_account_id,ta.name " +
+		"FROM transactions as t INNER JOIN accounts as a ON t.account_id=a.id LEFT JOIN accounts as ta ON t.to_account_id=ta.id WHERE t.id=? AND t.user_id=?"
+	getAllTransactions = "SELECT t.id,t.user_id, t.account_id, t.amount,t.currency,t.to_amount,t.to_currency,t.type,t.category,t.description,t.status,t.to_status,t.transaction_date," +
+		"t.created_at,t.deleted_at,a.name,t.to_account_id,ta.name FROM transactions as t INNER JOIN accounts as a ON t.account_id=a.id LEFT JOIN accounts as ta ON t.to_account_id=ta.id"
+	updateTransaction = "UPDATE transactions SET account_id=?, to_account_id=?, amount=?,currency=?,to_amount=?,to_currency=?,type=?,category=?,description=?,transaction_date=? WHERE id=?"
 	deleteTransaction = "UPDATE transactions SET deleted_at=? WHERE id=?"
+
+	// Only the leg of a transfer on the account is updated, the other account has a statement of its own
+	updateStatus = "UPDATE transactions SET status=IF(account_id=?,?,status),to_status=IF(to_account_id=?,?,to_status) " +
+		"WHERE user_id=? AND deleted_at IS NULL AND id IN ("
+
+	// A split transaction is counted in the category of each of its splits
+	getBucketTotals = "SELECT %s AS bucket,t.type,COALESCE(ts.category,t.category) AS split_category,t.currency," +
+		"SUM(COALESCE(ts.amount,t.amount)) FROM transactions as t LEFT JOIN transaction_splits as ts " +
+		"ON ts.transaction_id=t.id AND ts.deleted_at IS NULL"
+	groupBucketTotals = " GROUP BY bucket,t.type,split_category,t.currency ORDER BY bucket,t.type,split_category"
 )
+
+// bucketStarts are the SQL expressions of the first day of the day, week (starting on Monday), month or year a
+// transaction is dated in
+var bucketStarts = map[string]string{
+	"day":   "DATE_FORMAT(t.transaction_date,'%Y-%m-%d')",
+	"week":  "DATE_FORMAT(DATE_SUB(t.transaction_date,INTERVAL WEEKDAY(t.transaction_date) DAY),'%Y-%m-%d')",
+	"month": "DATE_FORMAT(t.transaction_date,'%Y-%m-01')",
+	"year":  "DATE_FORMAT(t.transaction_date,'%Y-01-01')",
+}
@@ -2,12 +2,15 @@ package transactions
 
 import (
 	"database/sql"
+	"errors"
+	"fmt"
 	"gofr.dev/pkg/gofr"
 	"gofr.dev/pkg/gofr/datasource"
 	datasourceSQL "gofr.dev/pkg/gofr/datasource/sql"
 	"moneyManagement/filters"
 	"moneyManagement/models"
 	"moneyManagement/stores"
+	"strings"
 	"time"
 )
 
@@ -20,8 +23,9 @@ func New() stores.Transactions {
 func (s *transactionStore) Create(ctx *gofr.Context, transaction *models.Transaction, tx *datasourceSQL.Tx) error {
 	createdAt := time.Now().UTC().Format("2006-01-02 15:04:05")
 
-	res, err := tx.ExecContext(ctx, createTransaction, transaction.UserID, transaction.Account.ID, transaction.Amount,
-		transaction.Type, transaction.Category, transaction.Description, transaction.TransactionDate, createdAt)
+	res, err := tx.ExecContext(ctx, createTransaction, transaction.UserID, transaction.Account.ID, toAccountID(transaction), transaction.Amount,
+		transaction.Currency, transaction.ToAmount, toCurrency(transaction), transaction.Type, transaction.Category,
+		transaction.Description, transaction.TransactionDate, createdAt)
 	if err != nil {
 		return err
 	}
@@ -36,17 +40,75 @@ func (s *transactionStore) Create(ctx *gofr.Context, transaction *models.Transac
 	return nil
 }
 
+// GetBucketTotals adds up the INCOME, EXPENSE and SAVINGS transactions matching f by the day, week, month or year
+// they are dated in, their type, category and currency. The buckets are ordered by their first day.
+func (s *transactionStore) GetBucketTotals(ctx *gofr.Context, f *filters.Transactions, interval string) ([]*models.BucketTotal, error) {
+	bucketStart, ok := bucketStarts[interval]
+	if !ok {
+		return nil, errors.New("invalid interval, use day, week, month or year")
+	}
+
+	categories := f.Category
+
+	clause, val := f.WhereClause()
+	if clause == "" {
+		clause = " WHERE t.deleted_at IS NULL"
+	}
+
+	clause += " AND t.type IN ('INCOME','EXPENSE','SAVINGS')"
+
+	// Only the splits in the categories asked for count, not the rest of a matching split transaction
+	if len(categories) != 0 {
+		clause += " AND COALESCE(ts.category,t.category) IN (" + strings.TrimRight(strings.Repeat("?,", len(categories)), ",") + ")"
+
+		for _, category := range categories {
+			val = append(val, category)
+		}
+	}
+
+	query := fmt.Sprintf(getBucketTotals, bucketStart) + clause + groupBucketTotals
+
+	rows, err := ctx.SQL.QueryContext(ctx, query, val...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		_ = rows.Close()
+		_ = rows.Err()
+	}()
+
+	var totals []*models.BucketTotal
+
+	for rows.Next() {
+		var total models.BucketTotal
+
+		err = rows.Scan(&total.Bucket, &total.Type, &total.Category, &total.Currency, &total.Amount)
+		if err != nil {
+			return nil, err
+		}
+
+		totals = append(totals, &total)
+	}
+
+	return totals, nil
+}
+
 func (s *transactionStore) GetByID(ctx *gofr.Context, id, userID int) (*models.Transaction, error) {
 	var (
 		transaction     models.Transaction
 		deletedAt       sql.NullString
 		createdAt       time.Time
 		transactionDate time.Time
+		toAccountID     sql.NullInt64
+		toAccountName   sql.NullString
+		toCurrency      sql.NullString
 	)
 
 	err := ctx.SQL.QueryRowContext(ctx, getByIDTransactions, id, userID).Scan(&transaction.ID, &transaction.UserID,
-		&transaction.Account.ID, &transaction.Amount, &transaction.Type, &transaction.Category, &transaction.Description,
-		&transactionDate, &createdAt, &deletedAt, &transaction.Account.Name)
+		&transaction.Account.ID, &transaction.Amount, &transaction.Currency, &transaction.ToAmount, &toCurrency, &transaction.Type,
+		&transaction.Category, &transaction.Description, &transaction.Status, &transaction.ToStatus, &transactionDate, &createdAt, &deletedAt,
+		&transaction.Account.Name, &toAccountID, &toAccountName)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -62,6 +124,59 @@ func (s *transactionStore) GetByID(ctx *gofr.Context, id, userID int) (*models.T
 		transaction.DeletedAt = deletedAt.String
 	}
 
+	if toAccountID.Valid {
+		transaction.ToAccount = &models.AccountDetails{ID: int(toAccountID.Int64), Name: toAccountName.String}
+	}
+
+	if transaction.Type != models.TRANSFER {
+		transaction.ToStatus = ""
+	}
+
+	transaction.ToCurrency = toCurrency.String
+
+	return &transaction, nil
+}
+
+func (s *transactionStore) GetByIDForUpdate(ctx *gofr.Context, id, userID int, tx *datasourceSQL.Tx) (*models.Transaction, error) {
+	var (
+		transaction     models.Transaction
+		deletedAt       sql.NullString
+		createdAt       time.Time
+		transactionDate time.Time
+		toAccountID     sql.NullInt64
+		toAccountName   sql.NullString
+		toCurrency      sql.NullString
+	)
+
+	err := tx.QueryRowContext(ctx, getByIDTransactions+" FOR UPDATE OF t", id, userID).Scan(&transaction.ID, &transaction.UserID,
+		&transaction.Account.ID, &transaction.Amount, &transaction.Currency, &transaction.ToAmount, &toCurrency, &transaction.Type,
+		&transaction.Category, &transaction.Description, &transaction.Status, &transaction.ToStatus, &transactionDate, &createdAt, &deletedAt,
+		&transaction.Account.Name, &toAccountID, &toAccountName)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+
+		return nil, datasource.ErrorDB{Err: err, Message: "error fetching transaction by id"}
+	}
+
+	transaction.CreatedAt = createdAt.Format("2006-01-02T15:04:05.000Z")
+	transaction.TransactionDate = transactionDate.Format("2006-01-02T15:04:05.000Z")
+
+	if deletedAt.Valid {
+		transaction.DeletedAt = deletedAt.String
+	}
+
+	if toAccountID.Valid {
+		transaction.ToAccount = &models.AccountDetails{ID: int(toAccountID.Int64), Name: toAccountName.String}
+	}
+
+	if transaction.Type != models.TRANSFER {
+		transaction.ToStatus = ""
+	}
+
+	transaction.ToCurrency = toCurrency.String
+
 	return &transaction, nil
 }
 
@@ -88,10 +203,14 @@ func (s *transactionStore) GetAll(ctx *gofr.Context, f *filters.Transactions) ([
 			deletedAt       sql.NullString
 			createdAt       time.Time
 			transactionDate time.Time
+			toAccountID     sql.NullInt64
+			toAccountName   sql.NullString
+			toCurrency      sql.NullString
 		)
 
-		err = rows.Scan(&transaction.ID, &transaction.UserID, &transaction.Account.ID, &transaction.Amount, &transaction.Type,
-			&transaction.Category, &transaction.Description, &transactionDate, &createdAt, &deletedAt, &transaction.Account.Name)
+		err = rows.Scan(&transaction.ID, &transaction.UserID, &transaction.Account.ID, &transaction.Amount, &transaction.Currency,
+			&transaction.ToAmount, &toCurrency, &transaction.Type, &transaction.Category, &transaction.Description,
+			&transaction.Status, &transaction.ToStatus, &transactionDate, &createdAt, &deletedAt, &transaction.Account.Name, &toAccountID, &toAccountName)
 		if err != nil {
 			return nil, err
 		}
@@ -103,6 +222,16 @@ func (s *transactionStore) GetAll(ctx *gofr.Context, f *filters.Transactions) ([
 			transaction.DeletedAt = deletedAt.String
 		}
 
+		if toAccountID.Valid {
+			transaction.ToAccount = &models.AccountDetails{ID: int(toAccountID.Int64), Name: toAccountName.String}
+		}
+
+		if transaction.Type != models.TRANSFER {
+			transaction.ToStatus = ""
+		}
+
+		transaction.ToCurrency = toCurrency.String
+
 		allTransactions = append(allTransactions, &transaction)
 	}
 
@@ -110,7 +239,9 @@ func (s *transactionStore) GetAll(ctx *gofr.Context, f *filters.Transactions) ([
 }
 
 func (s *transactionStore) Update(ctx *gofr.Context, transaction *models.Transaction, tx *datasourceSQL.Tx) error {
-	_, err := tx.ExecContext(ctx, updateTransaction, transaction.Account.ID, transaction.Amount, transaction.Type, transaction.Category, transaction.Description, transaction.TransactionDate, transaction.ID)
+	_, err := tx.ExecContext(ctx, updateTransaction, transaction.Account.ID, toAccountID(transaction), transaction.Amount,
+		transaction.Currency, transaction.ToAmount, toCurrency(transaction), transaction.Type, transaction.Category,
+		transaction.Description, transaction.TransactionDate, transaction.ID)
 	if err != nil {
 		return err
 	}
@@ -128,3 +259,41 @@ func (s *transactionStore) Delete(ctx *gofr.Context, id int, tx *datasourceSQL.T
 
 	return nil
 }
+
+// UpdateStatus sets the status of the transactions on an account, of their leg on it for transfers.
+func (s *transactionStore) UpdateStatus(ctx *gofr.Context, ids []int, userID, accountID int,
+	status models.TransactionStatus, tx *datasourceSQL.Tx) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	args := []interface{}{accountID, status, accountID, status, userID}
+	for _, id := range ids {
+		args = append(args, id)
+	}
+
+	_, err := tx.ExecContext(ctx, updateStatus+strings.TrimRight(strings.Repeat("?,", len(ids)), ",")+")", args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// toAccountID returns the destination account of a transfer, or nil for every other transaction type.
+func toAccountID(transaction *models.Transaction) interface{} {
+	if transaction.ToAccount == nil {
+		return nil
+	}
+
+	return transaction.ToAccount.ID
+}
+
+// toCurrency returns the currency of ToAmount, or nil when the transfer is within one currency.
+func toCurrency(transaction *models.Transaction) interface{} {
+	if transaction.ToAmount == nil {
+		return nil
+	}
+
+	return transaction.ToCurrency
+}
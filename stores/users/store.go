@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"gofr.dev/pkg/gofr"
 	"gofr.dev/pkg/gofr/datasource"
+	datasourceSQL "gofr.dev/pkg/gofr/datasource/sql"
 	"moneyManagement/filters"
 	"moneyManagement/models"
 	"moneyManagement/stores"
@@ -16,10 +17,10 @@ func New() stores.User {
 	return &userStore{}
 }
 
-func (s *userStore) Create(ctx *gofr.Context, user *models.User) error {
+func (s *userStore) Create(ctx *gofr.Context, user *models.User, tx *datasourceSQL.Tx) error {
 	createdAt := time.Now().UTC().Format("2006-01-02 15:04:05")
 
-	res, err := ctx.SQL.ExecContext(ctx, createUser, user.FirstName, user.LastName, user.Email, user.Status, createdAt)
+	res, err := tx.ExecContext(ctx, createUser, user.FirstName, user.LastName, user.Email, user.HomeCurrency, user.Status, createdAt)
 	if err != nil {
 		return err
 	}
@@ -41,7 +42,7 @@ func (s *userStore) GetByID(ctx *gofr.Context, id int) (*models.User, error) {
 		deletedAt sql.NullString
 	)
 
-	err := ctx.SQL.QueryRowContext(ctx, getByID, id).Scan(&user.ID, &user.FirstName, &user.LastName, &user.Email, &user.Status, &createdAt, &deletedAt)
+	err := ctx.SQL.QueryRowContext(ctx, getByID, id).Scan(&user.ID, &user.FirstName, &user.LastName, &user.Email, &user.HomeCurrency, &user.Role, &user.Status, &createdAt, &deletedAt)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -83,7 +84,7 @@ func (s *userStore) GetAll(ctx *gofr.Context, f *filters.User) ([]*models.User,
 			deletedAt sql.NullString
 		)
 
-		err = rows.Scan(&user.ID, &user.FirstName, &user.LastName, &user.Email, &user.Status, &createdAt, &deletedAt)
+		err = rows.Scan(&user.ID, &user.FirstName, &user.LastName, &user.Email, &user.HomeCurrency, &user.Role, &user.Status, &createdAt, &deletedAt)
 		if err != nil {
 			return nil, err
 		}
@@ -102,7 +103,7 @@ func (s *userStore) GetAll(ctx *gofr.Context, f *filters.User) ([]*models.User,
 }
 
 func (s *userStore) Update(ctx *gofr.Context, user *models.User) error {
-	_, err := ctx.SQL.ExecContext(ctx, updateUser, user.FirstName, user.LastName, user.Email, user.Status, user.ID)
+	_, err := ctx.SQL.ExecContext(ctx, updateUser, user.FirstName, user.LastName, user.Email, user.HomeCurrency, user.Status, user.ID)
 	if err != nil {
 		return err
 	}